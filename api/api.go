@@ -3,18 +3,22 @@ package api
 import (
 	review "github.com/NpoolPlatform/message/npool/review/mgr/v2"
 
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 )
 
 type Server struct {
 	review.UnimplementedManagerServer
+	extmgr.UnimplementedExtManagerServer
 }
 
 func Register(server grpc.ServiceRegistrar) {
 	review.RegisterManagerServer(server, &Server{})
+	extmgr.RegisterExtManagerServer(server, &Server{})
 }
 
 func RegisterGateway(mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
-	return nil
+	return registerGateway(mux, endpoint, opts)
 }
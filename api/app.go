@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	valuedef "github.com/NpoolPlatform/message/npool"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/google/uuid"
+)
+
+// The cross app endpoints serve the Manager handlers on the reviews of the target app of the request,
+// out of the app scope of the caller. tenant.PlatformAdmin checks the caller is platform admin and audits the call.
+
+func validateTargetApp(targetAppID string) error {
+	if _, err := uuid.Parse(targetAppID); err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target app id: %v", err))
+	}
+	return nil
+}
+
+// targetInfo is a copy of info in targetAppID, the request of the caller stays untouched
+func targetInfo(info *npool.ReviewReq, targetAppID string) *npool.ReviewReq {
+	if info == nil {
+		return nil
+	}
+	info = proto.Clone(info).(*npool.ReviewReq)
+	info.AppID = &targetAppID
+	return info
+}
+
+func (s *Server) CreateAppReview(ctx context.Context, in *extmgr.CreateAppReviewRequest) (*extmgr.CreateAppReviewResponse, error) {
+	if err := validateTargetApp(in.GetTargetAppID()); err != nil {
+		return &extmgr.CreateAppReviewResponse{}, err
+	}
+
+	ctx, err := tenant.PlatformAdmin(ctx, "CreateAppReview")
+	if err != nil {
+		return &extmgr.CreateAppReviewResponse{}, toStatus(err)
+	}
+
+	resp, err := s.CreateReview(ctx, &npool.CreateReviewRequest{
+		Info: targetInfo(in.GetInfo(), in.GetTargetAppID()),
+	})
+	if err != nil {
+		return &extmgr.CreateAppReviewResponse{}, err
+	}
+
+	return &extmgr.CreateAppReviewResponse{
+		Info: resp.GetInfo(),
+	}, nil
+}
+
+func (s *Server) CreateAppReviews(ctx context.Context, in *extmgr.CreateAppReviewsRequest) (*extmgr.CreateAppReviewsResponse, error) {
+	if err := validateTargetApp(in.GetTargetAppID()); err != nil {
+		return &extmgr.CreateAppReviewsResponse{}, err
+	}
+
+	ctx, err := tenant.PlatformAdmin(ctx, "CreateAppReviews")
+	if err != nil {
+		return &extmgr.CreateAppReviewsResponse{}, toStatus(err)
+	}
+
+	infos := []*npool.ReviewReq{}
+	for _, info := range in.GetInfos() {
+		infos = append(infos, targetInfo(info, in.GetTargetAppID()))
+	}
+
+	resp, err := s.CreateReviews(ctx, &npool.CreateReviewsRequest{
+		Infos: infos,
+	})
+	if err != nil {
+		return &extmgr.CreateAppReviewsResponse{}, err
+	}
+
+	return &extmgr.CreateAppReviewsResponse{
+		Infos: resp.GetInfos(),
+	}, nil
+}
+
+func (s *Server) GetAppReviews(ctx context.Context, in *extmgr.GetAppReviewsRequest) (*extmgr.GetAppReviewsResponse, error) {
+	if err := validateTargetApp(in.GetTargetAppID()); err != nil {
+		return &extmgr.GetAppReviewsResponse{}, err
+	}
+
+	ctx, err := tenant.PlatformAdmin(ctx, "GetAppReviews")
+	if err != nil {
+		return &extmgr.GetAppReviewsResponse{}, toStatus(err)
+	}
+
+	conds := &npool.Conds{}
+	if in.GetConds() != nil {
+		conds = proto.Clone(in.GetConds()).(*npool.Conds)
+	}
+	conds.AppID = &valuedef.StringVal{
+		Op:    cruder.EQ,
+		Value: in.GetTargetAppID(),
+	}
+
+	resp, err := s.GetReviews(ctx, &npool.GetReviewsRequest{
+		Conds:  conds,
+		Offset: in.GetOffset(),
+		Limit:  in.GetLimit(),
+	})
+	if err != nil {
+		return &extmgr.GetAppReviewsResponse{}, err
+	}
+
+	return &extmgr.GetAppReviewsResponse{
+		Infos: resp.GetInfos(),
+		Total: resp.GetTotal(),
+	}, nil
+}
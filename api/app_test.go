@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetAppReviewsTargetApp(t *testing.T) {
+	s := &Server{}
+
+	_, err := s.GetAppReviews(context.Background(), &extmgr.GetAppReviewsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// bulkTarget parses the ids of a bulk request, the reviews matching conds are the target when ids is empty
+func bulkTarget(ids []string, conds *extmgr.Conds) ([]uuid.UUID, error) {
+	if len(ids) > 0 && conds != nil {
+		return nil, fmt.Errorf("ids and conds are exclusive")
+	}
+	if len(ids) == 0 && conds == nil {
+		return nil, fmt.Errorf("ids or conds needed")
+	}
+	_ids := []uuid.UUID{}
+	for _, id := range ids {
+		_id, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %v: %v", id, err)
+		}
+		_ids = append(_ids, _id)
+	}
+	if err := ValidateExtConds(conds); err != nil {
+		return nil, err
+	}
+	return _ids, nil
+}
+
+func (s *Server) UpdateReviews(ctx context.Context, in *extmgr.UpdateReviewsRequest) (*extmgr.UpdateReviewsResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "UpdateReviews")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(attribute.Int("IDs", len(in.GetIDs())))
+	span = tracer.TraceExtConds(span, in.GetConds())
+	span = tracer.Trace(span, in.GetInfo())
+
+	ids, err := bulkTarget(in.GetIDs(), in.GetConds())
+	if err != nil {
+		return &extmgr.UpdateReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.GetInfo().ID != nil {
+		return &extmgr.UpdateReviewsResponse{}, status.Error(codes.InvalidArgument, "info takes no id")
+	}
+	if err := validateUpdate(ctx, in.GetInfo()); err != nil {
+		return &extmgr.UpdateReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "UpdateMany")
+
+	outcomes, err := crud.UpdateMany(ctx, ids, crud.ConvertExtConds(in.GetConds()), in.GetInfo())
+	if err != nil {
+		logger.Sugar().Errorf("fail update reviews: %v", err)
+		return &extmgr.UpdateReviewsResponse{}, toStatus(err)
+	}
+
+	infos := []*extmgr.UpdateReviewOutcome{}
+	for _, outcome := range outcomes {
+		info := &extmgr.UpdateReviewOutcome{
+			ID: outcome.ID.String(),
+		}
+		if outcome.Err != nil {
+			info.Reason = mapError(outcome.Err).reason
+			info.Message = outcome.Err.Error()
+		} else {
+			info.Info = converter.Ent2Grpc(outcome.Info)
+		}
+		infos = append(infos, info)
+	}
+
+	return &extmgr.UpdateReviewsResponse{
+		Outcomes: infos,
+	}, nil
+}
+
+func (s *Server) DeleteReviews(ctx context.Context, in *extmgr.DeleteReviewsRequest) (*extmgr.DeleteReviewsResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "DeleteReviews")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.Int("IDs", len(in.GetIDs())),
+		attribute.Int64("MaxRows", int64(in.GetMaxRows())),
+		attribute.Bool("DryRun", in.GetDryRun()),
+	)
+	span = tracer.TraceExtConds(span, in.GetConds())
+
+	ids, err := bulkTarget(in.GetIDs(), in.GetConds())
+	if err != nil {
+		return &extmgr.DeleteReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.GetMaxRows() == 0 {
+		return &extmgr.DeleteReviewsResponse{}, status.Error(codes.InvalidArgument, "invalid max rows")
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "DeleteMany")
+
+	count, err := crud.DeleteMany(ctx, ids, crud.ConvertExtConds(in.GetConds()), int(in.GetMaxRows()), in.GetDryRun())
+	if err != nil {
+		logger.Sugar().Errorf("fail delete reviews: %v", err)
+		return &extmgr.DeleteReviewsResponse{}, toStatus(err)
+	}
+
+	return &extmgr.DeleteReviewsResponse{
+		Count: uint32(count),
+	}, nil
+}
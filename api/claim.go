@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (s *Server) ClaimReview(ctx context.Context, in *extmgr.ClaimReviewRequest) (*extmgr.ClaimReviewResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "ClaimReview")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.String("Domain", in.GetDomain()),
+		attribute.String("ObjectType", in.GetObjectType().String()),
+		attribute.String("ReviewerID", in.GetReviewerID()),
+		attribute.Int64("LeaseSeconds", int64(in.GetLeaseSeconds())),
+	)
+
+	if in.GetDomain() == "" {
+		return &extmgr.ClaimReviewResponse{}, status.Error(codes.InvalidArgument, "invalid domain")
+	}
+	if err := validateObjectType(int32(in.GetObjectType())); err != nil {
+		return &extmgr.ClaimReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	reviewerID, err := uuid.Parse(in.GetReviewerID())
+	if err != nil {
+		return &extmgr.ClaimReviewResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid reviewer id: %v", err))
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "Claim")
+
+	lease := time.Duration(in.GetLeaseSeconds()) * time.Second
+	info, err := crud.Claim(ctx, in.GetDomain(), in.GetObjectType(), reviewerID, lease)
+	if err != nil {
+		logger.Sugar().Errorf("fail claim review: %v", err)
+		return &extmgr.ClaimReviewResponse{}, toStatus(err)
+	}
+
+	setVersion(ctx, info)
+
+	return &extmgr.ClaimReviewResponse{
+		Info: converter.Ent2Grpc(info),
+	}, nil
+}
+
+func (s *Server) ReleaseReview(ctx context.Context, in *extmgr.ReleaseReviewRequest) (*extmgr.ReleaseReviewResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "ReleaseReview")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetID())
+	span.SetAttributes(attribute.String("ReviewerID", in.GetReviewerID()))
+
+	id, err := uuid.Parse(in.GetID())
+	if err != nil {
+		return &extmgr.ReleaseReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	reviewerID, err := uuid.Parse(in.GetReviewerID())
+	if err != nil {
+		return &extmgr.ReleaseReviewResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid reviewer id: %v", err))
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "Release")
+
+	info, err := crud.Release(ctx, id, reviewerID)
+	if err != nil {
+		logger.Sugar().Errorf("fail release review: %v", err)
+		return &extmgr.ReleaseReviewResponse{}, toStatus(err)
+	}
+
+	setVersion(ctx, info)
+
+	return &extmgr.ReleaseReviewResponse{
+		Info: converter.Ent2Grpc(info),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (s *Server) RestoreReview(ctx context.Context, in *extmgr.RestoreReviewRequest) (*extmgr.RestoreReviewResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "RestoreReview")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetID())
+
+	id, err := uuid.Parse(in.GetID())
+	if err != nil {
+		return &extmgr.RestoreReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "Restore")
+
+	info, err := crud.Restore(ctx, id)
+	if err != nil {
+		logger.Sugar().Errorf("fail restore review: %v", err)
+		return &extmgr.RestoreReviewResponse{}, toStatus(err)
+	}
+
+	setVersion(ctx, info)
+
+	return &extmgr.RestoreReviewResponse{
+		Info: converter.Ent2Grpc(info),
+	}, nil
+}
+
+func (s *Server) GetDeletedReviews(ctx context.Context, in *extmgr.GetDeletedReviewsRequest) (*extmgr.GetDeletedReviewsResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "GetDeletedReviews")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = tracer.TraceExtConds(span, in.GetConds())
+	span = commontracer.TraceOffsetLimit(span, int(in.GetOffset()), int(in.GetLimit()))
+
+	if err := ValidateExtConds(in.GetConds()); err != nil {
+		return &extmgr.GetDeletedReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "DeletedRows")
+
+	rows, total, err := crud.DeletedRows(ctx, crud.ConvertExtConds(in.GetConds()), int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		logger.Sugar().Errorf("fail get deleted reviews: %v", err)
+		return &extmgr.GetDeletedReviewsResponse{}, toStatus(err)
+	}
+
+	return &extmgr.GetDeletedReviewsResponse{
+		Infos: converter.Ent2GrpcMany(rows),
+		Total: uint32(total),
+	}, nil
+}
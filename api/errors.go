@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/privacy"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	servicename "github.com/NpoolPlatform/review-manager/pkg/servicename"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons of the ErrorInfo detail attached to the mapped errors
+const (
+	ReasonNotFound        = "REVIEW_NOT_FOUND"
+	ReasonNotSingular     = "REVIEW_NOT_SINGULAR"
+	ReasonConflict        = "REVIEW_CONFLICT"
+	ReasonInvalid         = "REVIEW_INVALID"
+	ReasonInvalidCond     = "REVIEW_INVALID_COND"
+	ReasonInvalidReason   = "REVIEW_INVALID_REASON"
+	ReasonTransition      = "REVIEW_ILLEGAL_TRANSITION"
+	ReasonLeased          = "REVIEW_LEASED"
+	ReasonDecided         = "REVIEW_ALREADY_DECIDED"
+	ReasonVersionConflict = "REVIEW_VERSION_CONFLICT"
+	ReasonTooManyRows     = "REVIEW_TOO_MANY_ROWS"
+	ReasonOtherApp        = "REVIEW_OTHER_APP"
+	ReasonCanceled        = "REVIEW_CANCELED"
+	ReasonInternal        = "REVIEW_INTERNAL"
+)
+
+type errorMapping struct {
+	code     codes.Code
+	reason   string
+	metadata map[string]string
+}
+
+func mapError(err error) errorMapping {
+	var transitionErr *crud.TransitionError
+	var leaseErr *crud.LeaseError
+	var decisionErr *crud.DecisionError
+	var conflictErr *crud.ConflictError
+	var condErr *crud.CondError
+	var maxRowsErr *crud.MaxRowsError
+	var reasonErr *reason.Error
+
+	switch {
+	case ent.IsNotFound(err):
+		return errorMapping{code: codes.NotFound, reason: ReasonNotFound}
+	case ent.IsNotSingular(err):
+		return errorMapping{code: codes.FailedPrecondition, reason: ReasonNotSingular}
+	case ent.IsConstraintError(err):
+		return errorMapping{code: codes.AlreadyExists, reason: ReasonConflict}
+	case ent.IsValidationError(err):
+		return errorMapping{code: codes.InvalidArgument, reason: ReasonInvalid}
+	case errors.As(err, &condErr):
+		return errorMapping{
+			code:     codes.InvalidArgument,
+			reason:   ReasonInvalidCond,
+			metadata: map[string]string{"field": condErr.Field, "op": condErr.Op},
+		}
+	case errors.As(err, &reasonErr):
+		return errorMapping{
+			code:     codes.InvalidArgument,
+			reason:   ReasonInvalidReason,
+			metadata: map[string]string{"code": reasonErr.Code, "state": reasonErr.State},
+		}
+	case errors.As(err, &transitionErr):
+		return errorMapping{
+			code:     codes.FailedPrecondition,
+			reason:   ReasonTransition,
+			metadata: map[string]string{"from": transitionErr.From, "to": transitionErr.To},
+		}
+	case errors.As(err, &leaseErr):
+		return errorMapping{
+			code:     codes.FailedPrecondition,
+			reason:   ReasonLeased,
+			metadata: map[string]string{"id": leaseErr.ID.String()},
+		}
+	case errors.As(err, &decisionErr):
+		return errorMapping{
+			code:     codes.FailedPrecondition,
+			reason:   ReasonDecided,
+			metadata: map[string]string{"id": decisionErr.ID.String(), "reviewer_id": decisionErr.ReviewerID.String()},
+		}
+	case errors.As(err, &maxRowsErr):
+		return errorMapping{
+			code:     codes.FailedPrecondition,
+			reason:   ReasonTooManyRows,
+			metadata: map[string]string{"max_rows": fmt.Sprintf("%v", maxRowsErr.MaxRows)},
+		}
+	case errors.As(err, &conflictErr):
+		return errorMapping{
+			code:     codes.Aborted,
+			reason:   ReasonVersionConflict,
+			metadata: map[string]string{"id": conflictErr.ID.String()},
+		}
+	case errors.Is(err, privacy.Deny), errors.Is(err, tenant.ErrNotPlatformAdmin):
+		return errorMapping{code: codes.PermissionDenied, reason: ReasonOtherApp}
+	case errors.Is(err, context.Canceled):
+		return errorMapping{code: codes.Canceled, reason: ReasonCanceled}
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorMapping{code: codes.DeadlineExceeded, reason: ReasonCanceled}
+	}
+	return errorMapping{code: codes.Internal, reason: ReasonInternal}
+}
+
+// toStatus maps a crud error to its grpc status, with an ErrorInfo detail telling the reason
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	mapping := mapError(err)
+
+	st := status.New(mapping.code, err.Error())
+	withDetails, _err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   mapping.reason,
+		Domain:   servicename.ServiceName,
+		Metadata: mapping.metadata,
+	})
+	if _err != nil {
+		logger.Sugar().Warnw("toStatus", "error", _err)
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/privacy"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	servicename "github.com/NpoolPlatform/review-manager/pkg/servicename"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+)
+
+func TestToStatus(t *testing.T) {
+	id := uuid.New()
+	reviewerID := uuid.New()
+
+	tests := []struct {
+		name     string
+		err      error
+		code     codes.Code
+		reason   string
+		metadata map[string]string
+	}{
+		{"notFound", &ent.NotFoundError{}, codes.NotFound, ReasonNotFound, nil},
+		{"notSingular", &ent.NotSingularError{}, codes.FailedPrecondition, ReasonNotSingular, nil},
+		{"constraint", &ent.ConstraintError{}, codes.AlreadyExists, ReasonConflict, nil},
+		{
+			"cond", &crud.CondError{Field: "State", Op: "like"},
+			codes.InvalidArgument, ReasonInvalidCond, map[string]string{"field": "State", "op": "like"},
+		},
+		{
+			"reason", &reason.Error{ObjectType: "Kyc", State: "Rejected", Code: "BLURRY"},
+			codes.InvalidArgument, ReasonInvalidReason, map[string]string{"code": "BLURRY", "state": "Rejected"},
+		},
+		{
+			"transition", &crud.TransitionError{ObjectType: "Kyc", From: "Approved", To: "Wait"},
+			codes.FailedPrecondition, ReasonTransition, map[string]string{"from": "Approved", "to": "Wait"},
+		},
+		{
+			"leased", &crud.LeaseError{ID: id, ReviewerID: reviewerID},
+			codes.FailedPrecondition, ReasonLeased, map[string]string{"id": id.String()},
+		},
+		{
+			"decided", &crud.DecisionError{ID: id, ReviewerID: reviewerID},
+			codes.FailedPrecondition, ReasonDecided, map[string]string{"id": id.String(), "reviewer_id": reviewerID.String()},
+		},
+		{
+			"maxRows", &crud.MaxRowsError{MaxRows: 100},
+			codes.FailedPrecondition, ReasonTooManyRows, map[string]string{"max_rows": "100"},
+		},
+		{
+			"versionConflict", &crud.ConflictError{ID: id, Version: 3},
+			codes.Aborted, ReasonVersionConflict, map[string]string{"id": id.String()},
+		},
+		{"deny", privacy.Deny, codes.PermissionDenied, ReasonOtherApp, nil},
+		{"notPlatformAdmin", tenant.ErrNotPlatformAdmin, codes.PermissionDenied, ReasonOtherApp, nil},
+		{"canceled", context.Canceled, codes.Canceled, ReasonCanceled, nil},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded, ReasonCanceled, nil},
+		{"wrapped", fmt.Errorf("fail update review: %w", &ent.NotFoundError{}), codes.NotFound, ReasonNotFound, nil},
+		{"internal", fmt.Errorf("connection reset"), codes.Internal, ReasonInternal, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := mapError(tt.err)
+			assert.Equal(t, tt.code, mapping.code)
+			assert.Equal(t, tt.reason, mapping.reason)
+			assert.Equal(t, tt.metadata, mapping.metadata)
+
+			st, ok := status.FromError(toStatus(tt.err))
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+
+			details := st.Details()
+			if assert.Equal(t, 1, len(details)) {
+				info, ok := details[0].(*errdetails.ErrorInfo)
+				if assert.True(t, ok) {
+					assert.Equal(t, tt.reason, info.GetReason())
+					assert.Equal(t, servicename.ServiceName, info.GetDomain())
+					assert.Equal(t, len(tt.metadata), len(info.GetMetadata()))
+					for key, value := range tt.metadata {
+						assert.Equal(t, value, info.GetMetadata()[key])
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, toStatus(nil))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		err := status.Error(codes.InvalidArgument, "invalid domain")
+		assert.Equal(t, err, toStatus(err))
+	})
+}
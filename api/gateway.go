@@ -0,0 +1,481 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	valuedef "github.com/NpoolPlatform/message/npool"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	HeaderAppID     = "X-App-ID"
+	HeaderUserID    = "X-User-ID"
+	HeaderVersion   = "X-Review-Version"
+	HeaderUpdatedAt = "X-Review-Updated-At"
+	HeaderReasons   = "X-Review-Reasons"
+	// Stripped from the inbound requests, the platform admins are the users of platform_admin_user_ids
+	// in the service config
+	HeaderPlatformAdmin = "X-Platform-Admin"
+)
+
+// stripForged drops HeaderPlatformAdmin, and the Grpc-Metadata- prefixed headers the default incoming
+// matcher would forward as the app, user or admin metadata the gateway sets itself
+func stripForged(r *http.Request) {
+	r.Header.Del(HeaderPlatformAdmin)
+	for _, header := range []string{HeaderAppID, HeaderUserID, HeaderPlatformAdmin} {
+		r.Header.Del(runtime.MetadataHeaderPrefix + header)
+	}
+}
+
+type invoker func(context.Context, grpc.ClientConnInterface, proto.Message, ...grpc.CallOption) (proto.Message, error)
+
+// The admin routes call the cross app endpoints of extmgr, their requests keep the TargetAppID of the body.
+// service is the grpc service of method, the Manager service when empty.
+type route struct {
+	path    string
+	service string
+	method  string
+	admin   bool
+	req     func() proto.Message
+	invoke  invoker
+}
+
+var routes = []route{
+	{
+		path:   "/v1/create/review",
+		method: "CreateReview",
+		req:    func() proto.Message { return &npool.CreateReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).CreateReview(ctx, in.(*npool.CreateReviewRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/create/reviews",
+		method: "CreateReviews",
+		req:    func() proto.Message { return &npool.CreateReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).CreateReviews(ctx, in.(*npool.CreateReviewsRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/update/review",
+		method: "UpdateReview",
+		req:    func() proto.Message { return &npool.UpdateReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).UpdateReview(ctx, in.(*npool.UpdateReviewRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/get/review",
+		method: "GetReview",
+		req:    func() proto.Message { return &npool.GetReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).GetReview(ctx, in.(*npool.GetReviewRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/get/review/only",
+		method: "GetReviewOnly",
+		req:    func() proto.Message { return &npool.GetReviewOnlyRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).GetReviewOnly(ctx, in.(*npool.GetReviewOnlyRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/get/reviews",
+		method: "GetReviews",
+		req:    func() proto.Message { return &npool.GetReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).GetReviews(ctx, in.(*npool.GetReviewsRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/exist/review",
+		method: "ExistReview",
+		req:    func() proto.Message { return &npool.ExistReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).ExistReview(ctx, in.(*npool.ExistReviewRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/exist/review/conds",
+		method: "ExistReviewConds",
+		req:    func() proto.Message { return &npool.ExistReviewCondsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).ExistReviewConds(ctx, in.(*npool.ExistReviewCondsRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/count/reviews",
+		method: "CountReviews",
+		req:    func() proto.Message { return &npool.CountReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).CountReviews(ctx, in.(*npool.CountReviewsRequest), opts...)
+		},
+	},
+	{
+		path:   "/v1/delete/review",
+		method: "DeleteReview",
+		req:    func() proto.Message { return &npool.DeleteReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return npool.NewManagerClient(conn).DeleteReview(ctx, in.(*npool.DeleteReviewRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/create/app/review",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "CreateAppReview",
+		admin:   true,
+		req:     func() proto.Message { return &extmgr.CreateAppReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).CreateAppReview(ctx, in.(*extmgr.CreateAppReviewRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/create/app/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "CreateAppReviews",
+		admin:   true,
+		req:     func() proto.Message { return &extmgr.CreateAppReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).CreateAppReviews(ctx, in.(*extmgr.CreateAppReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/get/app/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "GetAppReviews",
+		admin:   true,
+		req:     func() proto.Message { return &extmgr.GetAppReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).GetAppReviews(ctx, in.(*extmgr.GetAppReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/get/review/history",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "GetReviewHistory",
+		req:     func() proto.Message { return &extmgr.GetReviewHistoryRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).GetReviewHistory(ctx, in.(*extmgr.GetReviewHistoryRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/query/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "QueryReviews",
+		req:     func() proto.Message { return &extmgr.QueryReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).QueryReviews(ctx, in.(*extmgr.QueryReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/query/reviews/after",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "QueryReviewsAfter",
+		req:     func() proto.Message { return &extmgr.QueryReviewsAfterRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).QueryReviewsAfter(ctx, in.(*extmgr.QueryReviewsAfterRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/claim/review",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "ClaimReview",
+		req:     func() proto.Message { return &extmgr.ClaimReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).ClaimReview(ctx, in.(*extmgr.ClaimReviewRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/release/review",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "ReleaseReview",
+		req:     func() proto.Message { return &extmgr.ReleaseReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).ReleaseReview(ctx, in.(*extmgr.ReleaseReviewRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/create/reviewer/pool",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "CreateReviewerPool",
+		req:     func() proto.Message { return &extmgr.CreateReviewerPoolRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).CreateReviewerPool(ctx, in.(*extmgr.CreateReviewerPoolRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/add/reviewer/pool/member",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "AddReviewerPoolMember",
+		req:     func() proto.Message { return &extmgr.AddReviewerPoolMemberRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).AddReviewerPoolMember(ctx, in.(*extmgr.AddReviewerPoolMemberRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/set/reviewer/on/shift",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "SetReviewerOnShift",
+		req:     func() proto.Message { return &extmgr.SetReviewerOnShiftRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).SetReviewerOnShift(ctx, in.(*extmgr.SetReviewerOnShiftRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/rebalance/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "RebalanceReviews",
+		req:     func() proto.Message { return &extmgr.RebalanceReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).RebalanceReviews(ctx, in.(*extmgr.RebalanceReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/restore/review",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "RestoreReview",
+		req:     func() proto.Message { return &extmgr.RestoreReviewRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).RestoreReview(ctx, in.(*extmgr.RestoreReviewRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/get/deleted/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "GetDeletedReviews",
+		req:     func() proto.Message { return &extmgr.GetDeletedReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).GetDeletedReviews(ctx, in.(*extmgr.GetDeletedReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/update/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "UpdateReviews",
+		req:     func() proto.Message { return &extmgr.UpdateReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).UpdateReviews(ctx, in.(*extmgr.UpdateReviewsRequest), opts...)
+		},
+	},
+	{
+		path:    "/v1/delete/reviews",
+		service: extmgr.ExtManager_ServiceDesc.ServiceName,
+		method:  "DeleteReviews",
+		req:     func() proto.Message { return &extmgr.DeleteReviewsRequest{} },
+		invoke: func(ctx context.Context, conn grpc.ClientConnInterface, in proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+			return extmgr.NewExtManagerClient(conn).DeleteReviews(ctx, in.(*extmgr.DeleteReviewsRequest), opts...)
+		},
+	},
+}
+
+func appConds(conds *npool.Conds, appID string) *npool.Conds {
+	if conds == nil {
+		conds = &npool.Conds{}
+	}
+	conds.AppID = &valuedef.StringVal{
+		Op:    cruder.EQ,
+		Value: appID,
+	}
+	return conds
+}
+
+// extAppConds also drops AppIDs, the header app is the only app of the query
+func extAppConds(conds *extmgr.Conds, appID string) *extmgr.Conds {
+	if conds == nil {
+		conds = &extmgr.Conds{}
+	}
+	conds.AppID = &valuedef.StringVal{
+		Op:    cruder.EQ,
+		Value: appID,
+	}
+	conds.AppIDs = nil
+	return conds
+}
+
+// mapHeaders applies the gateway header conventions of README to the request body
+func mapHeaders(r *http.Request, in proto.Message) {
+	appID := r.Header.Get(HeaderAppID)
+	userID := r.Header.Get(HeaderUserID)
+
+	switch req := in.(type) {
+	case *npool.CreateReviewRequest:
+		if appID != "" && req.Info != nil {
+			req.Info.AppID = &appID
+		}
+	case *npool.CreateReviewsRequest:
+		if appID != "" {
+			for _, info := range req.Infos {
+				info.AppID = &appID
+			}
+		}
+	case *npool.UpdateReviewRequest:
+		// Update never touches AppID
+		if userID != "" && req.Info != nil && req.Info.ReviewerID == nil {
+			req.Info.ReviewerID = &userID
+		}
+	case *npool.GetReviewOnlyRequest:
+		if appID != "" {
+			req.Conds = appConds(req.Conds, appID)
+		}
+	case *npool.GetReviewsRequest:
+		if appID != "" {
+			req.Conds = appConds(req.Conds, appID)
+		}
+	case *npool.ExistReviewCondsRequest:
+		if appID != "" {
+			req.Conds = appConds(req.Conds, appID)
+		}
+	case *npool.CountReviewsRequest:
+		if appID != "" {
+			req.Conds = appConds(req.Conds, appID)
+		}
+	case *extmgr.QueryReviewsRequest:
+		if appID != "" {
+			req.Conds = extAppConds(req.Conds, appID)
+		}
+	case *extmgr.QueryReviewsAfterRequest:
+		if appID != "" {
+			req.Conds = extAppConds(req.Conds, appID)
+		}
+	case *extmgr.GetDeletedReviewsRequest:
+		if appID != "" {
+			req.Conds = extAppConds(req.Conds, appID)
+		}
+	case *extmgr.UpdateReviewsRequest:
+		// Only scope conds the caller sent, an app cond alone would target the whole app
+		if appID != "" && req.Conds != nil {
+			req.Conds = extAppConds(req.Conds, appID)
+		}
+		if userID != "" && req.Info != nil && req.Info.ReviewerID == nil {
+			req.Info.ReviewerID = &userID
+		}
+	case *extmgr.DeleteReviewsRequest:
+		if appID != "" && req.Conds != nil {
+			req.Conds = extAppConds(req.Conds, appID)
+		}
+	case *extmgr.ClaimReviewRequest:
+		if userID != "" && req.ReviewerID == "" {
+			req.ReviewerID = userID
+		}
+	case *extmgr.ReleaseReviewRequest:
+		if userID != "" && req.ReviewerID == "" {
+			req.ReviewerID = userID
+		}
+	case *extmgr.CreateReviewerPoolRequest:
+		if appID != "" {
+			req.AppID = appID
+		}
+	}
+}
+
+// forwardVersion answers the review version in HeaderVersion, the header the next request sends it back in,
+// the mux of the service framework has no outgoing header matcher so it would go out as Grpc-Metadata-X-Review-Version
+func forwardVersion(w http.ResponseWriter, md *runtime.ServerMetadata) {
+	for _, version := range md.HeaderMD.Get(precondition.VersionKey) {
+		w.Header().Set(HeaderVersion, version)
+	}
+	md.HeaderMD.Delete(precondition.VersionKey)
+}
+
+func handler(mux *runtime.ServeMux, conn grpc.ClientConnInterface, rt route) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		inbound, outbound := runtime.MarshalerForRequest(mux, r)
+
+		service := rt.service
+		if service == "" {
+			service = npool.Manager_ServiceDesc.ServiceName
+		}
+
+		stripForged(r)
+
+		ctx, err := runtime.AnnotateContext(
+			ctx, mux, r,
+			fmt.Sprintf("/%v/%v", service, rt.method),
+			runtime.WithHTTPPathPattern(rt.path),
+		)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		in := rt.req()
+		if err := inbound.NewDecoder(r.Body).Decode(in); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		if !rt.admin {
+			mapHeaders(r, in)
+		}
+		if appID := r.Header.Get(HeaderAppID); appID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, tenant.AppIDKey, appID)
+		}
+		if userID := r.Header.Get(HeaderUserID); userID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, actor.UserIDKey, userID)
+		}
+		if version := r.Header.Get(HeaderVersion); version != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, precondition.VersionKey, version)
+		}
+		if updatedAt := r.Header.Get(HeaderUpdatedAt); updatedAt != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, precondition.UpdatedAtKey, updatedAt)
+		}
+		if reasons := r.Header.Get(HeaderReasons); reasons != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, reason.Key, reasons)
+		}
+
+		var md runtime.ServerMetadata
+		resp, err := rt.invoke(ctx, conn, in, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		forwardVersion(w, &md)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+func registerGateway(mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return fmt.Errorf("fail dial %v: %v", endpoint, err)
+	}
+
+	if err := handleRoutes(mux, conn); err != nil {
+		conn.Close()
+		return err
+	}
+
+	return nil
+}
+
+func handleRoutes(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	for _, rt := range routes {
+		if err := mux.HandlePath(http.MethodPost, rt.path, handler(mux, conn, rt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/google/uuid"
+)
+
+// fakeConn records the last call the gateway made instead of calling the service,
+// header is answered as the response header of the call.
+type fakeConn struct {
+	method string
+	md     metadata.MD
+	req    proto.Message
+	header metadata.MD
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	c.method = method
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	c.req = proto.Clone(args.(proto.Message))
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = c.header
+		}
+	}
+	return nil
+}
+
+func (c *fakeConn) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("no stream")
+}
+
+func serveGateway(t *testing.T, conn *fakeConn, path, body string, header map[string]string) *httptest.ResponseRecorder {
+	mux := runtime.NewServeMux()
+	if err := handleRoutes(mux, conn); err != nil {
+		t.Fatalf("fail handle routes: %v", err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	for key, val := range header {
+		r.Header.Set(key, val)
+	}
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	return w
+}
+
+func TestGateway(t *testing.T) {
+	appID := uuid.NewString()
+	userID := uuid.NewString()
+	reviewID := uuid.NewString()
+
+	t.Run("create", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/create/review",
+			fmt.Sprintf(`{"Info":{"AppID":"%v","Domain":"kyc"}}`, uuid.NewString()),
+			map[string]string{HeaderAppID: appID, HeaderUserID: userID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.v2.Manager/CreateReview", conn.method)
+
+		req, ok := conn.req.(*npool.CreateReviewRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetInfo().GetAppID())
+			assert.Equal(t, "kyc", req.GetInfo().GetDomain())
+		}
+		assert.Equal(t, []string{appID}, conn.md.Get(tenant.AppIDKey))
+		assert.Equal(t, []string{userID}, conn.md.Get(actor.UserIDKey))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/update/review",
+			fmt.Sprintf(`{"Info":{"ID":"%v","State":"Rejected"}}`, reviewID),
+			map[string]string{
+				HeaderUserID:    userID,
+				HeaderVersion:   "3",
+				HeaderUpdatedAt: "1000",
+				HeaderReasons:   "ID_PHOTO_BLURRY,NAME_MISMATCH",
+			},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.v2.Manager/UpdateReview", conn.method)
+
+		req, ok := conn.req.(*npool.UpdateReviewRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, reviewID, req.GetInfo().GetID())
+			assert.Equal(t, userID, req.GetInfo().GetReviewerID())
+		}
+		assert.Equal(t, []string{"3"}, conn.md.Get(precondition.VersionKey))
+		assert.Equal(t, []string{"1000"}, conn.md.Get(precondition.UpdatedAtKey))
+		assert.Equal(t, []string{"ID_PHOTO_BLURRY,NAME_MISMATCH"}, conn.md.Get(reason.Key))
+	})
+
+	t.Run("version", func(t *testing.T) {
+		conn := &fakeConn{header: metadata.Pairs(precondition.VersionKey, "4")}
+		w := serveGateway(t, conn, "/v1/get/review",
+			fmt.Sprintf(`{"ID":"%v"}`, reviewID),
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "4", w.Header().Get(HeaderVersion))
+		assert.Equal(t, "", w.Header().Get("Grpc-Metadata-X-Review-Version"))
+	})
+
+	t.Run("conds", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/get/reviews",
+			`{"Conds":{"Domain":{"Op":"eq","Value":"kyc"}},"Limit":10}`,
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		req, ok := conn.req.(*npool.GetReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, cruder.EQ, req.GetConds().GetAppID().GetOp())
+			assert.Equal(t, appID, req.GetConds().GetAppID().GetValue())
+			assert.Equal(t, "kyc", req.GetConds().GetDomain().GetValue())
+			assert.Equal(t, int32(10), req.GetLimit())
+		}
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		targetAppID := uuid.NewString()
+
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/get/app/reviews",
+			fmt.Sprintf(`{"TargetAppID":"%v"}`, targetAppID),
+			map[string]string{
+				HeaderAppID:         appID,
+				HeaderUserID:        userID,
+				HeaderPlatformAdmin: "true",
+				runtime.MetadataHeaderPrefix + HeaderPlatformAdmin: "true",
+				runtime.MetadataHeaderPrefix + HeaderUserID:        uuid.NewString(),
+			},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/GetAppReviews", conn.method)
+		assert.Nil(t, conn.md.Get("x-platform-admin"))
+		assert.Equal(t, []string{userID}, conn.md.Get(actor.UserIDKey))
+
+		req, ok := conn.req.(*extmgr.GetAppReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, targetAppID, req.GetTargetAppID())
+			assert.Nil(t, req.GetConds())
+		}
+	})
+
+	t.Run("ext", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/get/review/history",
+			fmt.Sprintf(`{"ID":"%v","Limit":5}`, reviewID),
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/GetReviewHistory", conn.method)
+
+		req, ok := conn.req.(*extmgr.GetReviewHistoryRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, reviewID, req.GetID())
+			assert.Equal(t, int32(5), req.GetLimit())
+		}
+	})
+
+	t.Run("extConds", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/query/reviews",
+			fmt.Sprintf(`{"Conds":{"AppIDs":{"Op":"in","Value":["%v"]},"States":{"Op":"in","Value":[0,1]}}}`, uuid.NewString()),
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/QueryReviews", conn.method)
+
+		req, ok := conn.req.(*extmgr.QueryReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetConds().GetAppID().GetValue())
+			assert.Nil(t, req.GetConds().GetAppIDs())
+			assert.Equal(t, []int32{0, 1}, req.GetConds().GetStates().GetValue())
+		}
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/get/deleted/reviews",
+			`{"Offset":0,"Limit":10}`,
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/GetDeletedReviews", conn.method)
+
+		req, ok := conn.req.(*extmgr.GetDeletedReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetConds().GetAppID().GetValue())
+			assert.Equal(t, int32(10), req.GetLimit())
+		}
+	})
+
+	t.Run("bulkUpdate", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/update/reviews",
+			fmt.Sprintf(`{"IDs":["%v"],"Info":{"State":"Approved"}}`, reviewID),
+			map[string]string{HeaderAppID: appID, HeaderUserID: userID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/UpdateReviews", conn.method)
+
+		req, ok := conn.req.(*extmgr.UpdateReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, []string{reviewID}, req.GetIDs())
+			assert.Nil(t, req.GetConds())
+			assert.Equal(t, userID, req.GetInfo().GetReviewerID())
+		}
+	})
+
+	t.Run("bulkDelete", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/delete/reviews",
+			`{"Conds":{"Domain":{"Op":"eq","Value":"kyc"}},"MaxRows":50,"DryRun":true}`,
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/DeleteReviews", conn.method)
+
+		req, ok := conn.req.(*extmgr.DeleteReviewsRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetConds().GetAppID().GetValue())
+			assert.Equal(t, uint32(50), req.GetMaxRows())
+			assert.True(t, req.GetDryRun())
+		}
+	})
+
+	t.Run("after", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/query/reviews/after",
+			`{"Token":"next","Limit":20}`,
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/QueryReviewsAfter", conn.method)
+
+		req, ok := conn.req.(*extmgr.QueryReviewsAfterRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetConds().GetAppID().GetValue())
+			assert.Equal(t, "next", req.GetToken())
+			assert.Equal(t, int32(20), req.GetLimit())
+		}
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/claim/review",
+			`{"Domain":"kyc","ObjectType":"ObjectKyc"}`,
+			map[string]string{HeaderAppID: appID, HeaderUserID: userID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/ClaimReview", conn.method)
+
+		req, ok := conn.req.(*extmgr.ClaimReviewRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, userID, req.GetReviewerID())
+			assert.Equal(t, npool.ReviewObjectType_ObjectKyc, req.GetObjectType())
+		}
+	})
+
+	t.Run("pool", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/create/reviewer/pool",
+			fmt.Sprintf(`{"AppID":"%v","Domain":"kyc","Strategy":"RoundRobin"}`, uuid.NewString()),
+			map[string]string{HeaderAppID: appID},
+		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/review.manager.ext.v2.ExtManager/CreateReviewerPool", conn.method)
+
+		req, ok := conn.req.(*extmgr.CreateReviewerPoolRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, appID, req.GetAppID())
+		}
+	})
+
+	t.Run("badBody", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/create/review", `{"Info":`, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "", conn.method)
+	})
+
+	t.Run("notFound", func(t *testing.T) {
+		conn := &fakeConn{}
+		w := serveGateway(t, conn, "/v1/create/object", `{}`, nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "", conn.method)
+	})
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/reviewevent"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// GetReviewHistory returns the events of a review, oldest first. The events are scoped by app like
+// their review, so the history of a review of another app comes back empty.
+func (s *Server) GetReviewHistory(ctx context.Context, in *extmgr.GetReviewHistoryRequest) (*extmgr.GetReviewHistoryResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "GetReviewHistory")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetID())
+	span = commontracer.TraceOffsetLimit(span, int(in.GetOffset()), int(in.GetLimit()))
+
+	id, err := uuid.Parse(in.GetID())
+	if err != nil {
+		return &extmgr.GetReviewHistoryResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Events carry the app of their review, the app scope of the caller applies to them directly
+	span = commontracer.TraceInvoker(span, "reviewevent", "crud", "Rows")
+
+	rows, total, err := eventcrud.Rows(ctx, id, int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		logger.Sugar().Errorf("fail get review history: %v", err)
+		return &extmgr.GetReviewHistoryResponse{}, toStatus(err)
+	}
+
+	return &extmgr.GetReviewHistoryResponse{
+		Infos: converter.Ent2GrpcMany(rows),
+		Total: uint32(total),
+	}, nil
+}
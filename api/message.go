@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+)
+
+// HandleReviewRequested creates the review requested by an upstream service.
+// It wraps msg.ErrInvalidMessage when the message should be dead-lettered,
+// any other error asks for a redelivery.
+func HandleReviewRequested(ctx context.Context, req *msg.ReviewRequested) error {
+	objectType, ok := npool.ReviewObjectType_value[req.ObjectType]
+	if !ok {
+		return fmt.Errorf("%w: invalid object type %v", msg.ErrInvalidMessage, req.ObjectType)
+	}
+	trigger, ok := npool.ReviewTriggerType_value[req.Trigger]
+	if !ok {
+		return fmt.Errorf("%w: invalid trigger %v", msg.ErrInvalidMessage, req.Trigger)
+	}
+
+	_objectType := npool.ReviewObjectType(objectType)
+	_trigger := npool.ReviewTriggerType(trigger)
+
+	in := &npool.ReviewReq{
+		ID:         req.ID,
+		AppID:      &req.AppID,
+		Domain:     &req.Domain,
+		ObjectID:   &req.ObjectID,
+		ObjectType: &_objectType,
+		Trigger:    &_trigger,
+	}
+	if err := ValidateCreate(ctx, in); err != nil {
+		return fmt.Errorf("%w: %v", msg.ErrInvalidMessage, err)
+	}
+
+	info, err := crud.Create(ctx, in)
+	if err != nil {
+		// A redelivered request whose review was already committed
+		if req.ID != nil && ent.IsConstraintError(err) {
+			return nil
+		}
+		if ent.IsValidationError(err) {
+			return fmt.Errorf("%w: %v", msg.ErrInvalidMessage, err)
+		}
+		logger.Sugar().Errorw("HandleReviewRequested", "error", err)
+		return err
+	}
+
+	logger.Sugar().Infow("HandleReviewRequested", "ID", info.ID, "Domain", info.Domain, "ObjectID", info.ObjectID)
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/reviewerpool"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	poolcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// The member endpoints check the pool with poolcrud.Row first, members carry no app
+// so the pool tells whether the caller may manage them.
+
+func (s *Server) CreateReviewerPool(ctx context.Context, in *extmgr.CreateReviewerPoolRequest) (*extmgr.CreateReviewerPoolResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "CreateReviewerPool")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.String("AppID", in.GetAppID()),
+		attribute.String("Domain", in.GetDomain()),
+		attribute.String("ObjectType", in.GetObjectType().String()),
+		attribute.String("Strategy", in.GetStrategy()),
+	)
+
+	appID, err := uuid.Parse(in.GetAppID())
+	if err != nil {
+		return &extmgr.CreateReviewerPoolResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid app id: %v", err))
+	}
+	if in.GetDomain() == "" {
+		return &extmgr.CreateReviewerPoolResponse{}, status.Error(codes.InvalidArgument, "invalid domain")
+	}
+	if err := validateObjectType(int32(in.GetObjectType())); err != nil {
+		return &extmgr.CreateReviewerPoolResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := poolcrud.ValidateStrategy(in.GetStrategy()); err != nil {
+		return &extmgr.CreateReviewerPoolResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "CreatePool")
+
+	info, err := poolcrud.CreatePool(ctx, appID, in.GetDomain(), in.GetObjectType(), in.GetStrategy())
+	if err != nil {
+		logger.Sugar().Errorf("fail create reviewer pool: %v", err)
+		return &extmgr.CreateReviewerPoolResponse{}, toStatus(err)
+	}
+
+	return &extmgr.CreateReviewerPoolResponse{
+		Info: converter.Ent2Grpc(info),
+	}, nil
+}
+
+func (s *Server) AddReviewerPoolMember(
+	ctx context.Context,
+	in *extmgr.AddReviewerPoolMemberRequest,
+) (*extmgr.AddReviewerPoolMemberResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "AddReviewerPoolMember")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetPoolID())
+	span.SetAttributes(
+		attribute.String("ReviewerID", in.GetReviewerID()),
+		attribute.Int64("Weight", int64(in.GetWeight())),
+	)
+
+	poolID, err := uuid.Parse(in.GetPoolID())
+	if err != nil {
+		return &extmgr.AddReviewerPoolMemberResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid pool id: %v", err))
+	}
+	reviewerID, err := uuid.Parse(in.GetReviewerID())
+	if err != nil {
+		return &extmgr.AddReviewerPoolMemberResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid reviewer id: %v", err))
+	}
+	// The picker skips members of weight 0, such a member would never get a review
+	if in.GetWeight() == 0 {
+		return &extmgr.AddReviewerPoolMemberResponse{}, status.Error(codes.InvalidArgument, "invalid weight")
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "Row")
+
+	if _, err = poolcrud.Row(ctx, poolID); err != nil {
+		logger.Sugar().Errorf("fail get reviewer pool: %v", err)
+		return &extmgr.AddReviewerPoolMemberResponse{}, toStatus(err)
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "AddMember")
+
+	info, err := poolcrud.AddMember(ctx, poolID, reviewerID, in.GetWeight())
+	if err != nil {
+		logger.Sugar().Errorf("fail add reviewer pool member: %v", err)
+		return &extmgr.AddReviewerPoolMemberResponse{}, toStatus(err)
+	}
+
+	return &extmgr.AddReviewerPoolMemberResponse{
+		Info: converter.Member2Grpc(info),
+	}, nil
+}
+
+func (s *Server) SetReviewerOnShift(ctx context.Context, in *extmgr.SetReviewerOnShiftRequest) (*extmgr.SetReviewerOnShiftResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "SetReviewerOnShift")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetPoolID())
+	span.SetAttributes(
+		attribute.String("ReviewerID", in.GetReviewerID()),
+		attribute.Bool("OnShift", in.GetOnShift()),
+	)
+
+	poolID, err := uuid.Parse(in.GetPoolID())
+	if err != nil {
+		return &extmgr.SetReviewerOnShiftResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid pool id: %v", err))
+	}
+	reviewerID, err := uuid.Parse(in.GetReviewerID())
+	if err != nil {
+		return &extmgr.SetReviewerOnShiftResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid reviewer id: %v", err))
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "Row")
+
+	if _, err = poolcrud.Row(ctx, poolID); err != nil {
+		logger.Sugar().Errorf("fail get reviewer pool: %v", err)
+		return &extmgr.SetReviewerOnShiftResponse{}, toStatus(err)
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "SetOnShift")
+
+	info, err := poolcrud.SetOnShift(ctx, poolID, reviewerID, in.GetOnShift())
+	if err != nil {
+		logger.Sugar().Errorf("fail set reviewer on shift: %v", err)
+		return &extmgr.SetReviewerOnShiftResponse{}, toStatus(err)
+	}
+
+	return &extmgr.SetReviewerOnShiftResponse{
+		Info: converter.Member2Grpc(info),
+	}, nil
+}
+
+func (s *Server) RebalanceReviews(ctx context.Context, in *extmgr.RebalanceReviewsRequest) (*extmgr.RebalanceReviewsResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "RebalanceReviews")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, in.GetPoolID())
+
+	poolID, err := uuid.Parse(in.GetPoolID())
+	if err != nil {
+		return &extmgr.RebalanceReviewsResponse{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid pool id: %v", err))
+	}
+
+	span = commontracer.TraceInvoker(span, "reviewerpool", "crud", "Row")
+
+	if _, err = poolcrud.Row(ctx, poolID); err != nil {
+		logger.Sugar().Errorf("fail get reviewer pool: %v", err)
+		return &extmgr.RebalanceReviewsResponse{}, toStatus(err)
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "Rebalance")
+
+	moved, err := crud.Rebalance(ctx, poolID)
+	if err != nil {
+		logger.Sugar().Errorf("fail rebalance reviews: %v", err)
+		return &extmgr.RebalanceReviewsResponse{}, toStatus(err)
+	}
+
+	return &extmgr.RebalanceReviewsResponse{
+		Moved: uint32(moved),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+)
+
+func TestAddReviewerPoolMemberWeight(t *testing.T) {
+	s := &Server{}
+
+	_, err := s.AddReviewerPoolMember(context.Background(), &extmgr.AddReviewerPoolMemberRequest{
+		PoolID:     uuid.New().String(),
+		ReviewerID: uuid.New().String(),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
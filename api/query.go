@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"go.opentelemetry.io/otel"
+	scodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	valuedef "github.com/NpoolPlatform/message/npool"
+
+	"github.com/google/uuid"
+)
+
+// validateListOp accepts the list ops with at least one value, single and plural field of a cond are exclusive
+func validateListOp(field, op string, values int, single bool) error {
+	if single {
+		return fmt.Errorf("field %v and %vs are exclusive", field, field)
+	}
+	switch op {
+	case cruder.IN:
+	case crud.NIN:
+	default:
+		return fmt.Errorf("invalid op %v of field %vs", op, field)
+	}
+	if values == 0 {
+		return fmt.Errorf("op %v of field %vs needs values", op, field)
+	}
+	return nil
+}
+
+func validateUUIDs(field, op string, values []string, single bool) error {
+	if err := validateListOp(field, op, len(values), single); err != nil {
+		return err
+	}
+	for _, value := range values {
+		if _, err := uuid.Parse(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEnums(field, op string, values []int32, single bool, validate func(int32) error) error {
+	if err := validateListOp(field, op, len(values), single); err != nil {
+		return err
+	}
+	for _, value := range values {
+		if err := validate(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateTimeBounds(field string, bounds []*valuedef.Uint32Val) error {
+	for _, bound := range bounds {
+		switch bound.GetOp() {
+		case cruder.EQ:
+		case cruder.GT:
+		case crud.GTE:
+		case cruder.LT:
+		case crud.LTE:
+		default:
+			return fmt.Errorf("invalid op %v of field %v", bound.GetOp(), field)
+		}
+	}
+	return nil
+}
+
+func ValidateOrders(orders []*extmgr.Order) error {
+	for _, order := range crud.ConvertOrders(orders) {
+		if err := crud.ValidateOrder(order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateExtConds(conds *extmgr.Conds) error { //nolint
+	if conds == nil {
+		return nil
+	}
+	if err := ValidateConds(extmgr.SingleConds(conds)); err != nil {
+		return err
+	}
+	if conds.IDs != nil {
+		if err := validateUUIDs("ID", conds.GetIDs().GetOp(), conds.GetIDs().GetValue(), conds.ID != nil); err != nil {
+			return err
+		}
+	}
+	if conds.AppIDs != nil {
+		if err := validateUUIDs("AppID", conds.GetAppIDs().GetOp(), conds.GetAppIDs().GetValue(), conds.AppID != nil); err != nil {
+			return err
+		}
+	}
+	if conds.ReviewerIDs != nil {
+		if err := validateUUIDs(
+			"ReviewerID", conds.GetReviewerIDs().GetOp(), conds.GetReviewerIDs().GetValue(), conds.ReviewerID != nil,
+		); err != nil {
+			return err
+		}
+	}
+	if conds.Domains != nil {
+		if err := validateListOp(
+			"Domain", conds.GetDomains().GetOp(), len(conds.GetDomains().GetValue()), conds.Domain != nil,
+		); err != nil {
+			return err
+		}
+		for _, domain := range conds.GetDomains().GetValue() {
+			if domain == "" {
+				return fmt.Errorf("invalid domain")
+			}
+		}
+	}
+	if conds.ObjectIDs != nil {
+		if err := validateUUIDs("ObjectID", conds.GetObjectIDs().GetOp(), conds.GetObjectIDs().GetValue(), conds.ObjectID != nil); err != nil {
+			return err
+		}
+	}
+	if conds.Triggers != nil {
+		if err := validateEnums(
+			"Trigger", conds.GetTriggers().GetOp(), conds.GetTriggers().GetValue(), conds.Trigger != nil, validateTrigger,
+		); err != nil {
+			return err
+		}
+	}
+	if conds.ObjectTypes != nil {
+		if err := validateEnums(
+			"ObjectType", conds.GetObjectTypes().GetOp(), conds.GetObjectTypes().GetValue(), conds.ObjectType != nil, validateObjectType,
+		); err != nil {
+			return err
+		}
+	}
+	if conds.States != nil {
+		if err := validateEnums(
+			"State", conds.GetStates().GetOp(), conds.GetStates().GetValue(), conds.State != nil, validateState,
+		); err != nil {
+			return err
+		}
+	}
+	if err := validateTimeBounds("CreatedAt", conds.GetCreatedAt()); err != nil {
+		return err
+	}
+	if err := validateTimeBounds("UpdatedAt", conds.GetUpdatedAt()); err != nil {
+		return err
+	}
+	if err := validateTimeBounds("DueAt", conds.GetDueAt()); err != nil {
+		return err
+	}
+	if conds.Overdue != nil && conds.GetOverdue().GetOp() != cruder.EQ {
+		return fmt.Errorf("invalid op %v of field Overdue", conds.GetOverdue().GetOp())
+	}
+	if conds.Escalated != nil && conds.GetEscalated().GetOp() != cruder.EQ {
+		return fmt.Errorf("invalid op %v of field Escalated", conds.GetEscalated().GetOp())
+	}
+	if conds.Reason != nil {
+		if op := conds.GetReason().GetOp(); op != cruder.EQ && op != crud.NEQ {
+			return fmt.Errorf("invalid op %v of field Reason", op)
+		}
+		if conds.GetReason().GetValue() == "" {
+			return fmt.Errorf("invalid Reason")
+		}
+	}
+
+	return nil
+}
+
+// QueryReviews is GetReviews with the list conds, time bounds and orders of extmgr
+func (s *Server) QueryReviews(ctx context.Context, in *extmgr.QueryReviewsRequest) (*extmgr.QueryReviewsResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "QueryReviews")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = tracer.TraceExtConds(span, in.GetConds())
+	span = commontracer.TraceOffsetLimit(span, int(in.GetOffset()), int(in.GetLimit()))
+
+	if err := ValidateExtConds(in.GetConds()); err != nil {
+		return &extmgr.QueryReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := ValidateOrders(in.GetOrders()); err != nil {
+		return &extmgr.QueryReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "Rows")
+
+	rows, total, err := crud.Rows(
+		ctx,
+		crud.ConvertExtConds(in.GetConds()),
+		int(in.GetOffset()),
+		int(in.GetLimit()),
+		crud.ConvertOrders(in.GetOrders())...,
+	)
+	if err != nil {
+		logger.Sugar().Errorf("fail query reviews: %v", err)
+		return &extmgr.QueryReviewsResponse{}, toStatus(err)
+	}
+
+	return &extmgr.QueryReviewsResponse{
+		Infos:   converter.Ent2GrpcMany(rows),
+		Total:   uint32(total),
+		Details: converter.Ent2DetailMany(rows),
+	}, nil
+}
+
+// QueryReviewsAfter is QueryReviews paging by the token of crud.RowsAfter instead of offset
+func (s *Server) QueryReviewsAfter(ctx context.Context, in *extmgr.QueryReviewsAfterRequest) (*extmgr.QueryReviewsAfterResponse, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "QueryReviewsAfter")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(scodes.Error, err.Error())
+			span.RecordError(err)
+		}
+	}()
+
+	span = tracer.TraceExtConds(span, in.GetConds())
+	span = commontracer.TraceOffsetLimit(span, 0, int(in.GetLimit()))
+
+	if err := ValidateExtConds(in.GetConds()); err != nil {
+		return &extmgr.QueryReviewsAfterResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := crud.ValidateCursor(in.GetToken()); err != nil {
+		return &extmgr.QueryReviewsAfterResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.GetLimit() <= 0 {
+		return &extmgr.QueryReviewsAfterResponse{}, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+
+	span = commontracer.TraceInvoker(span, "review", "crud", "RowsAfter")
+
+	rows, next, err := crud.RowsAfter(ctx, crud.ConvertExtConds(in.GetConds()), in.GetToken(), int(in.GetLimit()))
+	if err != nil {
+		logger.Sugar().Errorf("fail query reviews after: %v", err)
+		return &extmgr.QueryReviewsAfterResponse{}, toStatus(err)
+	}
+
+	return &extmgr.QueryReviewsAfterResponse{
+		Infos:     converter.Ent2GrpcMany(rows),
+		NextToken: next,
+		Details:   converter.Ent2DetailMany(rows),
+	}, nil
+}
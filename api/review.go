@@ -7,6 +7,7 @@ import (
 
 	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
 	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
 	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
 	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
 
@@ -18,12 +19,15 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
 
 	"github.com/google/uuid"
 )
 
-func ValidateCreate(in *npool.ReviewReq) error {
+// ValidateCreate also checks the reason codes sent in reason.Key. A new review waits,
+// so no code of the catalogue is valid for it.
+func ValidateCreate(ctx context.Context, in *npool.ReviewReq) error {
 	if in.ID != nil {
 		if _, err := uuid.Parse(in.GetID()); err != nil {
 			return err
@@ -43,13 +47,16 @@ func ValidateCreate(in *npool.ReviewReq) error {
 	if _, err := uuid.Parse(in.GetObjectID()); err != nil {
 		return err
 	}
+	if err := reason.Validate(in.GetObjectType().String(), npool.ReviewState_Wait, reason.FromContext(ctx)); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func ValidateManyCreate(in []*npool.ReviewReq) error {
+func ValidateManyCreate(ctx context.Context, in []*npool.ReviewReq) error {
 	for _, info := range in {
-		if err := ValidateCreate(info); err != nil {
+		if err := ValidateCreate(ctx, info); err != nil {
 			return err
 		}
 	}
@@ -71,7 +78,7 @@ func (s *Server) CreateReview(ctx context.Context, in *npool.CreateReviewRequest
 
 	span = tracer.Trace(span, in.GetInfo())
 
-	if err := ValidateCreate(in.GetInfo()); err != nil {
+	if err := ValidateCreate(ctx, in.GetInfo()); err != nil {
 		return &npool.CreateReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -80,9 +87,11 @@ func (s *Server) CreateReview(ctx context.Context, in *npool.CreateReviewRequest
 	info, err := crud.Create(ctx, in.GetInfo())
 	if err != nil {
 		logger.Sugar().Errorf("fail create review: %v", err.Error())
-		return &npool.CreateReviewResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.CreateReviewResponse{}, toStatus(err)
 	}
 
+	setVersion(ctx, info)
+
 	return &npool.CreateReviewResponse{
 		Info: converter.Ent2Grpc(info),
 	}, nil
@@ -105,7 +114,7 @@ func (s *Server) CreateReviews(ctx context.Context, in *npool.CreateReviewsReque
 		return &npool.CreateReviewsResponse{}, status.Error(codes.InvalidArgument, "Infos is empty")
 	}
 
-	if err := ValidateManyCreate(in.GetInfos()); err != nil {
+	if err := ValidateManyCreate(ctx, in.GetInfos()); err != nil {
 		return &npool.CreateReviewsResponse{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -115,7 +124,7 @@ func (s *Server) CreateReviews(ctx context.Context, in *npool.CreateReviewsReque
 	rows, err := crud.CreateBulk(ctx, in.GetInfos())
 	if err != nil {
 		logger.Sugar().Errorf("fail create reviews: %v", err)
-		return &npool.CreateReviewsResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.CreateReviewsResponse{}, toStatus(err)
 	}
 
 	return &npool.CreateReviewsResponse{
@@ -123,6 +132,24 @@ func (s *Server) CreateReviews(ctx context.Context, in *npool.CreateReviewsReque
 	}, nil
 }
 
+// validateUpdate checks the fields UpdateReview and UpdateReviews apply, and the reasons sent with them
+func validateUpdate(ctx context.Context, in *npool.ReviewReq) error {
+	if _, err := uuid.Parse(in.GetReviewerID()); err != nil {
+		return err
+	}
+	reasons := reason.FromContext(ctx)
+	if len(reasons) > 0 && in.State == nil {
+		return fmt.Errorf("reasons need a state")
+	}
+	// A rejection tells why, with reason codes or a message
+	if in.State != nil && in.GetState() == npool.ReviewState_Rejected {
+		if in.GetMessage() == "" && len(reasons) == 0 {
+			return fmt.Errorf("rejection needs reasons or a message")
+		}
+	}
+	return nil
+}
+
 func (s *Server) UpdateReview(ctx context.Context, in *npool.UpdateReviewRequest) (*npool.UpdateReviewResponse, error) {
 	var err error
 
@@ -142,25 +169,21 @@ func (s *Server) UpdateReview(ctx context.Context, in *npool.UpdateReviewRequest
 		logger.Sugar().Errorw("UpdateReview", "ID", in.GetInfo().GetID(), "error", err)
 		return &npool.UpdateReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if _, err := uuid.Parse(in.GetInfo().GetReviewerID()); err != nil {
+	if err := validateUpdate(ctx, in.GetInfo()); err != nil {
 		logger.Sugar().Errorw("UpdateReview", "ReviewerID", in.GetInfo().GetReviewerID(), "error", err)
 		return &npool.UpdateReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if in.GetInfo().State != nil && in.GetInfo().GetState() == npool.ReviewState_Rejected {
-		if in.GetInfo().GetMessage() == "" {
-			logger.Sugar().Errorw("UpdateReview", "Message", in.GetInfo().GetMessage())
-			return &npool.UpdateReviewResponse{}, status.Error(codes.InvalidArgument, err.Error())
-		}
-	}
 
 	span = commontracer.TraceInvoker(span, "review", "crud", "Update")
 
 	info, err := crud.Update(ctx, in.GetInfo())
 	if err != nil {
 		logger.Sugar().Errorf("fail create review: %v", err.Error())
-		return &npool.UpdateReviewResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.UpdateReviewResponse{}, toStatus(err)
 	}
 
+	setVersion(ctx, info)
+
 	return &npool.UpdateReviewResponse{
 		Info: converter.Ent2Grpc(info),
 	}, nil
@@ -191,66 +214,130 @@ func (s *Server) GetReview(ctx context.Context, in *npool.GetReviewRequest) (*np
 	info, err := crud.Row(ctx, id)
 	if err != nil {
 		logger.Sugar().Errorf("fail get review: %v", err)
-		return &npool.GetReviewResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.GetReviewResponse{}, toStatus(err)
 	}
 
+	setVersion(ctx, info)
+
 	return &npool.GetReviewResponse{
 		Info: converter.Ent2Grpc(info),
 	}, nil
 }
 
+// validateOp accepts the single value ops, the list ops of crud.Conds can't be carried by npool.Conds,
+// they go through the plural fields of extmgr.Conds
+func validateOp(field, op string) error {
+	switch op {
+	case cruder.EQ:
+	case crud.NEQ:
+	case cruder.IN, crud.NIN:
+		return fmt.Errorf("op %v of field %v needs a list value", op, field)
+	default:
+		return fmt.Errorf("invalid op %v of field %v", op, field)
+	}
+	return nil
+}
+
+func validateTrigger(value int32) error {
+	switch npool.ReviewTriggerType(value) {
+	case npool.ReviewTriggerType_AutoReviewed:
+	case npool.ReviewTriggerType_LargeAmount:
+	case npool.ReviewTriggerType_InsufficientFunds:
+	case npool.ReviewTriggerType_InsufficientGas:
+	case npool.ReviewTriggerType_InsufficientFundsGas:
+	default:
+		return fmt.Errorf("invalid trigger")
+	}
+	return nil
+}
+
+func validateObjectType(value int32) error {
+	switch npool.ReviewObjectType(value) {
+	case npool.ReviewObjectType_ObjectKyc:
+	case npool.ReviewObjectType_ObjectWithdrawal:
+	default:
+		return fmt.Errorf("invalid object type")
+	}
+	return nil
+}
+
+func validateState(value int32) error {
+	switch npool.ReviewState(value) {
+	case npool.ReviewState_Wait:
+	case npool.ReviewState_Approved:
+	case npool.ReviewState_Rejected:
+	default:
+		return fmt.Errorf("invalid state")
+	}
+	return nil
+}
+
 func ValidateConds(conds *npool.Conds) error { //nolint
+	if conds == nil {
+		return nil
+	}
 	if conds.ID != nil {
+		if err := validateOp("ID", conds.GetID().GetOp()); err != nil {
+			return err
+		}
 		if _, err := uuid.Parse(conds.GetID().GetValue()); err != nil {
 			return err
 		}
 	}
 	if conds.AppID != nil {
+		if err := validateOp("AppID", conds.GetAppID().GetOp()); err != nil {
+			return err
+		}
 		if _, err := uuid.Parse(conds.GetAppID().GetValue()); err != nil {
 			return err
 		}
 	}
 	if conds.ReviewerID != nil {
+		if err := validateOp("ReviewerID", conds.GetReviewerID().GetOp()); err != nil {
+			return err
+		}
 		if _, err := uuid.Parse(conds.GetReviewerID().GetValue()); err != nil {
 			return err
 		}
 	}
 	if conds.Domain != nil {
+		if err := validateOp("Domain", conds.GetDomain().GetOp()); err != nil {
+			return err
+		}
 		if conds.GetDomain().GetValue() == "" {
 			return fmt.Errorf("invalid domain")
 		}
 	}
 	if conds.ObjectID != nil {
+		if err := validateOp("ObjectID", conds.GetObjectID().GetOp()); err != nil {
+			return err
+		}
 		if _, err := uuid.Parse(conds.GetObjectID().GetValue()); err != nil {
 			return err
 		}
 	}
 	if conds.Trigger != nil {
-		switch npool.ReviewTriggerType(conds.GetTrigger().GetValue()) {
-		case npool.ReviewTriggerType_AutoReviewed:
-		case npool.ReviewTriggerType_LargeAmount:
-		case npool.ReviewTriggerType_InsufficientFunds:
-		case npool.ReviewTriggerType_InsufficientGas:
-		case npool.ReviewTriggerType_InsufficientFundsGas:
-		default:
-			return fmt.Errorf("invalid trigger")
+		if err := validateOp("Trigger", conds.GetTrigger().GetOp()); err != nil {
+			return err
+		}
+		if err := validateTrigger(conds.GetTrigger().GetValue()); err != nil {
+			return err
 		}
 	}
 	if conds.ObjectType != nil {
-		switch npool.ReviewObjectType(conds.GetObjectType().GetValue()) {
-		case npool.ReviewObjectType_ObjectKyc:
-		case npool.ReviewObjectType_ObjectWithdrawal:
-		default:
-			return fmt.Errorf("invalid object type")
+		if err := validateOp("ObjectType", conds.GetObjectType().GetOp()); err != nil {
+			return err
+		}
+		if err := validateObjectType(conds.GetObjectType().GetValue()); err != nil {
+			return err
 		}
 	}
 	if conds.State != nil {
-		switch npool.ReviewState(conds.GetState().GetValue()) {
-		case npool.ReviewState_Wait:
-		case npool.ReviewState_Approved:
-		case npool.ReviewState_Rejected:
-		default:
-			return fmt.Errorf("invalid state")
+		if err := validateOp("State", conds.GetState().GetOp()); err != nil {
+			return err
+		}
+		if err := validateState(conds.GetState().GetValue()); err != nil {
+			return err
 		}
 	}
 
@@ -278,12 +365,14 @@ func (s *Server) GetReviewOnly(ctx context.Context, in *npool.GetReviewOnlyReque
 
 	span = commontracer.TraceInvoker(span, "review", "crud", "RowOnly")
 
-	info, err := crud.RowOnly(ctx, in.GetConds())
+	info, err := crud.RowOnly(ctx, crud.ConvertConds(in.GetConds()))
 	if err != nil {
 		logger.Sugar().Errorf("fail get reviews: %v", err)
-		return &npool.GetReviewOnlyResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.GetReviewOnlyResponse{}, toStatus(err)
 	}
 
+	setVersion(ctx, info)
+
 	return &npool.GetReviewOnlyResponse{
 		Info: converter.Ent2Grpc(info),
 	}, nil
@@ -311,10 +400,10 @@ func (s *Server) GetReviews(ctx context.Context, in *npool.GetReviewsRequest) (*
 
 	span = commontracer.TraceInvoker(span, "review", "crud", "Rows")
 
-	rows, total, err := crud.Rows(ctx, in.GetConds(), int(in.GetOffset()), int(in.GetLimit()))
+	rows, total, err := crud.Rows(ctx, crud.ConvertConds(in.GetConds()), int(in.GetOffset()), int(in.GetLimit()))
 	if err != nil {
 		logger.Sugar().Errorf("fail get reviews: %v", err)
-		return &npool.GetReviewsResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.GetReviewsResponse{}, toStatus(err)
 	}
 
 	return &npool.GetReviewsResponse{
@@ -348,7 +437,7 @@ func (s *Server) ExistReview(ctx context.Context, in *npool.ExistReviewRequest)
 	exist, err := crud.Exist(ctx, id)
 	if err != nil {
 		logger.Sugar().Errorf("fail check review: %v", err)
-		return &npool.ExistReviewResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.ExistReviewResponse{}, toStatus(err)
 	}
 
 	return &npool.ExistReviewResponse{
@@ -378,10 +467,10 @@ func (s *Server) ExistReviewConds(ctx context.Context,
 
 	span = commontracer.TraceInvoker(span, "review", "crud", "ExistConds")
 
-	exist, err := crud.ExistConds(ctx, in.GetConds())
+	exist, err := crud.ExistConds(ctx, crud.ConvertConds(in.GetConds()))
 	if err != nil {
 		logger.Sugar().Errorf("fail check review: %v", err)
-		return &npool.ExistReviewCondsResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.ExistReviewCondsResponse{}, toStatus(err)
 	}
 
 	return &npool.ExistReviewCondsResponse{
@@ -410,10 +499,10 @@ func (s *Server) CountReviews(ctx context.Context, in *npool.CountReviewsRequest
 
 	span = commontracer.TraceInvoker(span, "review", "crud", "Count")
 
-	total, err := crud.Count(ctx, in.GetConds())
+	total, err := crud.Count(ctx, crud.ConvertConds(in.GetConds()))
 	if err != nil {
 		logger.Sugar().Errorf("fail count reviews: %v", err)
-		return &npool.CountReviewsResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.CountReviewsResponse{}, toStatus(err)
 	}
 
 	return &npool.CountReviewsResponse{
@@ -446,9 +535,11 @@ func (s *Server) DeleteReview(ctx context.Context, in *npool.DeleteReviewRequest
 	info, err := crud.Delete(ctx, id)
 	if err != nil {
 		logger.Sugar().Errorf("fail delete review: %v", err)
-		return &npool.DeleteReviewResponse{}, status.Error(codes.Internal, err.Error())
+		return &npool.DeleteReviewResponse{}, toStatus(err)
 	}
 
+	setVersion(ctx, info)
+
 	return &npool.DeleteReviewResponse{
 		Info: converter.Ent2Grpc(info),
 	}, nil
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// setVersion returns the review version in the response header, the Review message has no field for it.
+// Clients send it back in precondition.VersionKey to update only the version they read.
+func setVersion(ctx context.Context, info *ent.Review) {
+	err := grpc.SetHeader(ctx, metadata.Pairs(precondition.VersionKey, fmt.Sprintf("%v", info.Version)))
+	if err != nil {
+		logger.Sugar().Warnw("setVersion", "ID", info.ID, "error", err)
+	}
+}
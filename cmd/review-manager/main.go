@@ -19,6 +19,8 @@ import (
 func main() {
 	commands := cli.Commands{
 		runCmd,
+		migrateCmd,
+		purgeCmd,
 	}
 
 	description := fmt.Sprintf("my %v service cli\nFor help on any individual command run <%v COMMAND -h>\n",
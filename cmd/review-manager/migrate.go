@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/migrator"
+
+	cli "github.com/urfave/cli/v2"
+)
+
+var migrateCmd = &cli.Command{
+	Name:  "migrate",
+	Usage: "Migrate the database schema",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Print the schema DDL and pending steps without applying them",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if err := db.Init(); err != nil {
+			return err
+		}
+
+		if c.Bool("dry-run") {
+			return migrator.DryRun(c.Context, os.Stdout)
+		}
+
+		return migrator.Migrate(c.Context)
+	},
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/config"
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	outboxcrud "github.com/NpoolPlatform/review-manager/pkg/crud/outbox"
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+
+	cli "github.com/urfave/cli/v2"
+)
+
+const (
+	keyRetentionDays           = "review_retention_days"
+	defaultRetentionDays       = 180
+	keyOutboxRetentionDays     = "outbox_retention_days"
+	defaultOutboxRetentionDays = 7
+	purgeBatchSize             = 500
+)
+
+// retentionDays takes flag, else key of the service config, else defaultDays.
+// A flag of zero or less would purge everything up to now so it is refused.
+func retentionDays(c *cli.Context, flag, key string, defaultDays int) (int, error) {
+	if c.IsSet(flag) {
+		days := c.Int(flag)
+		if days <= 0 {
+			return 0, fmt.Errorf("invalid %v %v, must be positive", flag, days)
+		}
+		return days, nil
+	}
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	if val := config.GetIntValueWithNameSpace(hostname, key); val > 0 {
+		return val, nil
+	}
+	return defaultDays, nil
+}
+
+// purge calls f with before until a batch comes back short, and returns the total
+func purge(f func(before uint32, limit int) (int, error), before uint32) (int, error) {
+	total := 0
+	for {
+		purged, err := f(before, purgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += purged
+		if purged < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func daysBefore(days int) uint32 {
+	return uint32(time.Now().Add(-time.Duration(days) * 24 * time.Hour).Unix())
+}
+
+var purgeCmd = &cli.Command{
+	Name:  "purge",
+	Usage: "Hard delete reviews soft deleted, and outbox messages delivered, longer than their retention periods",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "retention-days",
+			Usage: "Days a soft deleted review is kept, defaults to review_retention_days of the service config",
+		},
+		&cli.IntFlag{
+			Name:  "outbox-retention-days",
+			Usage: "Days a delivered outbox message is kept, defaults to outbox_retention_days of the service config",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		days, err := retentionDays(c, "retention-days", keyRetentionDays, defaultRetentionDays)
+		if err != nil {
+			return err
+		}
+		outboxDays, err := retentionDays(c, "outbox-retention-days", keyOutboxRetentionDays, defaultOutboxRetentionDays)
+		if err != nil {
+			return err
+		}
+
+		if err := db.Init(); err != nil {
+			return err
+		}
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.Sugar().Errorf("fail to close db: %v", err)
+			}
+		}()
+
+		deletedBefore := daysBefore(days)
+
+		total, err := purge(func(before uint32, limit int) (int, error) {
+			return crud.Purge(c.Context, before, limit)
+		}, deletedBefore)
+		if err != nil {
+			return err
+		}
+
+		logger.Sugar().Infow("purge", "RetentionDays", days, "DeletedBefore", deletedBefore, "Purged", total)
+
+		deliveredBefore := daysBefore(outboxDays)
+
+		total, err = purge(func(before uint32, limit int) (int, error) {
+			return outboxcrud.Purge(c.Context, before, limit)
+		}, deliveredBefore)
+		if err != nil {
+			return err
+		}
+
+		logger.Sugar().Infow("purge", "OutboxRetentionDays", outboxDays, "DeliveredBefore", deliveredBefore, "Purged", total)
+
+		return nil
+	},
+}
@@ -1,9 +1,15 @@
 package main
 
 import (
+	"context"
+
 	"github.com/NpoolPlatform/review-manager/api"
 	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/escalator"
+	"github.com/NpoolPlatform/review-manager/pkg/message/listener"
+	msgsrv "github.com/NpoolPlatform/review-manager/pkg/message/server"
 	"github.com/NpoolPlatform/review-manager/pkg/migrator"
+	"github.com/NpoolPlatform/review-manager/pkg/relay"
 
 	grpc2 "github.com/NpoolPlatform/go-service-framework/pkg/grpc"
 	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
@@ -24,13 +30,31 @@ var runCmd = &cli.Command{
 	Aliases: []string{"s"},
 	Usage:   "Run the daemon",
 	Action: func(c *cli.Context) error {
+		if err := db.Init(); err != nil {
+			return err
+		}
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.Sugar().Errorf("fail to close db: %v", err)
+			}
+		}()
+
 		if err := migrator.Migrate(c.Context); err != nil {
 			return err
 		}
 
-		if err := db.Init(); err != nil {
+		if err := msgsrv.Init(); err != nil {
 			return err
 		}
+		defer msgsrv.Deinit()
+
+		ctx, cancel := context.WithCancel(c.Context)
+		defer cancel()
+
+		go relay.Run(ctx)
+		go escalator.Run(ctx)
+
+		listener.Listen(ctx)
 
 		go func() {
 			if err := grpc2.RunGRPC(rpcRegister); err != nil {
@@ -0,0 +1,25 @@
+package actor
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/google/uuid"
+)
+
+const UserIDKey = "x-user-id"
+
+// FromContext returns the operator forwarded by the gateway in grpc metadata
+func FromContext(ctx context.Context) uuid.UUID {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.UUID{}
+	}
+	for _, val := range md.Get(UserIDKey) {
+		if id, err := uuid.Parse(val); err == nil {
+			return id
+		}
+	}
+	return uuid.UUID{}
+}
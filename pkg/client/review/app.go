@@ -0,0 +1,91 @@
+//nolint:dupl
+package review
+
+import (
+	"context"
+	"fmt"
+
+	grpc2 "github.com/NpoolPlatform/go-service-framework/pkg/grpc"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+)
+
+type appHandler func(context.Context, extmgr.ExtManagerClient) (cruder.Any, error)
+
+// withAppCRUD calls the cross app endpoints, which only serve the users of platform_admin_user_ids
+// in the service config, ctx must carry such a user with WithUser
+func withAppCRUD(ctx context.Context, handler appHandler) (cruder.Any, error) {
+	_ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := grpc2.GetGRPCConn(constant.ServiceName, grpc2.GRPCTAG)
+	if err != nil {
+		return nil, fmt.Errorf("fail get review connection: %v", err)
+	}
+
+	defer conn.Close()
+
+	cli := extmgr.NewExtManagerClient(conn)
+
+	return handler(_ctx, cli)
+}
+
+// CreateAppReview creates in in targetAppID, whatever the AppID of in is
+func CreateAppReview(ctx context.Context, targetAppID string, in *npool.ReviewReq) (*npool.Review, error) {
+	info, err := withAppCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.CreateAppReview(_ctx, &extmgr.CreateAppReviewRequest{
+			TargetAppID: targetAppID,
+			Info:        in,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail create app review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail create app review: %v", err)
+	}
+	return info.(*npool.Review), nil
+}
+
+func CreateAppReviews(ctx context.Context, targetAppID string, in []*npool.ReviewReq) ([]*npool.Review, error) {
+	infos, err := withAppCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.CreateAppReviews(_ctx, &extmgr.CreateAppReviewsRequest{
+			TargetAppID: targetAppID,
+			Infos:       in,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail create app reviews: %v", err)
+		}
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail create app reviews: %v", err)
+	}
+	return infos.([]*npool.Review), nil
+}
+
+func GetAppReviews(ctx context.Context, targetAppID string, conds *npool.Conds, limit, offset int32) ([]*npool.Review, uint32, error) {
+	var total uint32
+	infos, err := withAppCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.GetAppReviews(_ctx, &extmgr.GetAppReviewsRequest{
+			TargetAppID: targetAppID,
+			Conds:       conds,
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail get app reviews: %v", err)
+		}
+		total = resp.GetTotal()
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail get app reviews: %v", err)
+	}
+	return infos.([]*npool.Review), total, nil
+}
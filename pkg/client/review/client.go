@@ -4,6 +4,7 @@ package review
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	grpc2 "github.com/NpoolPlatform/go-service-framework/pkg/grpc"
@@ -11,7 +12,12 @@ import (
 	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
 
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
 	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
+
+	"google.golang.org/grpc/metadata"
 )
 
 var timeout = 10 * time.Second
@@ -34,6 +40,22 @@ func withCRUD(ctx context.Context, handler handler) (cruder.Any, error) {
 	return handler(_ctx, cli)
 }
 
+// WithApp sends the app of the caller, the server only serves the reviews of that app
+func WithApp(ctx context.Context, appID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, tenant.AppIDKey, appID)
+}
+
+// WithUser sends the user the caller acts for, the operator of the review events
+// and the user checked by the cross app endpoints
+func WithUser(ctx context.Context, userID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, actor.UserIDKey, userID)
+}
+
+// WithReasons sends the reason codes of the decision of UpdateReview
+func WithReasons(ctx context.Context, codes ...string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, reason.Key, strings.Join(codes, ","))
+}
+
 func CreateReview(ctx context.Context, in *npool.ReviewReq) (*npool.Review, error) {
 	info, err := withCRUD(ctx, func(_ctx context.Context, cli npool.ManagerClient) (cruder.Any, error) {
 		resp, err := cli.CreateReview(ctx, &npool.CreateReviewRequest{
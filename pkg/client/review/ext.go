@@ -0,0 +1,302 @@
+//nolint:dupl
+package review
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	grpc2 "github.com/NpoolPlatform/go-service-framework/pkg/grpc"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+)
+
+type extHandler func(context.Context, extmgr.ExtManagerClient) (cruder.Any, error)
+
+// withExtCRUD calls the endpoints of extmgr, the Manager service of the message module has no proto for them
+func withExtCRUD(ctx context.Context, handler extHandler) (cruder.Any, error) {
+	_ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := grpc2.GetGRPCConn(constant.ServiceName, grpc2.GRPCTAG)
+	if err != nil {
+		return nil, fmt.Errorf("fail get review connection: %v", err)
+	}
+
+	defer conn.Close()
+
+	cli := extmgr.NewExtManagerClient(conn)
+
+	return handler(_ctx, cli)
+}
+
+func GetReviewHistory(ctx context.Context, id string, limit, offset int32) ([]*extmgr.ReviewEvent, uint32, error) {
+	var total uint32
+	infos, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.GetReviewHistory(_ctx, &extmgr.GetReviewHistoryRequest{
+			ID:     id,
+			Offset: offset,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail get review history: %v", err)
+		}
+		total = resp.GetTotal()
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail get review history: %v", err)
+	}
+	return infos.([]*extmgr.ReviewEvent), total, nil
+}
+
+// QueryReviews is GetReviews with the IN and NIN conds of the plural fields of extmgr.Conds,
+// the time bounds of CreatedAt, UpdatedAt and DueAt, and orders, the latest changed reviews first when none is given.
+// The details carry the due and escalation times and the reason codes of the reviews, in the order of the reviews.
+func QueryReviews(
+	ctx context.Context, conds *extmgr.Conds, limit, offset int32, orders ...*extmgr.Order,
+) ([]*npool.Review, []*extmgr.ReviewDetail, uint32, error) {
+	var total uint32
+	var details []*extmgr.ReviewDetail
+	infos, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.QueryReviews(_ctx, &extmgr.QueryReviewsRequest{
+			Conds:  conds,
+			Offset: offset,
+			Limit:  limit,
+			Orders: orders,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail query reviews: %v", err)
+		}
+		total = resp.GetTotal()
+		details = resp.GetDetails()
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("fail query reviews: %v", err)
+	}
+	return infos.([]*npool.Review), details, total, nil
+}
+
+// QueryReviewsAfter returns the page after token, pass the returned token to get the next page,
+// it is empty on the last page
+func QueryReviewsAfter(
+	ctx context.Context, conds *extmgr.Conds, token string, limit int32,
+) ([]*npool.Review, []*extmgr.ReviewDetail, string, error) {
+	var next string
+	var details []*extmgr.ReviewDetail
+	infos, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.QueryReviewsAfter(_ctx, &extmgr.QueryReviewsAfterRequest{
+			Conds: conds,
+			Token: token,
+			Limit: limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail query reviews after: %v", err)
+		}
+		next = resp.GetNextToken()
+		details = resp.GetDetails()
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("fail query reviews after: %v", err)
+	}
+	return infos.([]*npool.Review), details, next, nil
+}
+
+// ClaimReview leases the oldest unassigned Wait review of domain and objectType to reviewerID,
+// for DefaultLease of the crud when lease is 0
+func ClaimReview(
+	ctx context.Context, domain string, objectType npool.ReviewObjectType, reviewerID string, lease time.Duration,
+) (*npool.Review, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.ClaimReview(_ctx, &extmgr.ClaimReviewRequest{
+			Domain:       domain,
+			ObjectType:   objectType,
+			ReviewerID:   reviewerID,
+			LeaseSeconds: uint32(lease.Seconds()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail claim review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail claim review: %v", err)
+	}
+	return info.(*npool.Review), nil
+}
+
+func ReleaseReview(ctx context.Context, id, reviewerID string) (*npool.Review, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.ReleaseReview(_ctx, &extmgr.ReleaseReviewRequest{
+			ID:         id,
+			ReviewerID: reviewerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail release review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail release review: %v", err)
+	}
+	return info.(*npool.Review), nil
+}
+
+func CreateReviewerPool(
+	ctx context.Context, appID, domain string, objectType npool.ReviewObjectType, strategy string,
+) (*extmgr.ReviewerPool, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.CreateReviewerPool(_ctx, &extmgr.CreateReviewerPoolRequest{
+			AppID:      appID,
+			Domain:     domain,
+			ObjectType: objectType,
+			Strategy:   strategy,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail create reviewer pool: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail create reviewer pool: %v", err)
+	}
+	return info.(*extmgr.ReviewerPool), nil
+}
+
+func AddReviewerPoolMember(ctx context.Context, poolID, reviewerID string, weight uint32) (*extmgr.ReviewerPoolMember, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.AddReviewerPoolMember(_ctx, &extmgr.AddReviewerPoolMemberRequest{
+			PoolID:     poolID,
+			ReviewerID: reviewerID,
+			Weight:     weight,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail add reviewer pool member: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail add reviewer pool member: %v", err)
+	}
+	return info.(*extmgr.ReviewerPoolMember), nil
+}
+
+func SetReviewerOnShift(ctx context.Context, poolID, reviewerID string, onShift bool) (*extmgr.ReviewerPoolMember, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.SetReviewerOnShift(_ctx, &extmgr.SetReviewerOnShiftRequest{
+			PoolID:     poolID,
+			ReviewerID: reviewerID,
+			OnShift:    onShift,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail set reviewer on shift: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail set reviewer on shift: %v", err)
+	}
+	return info.(*extmgr.ReviewerPoolMember), nil
+}
+
+// RebalanceReviews returns the number of reviews moved to the members on shift of the pool
+func RebalanceReviews(ctx context.Context, poolID string) (uint32, error) {
+	moved, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.RebalanceReviews(_ctx, &extmgr.RebalanceReviewsRequest{
+			PoolID: poolID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail rebalance reviews: %v", err)
+		}
+		return resp.GetMoved(), nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("fail rebalance reviews: %v", err)
+	}
+	return moved.(uint32), nil
+}
+
+// RestoreReview undoes DeleteReview
+func RestoreReview(ctx context.Context, id string) (*npool.Review, error) {
+	info, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.RestoreReview(_ctx, &extmgr.RestoreReviewRequest{
+			ID: id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail restore review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail restore review: %v", err)
+	}
+	return info.(*npool.Review), nil
+}
+
+// GetDeletedReviews lists the soft deleted reviews matching conds, the latest deleted first
+func GetDeletedReviews(ctx context.Context, conds *extmgr.Conds, limit, offset int32) ([]*npool.Review, uint32, error) {
+	var total uint32
+	infos, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.GetDeletedReviews(_ctx, &extmgr.GetDeletedReviewsRequest{
+			Conds:  conds,
+			Offset: offset,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail get deleted reviews: %v", err)
+		}
+		total = resp.GetTotal()
+		return resp.GetInfos(), nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail get deleted reviews: %v", err)
+	}
+	return infos.([]*npool.Review), total, nil
+}
+
+// UpdateReviews applies the state, message and reviewer of in to the reviews of ids, or to the reviews
+// matching conds when ids is empty, and returns how each review went
+func UpdateReviews(ctx context.Context, ids []string, conds *extmgr.Conds, in *npool.ReviewReq) ([]*extmgr.UpdateReviewOutcome, error) {
+	outcomes, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.UpdateReviews(_ctx, &extmgr.UpdateReviewsRequest{
+			IDs:   ids,
+			Conds: conds,
+			Info:  in,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail update reviews: %v", err)
+		}
+		return resp.GetOutcomes(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail update reviews: %v", err)
+	}
+	return outcomes.([]*extmgr.UpdateReviewOutcome), nil
+}
+
+// DeleteReviews soft deletes the reviews of ids, or the reviews matching conds when ids is empty, and returns
+// how many it deleted. Nothing is deleted when more than maxRows reviews match, or with dryRun.
+func DeleteReviews(ctx context.Context, ids []string, conds *extmgr.Conds, maxRows uint32, dryRun bool) (uint32, error) {
+	count, err := withExtCRUD(ctx, func(_ctx context.Context, cli extmgr.ExtManagerClient) (cruder.Any, error) {
+		resp, err := cli.DeleteReviews(_ctx, &extmgr.DeleteReviewsRequest{
+			IDs:     ids,
+			Conds:   conds,
+			MaxRows: maxRows,
+			DryRun:  dryRun,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail delete reviews: %v", err)
+		}
+		return resp.GetCount(), nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("fail delete reviews: %v", err)
+	}
+	return count.(uint32), nil
+}
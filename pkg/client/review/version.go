@@ -0,0 +1,76 @@
+//nolint:dupl
+package review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithVersion sends the version of the review the caller read, UpdateReview then fails
+// with Aborted when the review changed since
+func WithVersion(ctx context.Context, version uint32) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, precondition.VersionKey, fmt.Sprintf("%v", version))
+}
+
+func headerVersion(header metadata.MD) (uint32, error) {
+	version := precondition.Version(header)
+	if version == nil {
+		return 0, fmt.Errorf("no version in response header")
+	}
+	return *version, nil
+}
+
+// GetReviewVersion is GetReview also returning the version to send back through WithVersion
+func GetReviewVersion(ctx context.Context, id string) (*npool.Review, uint32, error) {
+	var version uint32
+	info, err := withCRUD(ctx, func(_ctx context.Context, cli npool.ManagerClient) (cruder.Any, error) {
+		var header metadata.MD
+		resp, err := cli.GetReview(_ctx, &npool.GetReviewRequest{
+			ID: id,
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, fmt.Errorf("fail get review: %v", err)
+		}
+		version, err = headerVersion(header)
+		if err != nil {
+			return nil, fmt.Errorf("fail get review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail get review: %v", err)
+	}
+	return info.(*npool.Review), version, nil
+}
+
+// UpdateReviewVersion updates the review only when it is still at version,
+// and returns it with its new version
+func UpdateReviewVersion(ctx context.Context, in *npool.ReviewReq, version uint32) (*npool.Review, uint32, error) {
+	var next uint32
+	info, err := withCRUD(WithVersion(ctx, version), func(_ctx context.Context, cli npool.ManagerClient) (cruder.Any, error) {
+		var header metadata.MD
+		resp, err := cli.UpdateReview(_ctx, &npool.UpdateReviewRequest{
+			Info: in,
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, fmt.Errorf("fail update review: %v", err)
+		}
+		next, err = headerVersion(header)
+		if err != nil {
+			return nil, fmt.Errorf("fail update review: %v", err)
+		}
+		return resp.GetInfo(), nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fail update review: %v", err)
+	}
+	return info.(*npool.Review), next, nil
+}
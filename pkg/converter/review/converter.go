@@ -3,6 +3,8 @@ package review
 import (
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
 )
 
 func Ent2Grpc(row *ent.Review) *npool.Review {
@@ -32,3 +34,43 @@ func Ent2GrpcMany(rows []*ent.Review) []*npool.Review {
 	}
 	return infos
 }
+
+func Ent2Detail(row *ent.Review) *extmgr.ReviewDetail {
+	if row == nil {
+		return nil
+	}
+
+	return &extmgr.ReviewDetail{
+		ID:          row.ID.String(),
+		DueAt:       row.DueAt,
+		EscalatedAt: row.EscalatedAt,
+		Reasons:     row.Reasons,
+	}
+}
+
+func Ent2DetailMany(rows []*ent.Review) []*extmgr.ReviewDetail {
+	details := []*extmgr.ReviewDetail{}
+	for _, row := range rows {
+		details = append(details, Ent2Detail(row))
+	}
+	return details
+}
+
+func Ent2Message(row *ent.Review) msg.Review {
+	return msg.Review{
+		ID:         row.ID.String(),
+		AppID:      row.AppID.String(),
+		ReviewerID: row.ReviewerID.String(),
+		Domain:     row.Domain,
+		ObjectID:   row.ObjectID.String(),
+		Trigger:    row.Trigger,
+		ObjectType: row.ObjectType,
+		State:      row.State,
+		Message:    row.Message,
+		Reasons:    row.Reasons,
+		DueAt:      row.DueAt,
+		Version:    row.Version,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
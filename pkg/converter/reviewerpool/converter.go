@@ -0,0 +1,37 @@
+package reviewerpool
+
+import (
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+)
+
+func Ent2Grpc(row *ent.ReviewerPool) *extmgr.ReviewerPool {
+	if row == nil {
+		return nil
+	}
+
+	return &extmgr.ReviewerPool{
+		ID:         row.ID.String(),
+		AppID:      row.AppID.String(),
+		Domain:     row.Domain,
+		ObjectType: npool.ReviewObjectType(npool.ReviewObjectType_value[row.ObjectType]),
+		Strategy:   row.Strategy,
+		CreatedAt:  row.CreatedAt,
+	}
+}
+
+func Member2Grpc(row *ent.ReviewerPoolMember) *extmgr.ReviewerPoolMember {
+	if row == nil {
+		return nil
+	}
+
+	return &extmgr.ReviewerPoolMember{
+		ID:         row.ID.String(),
+		PoolID:     row.PoolID.String(),
+		ReviewerID: row.ReviewerID.String(),
+		Weight:     row.Weight,
+		OnShift:    row.OnShift,
+		CreatedAt:  row.CreatedAt,
+	}
+}
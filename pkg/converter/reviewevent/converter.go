@@ -0,0 +1,30 @@
+package reviewevent
+
+import (
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+)
+
+func Ent2Grpc(row *ent.ReviewEvent) *extmgr.ReviewEvent {
+	if row == nil {
+		return nil
+	}
+
+	return &extmgr.ReviewEvent{
+		ID:        row.ID.String(),
+		ReviewID:  row.ReviewID.String(),
+		ActorID:   row.ActorID.String(),
+		Event:     row.Event,
+		OldValue:  row.OldValue,
+		NewValue:  row.NewValue,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func Ent2GrpcMany(rows []*ent.ReviewEvent) []*extmgr.ReviewEvent {
+	infos := []*extmgr.ReviewEvent{}
+	for _, row := range rows {
+		infos = append(infos, Ent2Grpc(row))
+	}
+	return infos
+}
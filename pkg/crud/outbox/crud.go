@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	StatePending   = "Pending"
+	StateDelivered = "Delivered"
+)
+
+// CreateTx queues a message in the transaction of the write it describes,
+// so the message is relayed only when the write commits
+func CreateTx(ctx context.Context, tx *ent.Tx, routingKey string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("fail marshal %v: %v", routingKey, err)
+	}
+
+	_, err = tx.Outbox.
+		Create().
+		SetRoutingKey(routingKey).
+		SetPayload(string(payload)).
+		SetState(StatePending).
+		Save(ctx)
+	return err
+}
+
+// Purge hard deletes at most limit messages delivered before deliveredBefore. It returns the number
+// of purged messages, call it until it returns less than limit. Pending messages are never purged.
+func Purge(ctx context.Context, deliveredBefore uint32, limit int) (int, error) {
+	var err error
+	purged := 0
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Purge")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("DeliveredBefore", int64(deliveredBefore)),
+		attribute.Int("Limit", limit),
+	)
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		ids, err := tx.Outbox.
+			Query().
+			Where(
+				outbox.State(StateDelivered),
+				outbox.DeliveredAtLT(deliveredBefore),
+			).
+			Order(ent.Asc(outbox.FieldDeliveredAt)).
+			Limit(limit).
+			ForUpdate().
+			IDs(_ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		purged, err = tx.Outbox.Delete().Where(outbox.IDIn(ids...)).Exec(_ctx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
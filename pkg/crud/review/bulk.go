@@ -0,0 +1,245 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// MaxUpdateMany bounds the reviews one UpdateMany touches
+const MaxUpdateMany = 1000
+
+// UpdateOutcome is the result of UpdateMany for one review, Info is set when it got updated
+type UpdateOutcome struct {
+	ID   uuid.UUID
+	Info *ent.Review
+	Err  error
+}
+
+// bulkRow runs f in a savepoint of tx, so a review failing f leaves no partial writes.
+// The error of f is returned as rowErr, err tells the savepoint itself failed.
+func bulkRow(ctx context.Context, tx *ent.Tx, f func() error) (rowErr, err error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT update_many"); err != nil {
+		return nil, err
+	}
+	if rowErr := f(); rowErr != nil {
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT update_many"); err != nil {
+			return nil, err
+		}
+		return rowErr, nil
+	}
+	_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT update_many")
+	return nil, err
+}
+
+func bulkIDs(ctx context.Context, tx *ent.Tx, ids []uuid.UUID, conds *Conds) ([]uuid.UUID, error) {
+	if len(ids) > 0 {
+		return ids, nil
+	}
+
+	stm, err := SetQueryConds(conds, tx.Client())
+	if err != nil {
+		return nil, err
+	}
+	ids, err = stm.
+		Order(ent.Asc(review.FieldCreatedAt)).
+		Limit(MaxUpdateMany + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > MaxUpdateMany {
+		return nil, &MaxRowsError{MaxRows: MaxUpdateMany}
+	}
+	return ids, nil
+}
+
+// UpdateMany applies the state, message and reviewer of in to the reviews of ids,
+// or to the reviews matching conds when ids is empty, in one transaction.
+// Each review goes through the checks of Update and gets its own outcome: a review
+// failing them is left as it was while the others are updated. The error is only set
+// when the whole batch failed.
+func UpdateMany(ctx context.Context, ids []uuid.UUID, conds *Conds, in *npool.ReviewReq) ([]*UpdateOutcome, error) {
+	var outcomes []*UpdateOutcome
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "UpdateMany")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	if len(ids) == 0 && conds.empty() {
+		err = fmt.Errorf("update many needs ids or conds")
+		return nil, err
+	}
+	if len(ids) > MaxUpdateMany {
+		err = &MaxRowsError{MaxRows: MaxUpdateMany}
+		return nil, err
+	}
+	if in.ID != nil {
+		err = fmt.Errorf("update many takes no id")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("IDs", len(ids)))
+	if conds != nil {
+		span = traceConds(span, conds)
+	}
+	span = tracer.Trace(span, in)
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		_ids, err := bulkIDs(_ctx, tx, ids, conds)
+		if err != nil {
+			return err
+		}
+
+		outcomes = []*UpdateOutcome{}
+		for _, id := range _ids {
+			outcome := &UpdateOutcome{ID: id}
+			outcome.Err, err = bulkRow(_ctx, tx, func() error {
+				old, err := tx.Review.Query().Where(review.ID(id)).ForUpdate().Only(_ctx)
+				if err != nil {
+					return fmt.Errorf("fail query review: %w", err)
+				}
+				outcome.Info, err = updateTx(_ctx, tx, old, in)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			outcomes = append(outcomes, outcome)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outcomes, nil
+}
+
+// MaxRowsError is returned when UpdateMany or DeleteMany matches more reviews than its guard allows
+type MaxRowsError struct {
+	MaxRows int
+}
+
+func (e *MaxRowsError) Error() string {
+	return fmt.Sprintf("more than %v reviews match", e.MaxRows)
+}
+
+func bulkQuery(cli *ent.Client, ids []uuid.UUID, conds *Conds) (*ent.ReviewQuery, error) {
+	if len(ids) > 0 {
+		return cli.Review.Query().Where(review.IDIn(ids...)), nil
+	}
+	return SetQueryConds(conds, cli)
+}
+
+// DeleteMany soft deletes the reviews of ids, or the reviews matching conds when ids is empty,
+// in one transaction, and returns how many it deleted. maxRows is required: nothing is deleted
+// when more reviews match. With dryRun it only returns how many reviews it would delete.
+func DeleteMany(ctx context.Context, ids []uuid.UUID, conds *Conds, maxRows int, dryRun bool) (int, error) {
+	var count int
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "DeleteMany")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	if len(ids) == 0 && conds.empty() {
+		err = fmt.Errorf("delete many needs ids or conds")
+		return 0, err
+	}
+	if maxRows <= 0 {
+		err = fmt.Errorf("delete many needs a positive max rows")
+		return 0, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("IDs", len(ids)),
+		attribute.Int("MaxRows", maxRows),
+		attribute.Bool("DryRun", dryRun),
+	)
+	if conds != nil {
+		span = traceConds(span, conds)
+	}
+
+	// A dry run only counts, it locks nothing
+	if dryRun {
+		err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+			stm, err := bulkQuery(cli, ids, conds)
+			if err != nil {
+				return err
+			}
+
+			count, err = stm.Count(_ctx)
+			if err != nil {
+				return err
+			}
+			if count > maxRows {
+				return &MaxRowsError{MaxRows: maxRows}
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		return count, nil
+	}
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		stm, err := bulkQuery(tx.Client(), ids, conds)
+		if err != nil {
+			return err
+		}
+
+		rows, err := stm.
+			Order(ent.Asc(review.FieldCreatedAt)).
+			Limit(maxRows + 1).
+			ForUpdate().
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) > maxRows {
+			return &MaxRowsError{MaxRows: maxRows}
+		}
+
+		count = len(rows)
+		for _, row := range rows {
+			if _, err := deleteTx(_ctx, tx, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,172 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+const DefaultLease = 30 * time.Minute
+
+// LeaseError is returned when a review is leased by another reviewer, or not leased by the releasing one
+type LeaseError struct {
+	ID         uuid.UUID
+	ReviewerID uuid.UUID
+}
+
+func (e *LeaseError) Error() string {
+	return fmt.Sprintf("review %v is not leased by %v", e.ID, e.ReviewerID)
+}
+
+func leased(info *ent.Review, now uint32) bool {
+	return info.LeaseExpiresAt > now
+}
+
+// pooled selects the Wait reviews nobody works on: never assigned, or with an expired lease.
+// A review assigned without a lease, e.g. by UpdateReview, stays with its reviewer.
+func pooled(now uint32) *sql.Predicate {
+	return sql.Or(
+		sql.EQ(review.FieldReviewerID, uuid.Nil),
+		sql.And(
+			sql.GT(review.FieldLeaseExpiresAt, 0),
+			sql.LTE(review.FieldLeaseExpiresAt, now),
+		),
+	)
+}
+
+// Claim leases the oldest pooled Wait review of domain and object type to reviewerID.
+// Rows locked by a concurrent claim are skipped, so two reviewers never get the same review.
+func Claim(
+	ctx context.Context,
+	domain string,
+	objectType npool.ReviewObjectType,
+	reviewerID uuid.UUID,
+	lease time.Duration,
+) (*ent.Review, error) {
+	var info *ent.Review
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Claim")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.String("Domain", domain),
+		attribute.String("ObjectType", objectType.String()),
+		attribute.String("ReviewerID", reviewerID.String()),
+	)
+
+	if lease <= 0 {
+		lease = DefaultLease
+	}
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		now := uint32(time.Now().Unix())
+
+		old, err := tx.Review.
+			Query().
+			Where(
+				review.Domain(domain),
+				review.ObjectType(objectType.String()),
+				review.State(npool.ReviewState_Wait.String()),
+				func(s *sql.Selector) {
+					s.Where(pooled(now))
+				},
+			).
+			Order(ent.Asc(review.FieldCreatedAt)).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			First(_ctx)
+		if err != nil {
+			return err
+		}
+
+		info, err = old.Update().
+			SetReviewerID(reviewerID).
+			SetLeaseExpiresAt(now + uint32(lease.Seconds())).
+			Save(_ctx)
+		if err != nil {
+			return err
+		}
+
+		return eventcrud.CreateTx(_ctx, tx, info.AppID, info.ID, eventcrud.Diff(reviewerID, old, info))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// Release returns a review leased by reviewerID to the pool
+func Release(ctx context.Context, id, reviewerID uuid.UUID) (*ent.Review, error) {
+	var info *ent.Review
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Release")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, id.String())
+	span.SetAttributes(attribute.String("ReviewerID", reviewerID.String()))
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		old, err := tx.Review.Query().Where(review.ID(id)).ForUpdate().Only(_ctx)
+		if err != nil {
+			return err
+		}
+
+		if old.ReviewerID != reviewerID || !leased(old, uint32(time.Now().Unix())) {
+			return &LeaseError{ID: id, ReviewerID: reviewerID}
+		}
+
+		info, err = old.Update().
+			SetReviewerID(uuid.Nil).
+			SetLeaseExpiresAt(0).
+			Save(_ctx)
+		if err != nil {
+			return err
+		}
+
+		actorID := actor.FromContext(_ctx)
+		if actorID == uuid.Nil {
+			actorID = reviewerID
+		}
+		return eventcrud.CreateTx(_ctx, tx, info.AppID, info.ID, eventcrud.Diff(actorID, old, info))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,409 @@
+package review
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+
+	"go.opentelemetry.io/otel/trace"
+
+	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NEQ = "neq"
+	NIN = "nin"
+	GTE = "gte"
+	LTE = "lte"
+)
+
+// Conds is the query condition of the crud layer. EQ and NEQ take a single value,
+// IN and NIN take a slice: uuid.UUID for id fields and string for the others.
+// CreatedAt, UpdatedAt and DueAt take uint32 bounds which are all applied, e.g. GTE and LT for a range.
+// Overdue and Escalated take an EQ bool, an overdue review is a Wait review past its due time.
+// Reason takes a reason code, EQ selects the reviews whose reasons hold it and NEQ the others.
+type Conds struct {
+	ID         *cruder.Cond
+	AppID      *cruder.Cond
+	ReviewerID *cruder.Cond
+	Domain     *cruder.Cond
+	ObjectID   *cruder.Cond
+	Trigger    *cruder.Cond
+	ObjectType *cruder.Cond
+	State      *cruder.Cond
+	CreatedAt  []*cruder.Cond
+	UpdatedAt  []*cruder.Cond
+	DueAt      []*cruder.Cond
+	Overdue    *cruder.Cond
+	Escalated  *cruder.Cond
+	Reason     *cruder.Cond
+}
+
+func (conds *Conds) empty() bool {
+	return conds == nil ||
+		conds.ID == nil && conds.AppID == nil && conds.ReviewerID == nil &&
+			conds.Domain == nil && conds.ObjectID == nil && conds.Trigger == nil &&
+			conds.ObjectType == nil && conds.State == nil &&
+			len(conds.CreatedAt) == 0 && len(conds.UpdatedAt) == 0 && len(conds.DueAt) == 0 &&
+			conds.Overdue == nil && conds.Escalated == nil && conds.Reason == nil
+}
+
+type CondError struct {
+	Field string
+	Op    string
+}
+
+func (e *CondError) Error() string {
+	return fmt.Sprintf("unsupported op %v of review field %v", e.Op, e.Field)
+}
+
+func uuidCond(op, value string) *cruder.Cond {
+	return &cruder.Cond{Op: op, Val: uuid.MustParse(value)}
+}
+
+// ConvertConds maps the grpc conds, which only carry single values, to the crud conds
+func ConvertConds(in *npool.Conds) *Conds {
+	conds := &Conds{}
+	if in == nil {
+		return conds
+	}
+
+	if in.ID != nil {
+		conds.ID = uuidCond(in.GetID().GetOp(), in.GetID().GetValue())
+	}
+	if in.AppID != nil {
+		conds.AppID = uuidCond(in.GetAppID().GetOp(), in.GetAppID().GetValue())
+	}
+	if in.ReviewerID != nil {
+		conds.ReviewerID = uuidCond(in.GetReviewerID().GetOp(), in.GetReviewerID().GetValue())
+	}
+	if in.Domain != nil {
+		conds.Domain = &cruder.Cond{Op: in.GetDomain().GetOp(), Val: in.GetDomain().GetValue()}
+	}
+	if in.ObjectID != nil {
+		conds.ObjectID = uuidCond(in.GetObjectID().GetOp(), in.GetObjectID().GetValue())
+	}
+	if in.Trigger != nil {
+		conds.Trigger = &cruder.Cond{
+			Op:  in.GetTrigger().GetOp(),
+			Val: npool.ReviewTriggerType(in.GetTrigger().GetValue()).String(),
+		}
+	}
+	if in.ObjectType != nil {
+		conds.ObjectType = &cruder.Cond{
+			Op:  in.GetObjectType().GetOp(),
+			Val: npool.ReviewObjectType(in.GetObjectType().GetValue()).String(),
+		}
+	}
+	if in.State != nil {
+		conds.State = &cruder.Cond{
+			Op:  in.GetState().GetOp(),
+			Val: npool.ReviewState(in.GetState().GetValue()).String(),
+		}
+	}
+
+	return conds
+}
+
+func uuidsCond(op string, values []string) *cruder.Cond {
+	ids := []uuid.UUID{}
+	for _, value := range values {
+		ids = append(ids, uuid.MustParse(value))
+	}
+	return &cruder.Cond{Op: op, Val: ids}
+}
+
+func enumsCond(op string, values []int32, name func(int32) string) *cruder.Cond {
+	names := []string{}
+	for _, value := range values {
+		names = append(names, name(value))
+	}
+	return &cruder.Cond{Op: op, Val: names}
+}
+
+// ConvertExtConds maps the grpc conds of extmgr, whose plural fields carry the list values of IN and NIN
+// and whose CreatedAt, UpdatedAt and DueAt carry the time bounds
+func ConvertExtConds(in *extmgr.Conds) *Conds {
+	conds := ConvertConds(extmgr.SingleConds(in))
+	if in == nil {
+		return conds
+	}
+
+	if in.IDs != nil {
+		conds.ID = uuidsCond(in.GetIDs().GetOp(), in.GetIDs().GetValue())
+	}
+	if in.AppIDs != nil {
+		conds.AppID = uuidsCond(in.GetAppIDs().GetOp(), in.GetAppIDs().GetValue())
+	}
+	if in.ReviewerIDs != nil {
+		conds.ReviewerID = uuidsCond(in.GetReviewerIDs().GetOp(), in.GetReviewerIDs().GetValue())
+	}
+	if in.Domains != nil {
+		conds.Domain = &cruder.Cond{Op: in.GetDomains().GetOp(), Val: in.GetDomains().GetValue()}
+	}
+	if in.ObjectIDs != nil {
+		conds.ObjectID = uuidsCond(in.GetObjectIDs().GetOp(), in.GetObjectIDs().GetValue())
+	}
+	if in.Triggers != nil {
+		conds.Trigger = enumsCond(in.GetTriggers().GetOp(), in.GetTriggers().GetValue(), func(value int32) string {
+			return npool.ReviewTriggerType(value).String()
+		})
+	}
+	if in.ObjectTypes != nil {
+		conds.ObjectType = enumsCond(in.GetObjectTypes().GetOp(), in.GetObjectTypes().GetValue(), func(value int32) string {
+			return npool.ReviewObjectType(value).String()
+		})
+	}
+	if in.States != nil {
+		conds.State = enumsCond(in.GetStates().GetOp(), in.GetStates().GetValue(), func(value int32) string {
+			return npool.ReviewState(value).String()
+		})
+	}
+	for _, bound := range in.GetCreatedAt() {
+		conds.CreatedAt = append(conds.CreatedAt, &cruder.Cond{Op: bound.GetOp(), Val: bound.GetValue()})
+	}
+	for _, bound := range in.GetUpdatedAt() {
+		conds.UpdatedAt = append(conds.UpdatedAt, &cruder.Cond{Op: bound.GetOp(), Val: bound.GetValue()})
+	}
+	for _, bound := range in.GetDueAt() {
+		conds.DueAt = append(conds.DueAt, &cruder.Cond{Op: bound.GetOp(), Val: bound.GetValue()})
+	}
+	if in.Overdue != nil {
+		conds.Overdue = &cruder.Cond{Op: in.GetOverdue().GetOp(), Val: in.GetOverdue().GetValue()}
+	}
+	if in.Escalated != nil {
+		conds.Escalated = &cruder.Cond{Op: in.GetEscalated().GetOp(), Val: in.GetEscalated().GetValue()}
+	}
+	if in.Reason != nil {
+		conds.Reason = &cruder.Cond{Op: in.GetReason().GetOp(), Val: in.GetReason().GetValue()}
+	}
+
+	return conds
+}
+
+func values(val interface{}) ([]interface{}, bool) {
+	vals := []interface{}{}
+	switch _vals := val.(type) {
+	case []uuid.UUID:
+		for _, v := range _vals {
+			vals = append(vals, v)
+		}
+	case []string:
+		for _, v := range _vals {
+			vals = append(vals, v)
+		}
+	default:
+		return nil, false
+	}
+	return vals, true
+}
+
+func fieldPredicate(field string, cond *cruder.Cond) (predicate.Review, error) {
+	switch cond.Op {
+	case cruder.EQ:
+		return func(s *sql.Selector) {
+			s.Where(sql.EQ(s.C(field), cond.Val))
+		}, nil
+	case NEQ:
+		return func(s *sql.Selector) {
+			s.Where(sql.NEQ(s.C(field), cond.Val))
+		}, nil
+	case cruder.IN, NIN:
+		vals, ok := values(cond.Val)
+		if !ok || len(vals) == 0 {
+			return nil, &CondError{Field: field, Op: cond.Op}
+		}
+		if cond.Op == cruder.IN {
+			return func(s *sql.Selector) {
+				s.Where(sql.In(s.C(field), vals...))
+			}, nil
+		}
+		return func(s *sql.Selector) {
+			s.Where(sql.NotIn(s.C(field), vals...))
+		}, nil
+	default:
+		return nil, &CondError{Field: field, Op: cond.Op}
+	}
+}
+
+func timePredicate(field string, cond *cruder.Cond) (predicate.Review, error) {
+	val, ok := cond.Val.(uint32)
+	if !ok {
+		return nil, &CondError{Field: field, Op: cond.Op}
+	}
+
+	var p func(string, interface{}) *sql.Predicate
+	switch cond.Op {
+	case cruder.EQ:
+		p = sql.EQ
+	case cruder.GT:
+		p = sql.GT
+	case GTE:
+		p = sql.GTE
+	case cruder.LT:
+		p = sql.LT
+	case LTE:
+		p = sql.LTE
+	default:
+		return nil, &CondError{Field: field, Op: cond.Op}
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(p(s.C(field), val))
+	}, nil
+}
+
+func overduePredicate(cond *cruder.Cond) (predicate.Review, error) {
+	val, ok := cond.Val.(bool)
+	if !ok || cond.Op != cruder.EQ {
+		return nil, &CondError{Field: "overdue", Op: cond.Op}
+	}
+
+	now := uint32(time.Now().Unix())
+	overdue := review.And(
+		review.State(npool.ReviewState_Wait.String()),
+		review.DueAtGT(0),
+		review.DueAtLTE(now),
+	)
+	if val {
+		return overdue, nil
+	}
+	return review.Not(overdue), nil
+}
+
+func escalatedPredicate(cond *cruder.Cond) (predicate.Review, error) {
+	val, ok := cond.Val.(bool)
+	if !ok || cond.Op != cruder.EQ {
+		return nil, &CondError{Field: review.FieldEscalatedAt, Op: cond.Op}
+	}
+
+	if val {
+		return review.EscalatedAtGT(0), nil
+	}
+	return review.EscalatedAt(0), nil
+}
+
+func reasonPredicate(cond *cruder.Cond) (predicate.Review, error) {
+	code, ok := cond.Val.(string)
+	if !ok {
+		return nil, &CondError{Field: review.FieldReasons, Op: cond.Op}
+	}
+
+	contains := func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(review.FieldReasons), code))
+	}
+	switch cond.Op {
+	case cruder.EQ:
+		return contains, nil
+	case NEQ:
+		return review.Or(review.ReasonsIsNil(), review.Not(contains)), nil
+	default:
+		return nil, &CondError{Field: review.FieldReasons, Op: cond.Op}
+	}
+}
+
+func SetQueryConds(conds *Conds, cli *ent.Client) (*ent.ReviewQuery, error) {
+	stm := cli.Review.Query()
+
+	fields := []struct {
+		field string
+		cond  *cruder.Cond
+	}{
+		{review.FieldID, conds.ID},
+		{review.FieldAppID, conds.AppID},
+		{review.FieldReviewerID, conds.ReviewerID},
+		{review.FieldDomain, conds.Domain},
+		{review.FieldObjectID, conds.ObjectID},
+		{review.FieldTrigger, conds.Trigger},
+		{review.FieldObjectType, conds.ObjectType},
+		{review.FieldState, conds.State},
+	}
+	for _, f := range fields {
+		if f.cond == nil {
+			continue
+		}
+		p, err := fieldPredicate(f.field, f.cond)
+		if err != nil {
+			return nil, err
+		}
+		stm.Where(p)
+	}
+
+	timeFields := []struct {
+		field string
+		conds []*cruder.Cond
+	}{
+		{review.FieldCreatedAt, conds.CreatedAt},
+		{review.FieldUpdatedAt, conds.UpdatedAt},
+		{review.FieldDueAt, conds.DueAt},
+	}
+	for _, f := range timeFields {
+		for _, cond := range f.conds {
+			p, err := timePredicate(f.field, cond)
+			if err != nil {
+				return nil, err
+			}
+			stm.Where(p)
+		}
+	}
+
+	if conds.Overdue != nil {
+		p, err := overduePredicate(conds.Overdue)
+		if err != nil {
+			return nil, err
+		}
+		stm.Where(p)
+	}
+	if conds.Escalated != nil {
+		p, err := escalatedPredicate(conds.Escalated)
+		if err != nil {
+			return nil, err
+		}
+		stm.Where(p)
+	}
+	if conds.Reason != nil {
+		p, err := reasonPredicate(conds.Reason)
+		if err != nil {
+			return nil, err
+		}
+		stm.Where(p)
+	}
+
+	return stm, nil
+}
+
+func traceConds(span trace.Span, conds *Conds) trace.Span {
+	span = tracer.TraceCond(span, "ID", conds.ID)
+	span = tracer.TraceCond(span, "AppID", conds.AppID)
+	span = tracer.TraceCond(span, "ReviewerID", conds.ReviewerID)
+	span = tracer.TraceCond(span, "Domain", conds.Domain)
+	span = tracer.TraceCond(span, "ObjectID", conds.ObjectID)
+	span = tracer.TraceCond(span, "Trigger", conds.Trigger)
+	span = tracer.TraceCond(span, "ObjectType", conds.ObjectType)
+	span = tracer.TraceCond(span, "State", conds.State)
+	for i, cond := range conds.CreatedAt {
+		span = tracer.TraceCond(span, fmt.Sprintf("CreatedAt.%v", i), cond)
+	}
+	for i, cond := range conds.UpdatedAt {
+		span = tracer.TraceCond(span, fmt.Sprintf("UpdatedAt.%v", i), cond)
+	}
+	for i, cond := range conds.DueAt {
+		span = tracer.TraceCond(span, fmt.Sprintf("DueAt.%v", i), cond)
+	}
+	span = tracer.TraceCond(span, "Overdue", conds.Overdue)
+	span = tracer.TraceCond(span, "Escalated", conds.Escalated)
+	span = tracer.TraceCond(span, "Reason", conds.Reason)
+	return span
+}
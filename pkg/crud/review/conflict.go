@@ -0,0 +1,38 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+
+	"github.com/google/uuid"
+)
+
+// ConflictError is returned when the review moved on since the caller read it
+type ConflictError struct {
+	ID      uuid.UUID
+	Version uint32
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("review %v was updated concurrently, current version %v", e.ID, e.Version)
+}
+
+// checkPrecondition compares the locked row with the version or updated_at the caller read
+func checkPrecondition(ctx context.Context, info *ent.Review) error {
+	p := precondition.FromContext(ctx)
+	if p == nil {
+		return nil
+	}
+
+	if p.Version != nil && *p.Version != info.Version {
+		return &ConflictError{ID: info.ID, Version: info.Version}
+	}
+	if p.UpdatedAt != nil && *p.UpdatedAt != info.UpdatedAt {
+		return &ConflictError{ID: info.ID, Version: info.Version}
+	}
+
+	return nil
+}
@@ -9,12 +9,16 @@ import (
 	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
 	tracer "github.com/NpoolPlatform/review-manager/pkg/tracer/review"
 
-	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
 
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	poolcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewerpool"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
 	"github.com/NpoolPlatform/review-manager/pkg/db"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	"github.com/NpoolPlatform/review-manager/pkg/sla"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -45,9 +49,29 @@ func CreateSet(c *ent.ReviewCreate, in *npool.ReviewReq) *ent.ReviewCreate {
 		c.SetObjectType(in.GetObjectType().String())
 	}
 	c.SetState(npool.ReviewState_Wait.String())
+	c.SetOpenKey(reqOpenKey(in))
+	c.SetDueAt(sla.DueAt(in.GetObjectType().String(), time.Now()))
 	return c
 }
 
+// assignReviewer lets the reviewer pool of the review pick its reviewer when none is given
+func assignReviewer(ctx context.Context, picker *poolcrud.Picker, c *ent.ReviewCreate, in *npool.ReviewReq) error {
+	if in.ReviewerID != nil || in.AppID == nil {
+		return nil
+	}
+
+	reviewerID, err := picker.Pick(ctx, uuid.MustParse(in.GetAppID()), in.GetDomain(), in.GetObjectType().String())
+	if err != nil {
+		return err
+	}
+	if reviewerID != uuid.Nil {
+		c.SetReviewerID(reviewerID)
+	}
+
+	return nil
+}
+
+// Create returns the open review of the object when there is one, so a retried create never duplicates it
 func Create(ctx context.Context, in *npool.ReviewReq) (*ent.Review, error) {
 	var info *ent.Review
 	var err error
@@ -64,11 +88,15 @@ func Create(ctx context.Context, in *npool.ReviewReq) (*ent.Review, error) {
 
 	span = tracer.Trace(span, in)
 
-	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
-		c := CreateSet(cli.Review.Create(), in)
-		info, err = c.Save(_ctx)
-		return err
-	})
+	for i := 0; i < createAttempts; i++ {
+		err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+			info, err = createTx(_ctx, tx, poolcrud.NewPicker(tx), in)
+			return err
+		})
+		if !ent.IsConstraintError(err) {
+			break
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +104,8 @@ func Create(ctx context.Context, in *npool.ReviewReq) (*ent.Review, error) {
 	return info, nil
 }
 
+// CreateBulk applies Create to each item: the i-th review is the open review of the i-th item,
+// existing or created, and items for the same object share one review
 func CreateBulk(ctx context.Context, in []*npool.ReviewReq) ([]*ent.Review, error) {
 	var err error
 
@@ -92,17 +122,26 @@ func CreateBulk(ctx context.Context, in []*npool.ReviewReq) ([]*ent.Review, erro
 	span = tracer.TraceMany(span, in)
 
 	rows := []*ent.Review{}
-	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
-		bulk := make([]*ent.ReviewCreate, len(in))
-		for i, info := range in {
-			bulk[i] = CreateSet(tx.Review.Create(), info)
+	for i := 0; i < createAttempts; i++ {
+		err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+			picker := poolcrud.NewPicker(tx)
+			rows = make([]*ent.Review, len(in))
+			for j, info := range in {
+				rows[j], err = createTx(_ctx, tx, picker, info)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if !ent.IsConstraintError(err) {
+			break
 		}
-		rows, err = tx.Review.CreateBulk(bulk...).Save(_ctx)
-		return err
-	})
+	}
 	if err != nil {
 		return nil, err
 	}
+
 	return rows, nil
 }
 
@@ -110,15 +149,27 @@ func UpdateSet(info *ent.Review, in *npool.ReviewReq) (*ent.ReviewUpdateOne, err
 	stm := info.Update()
 
 	if in.ReviewerID != nil {
-		stm = stm.SetReviewerID(uuid.MustParse(in.GetReviewerID()))
+		reviewerID := uuid.MustParse(in.GetReviewerID())
+		// Only a reassignment waits for the lease, any reviewer may decide a leased review
+		if reviewerID != info.ReviewerID && !decision(in) && leased(info, uint32(time.Now().Unix())) {
+			return nil, &LeaseError{ID: info.ID, ReviewerID: reviewerID}
+		}
+		stm = stm.SetReviewerID(reviewerID)
 	}
 	if in.State != nil {
-		switch info.State {
-		case npool.ReviewState_Wait.String():
-		default:
-			return nil, fmt.Errorf("permission denied")
+		if err := ValidateTransition(info.ObjectType, info.State, in.GetState()); err != nil {
+			return nil, err
 		}
 		stm = stm.SetState(in.GetState().String())
+		if in.GetState() == npool.ReviewState_Wait {
+			// A resubmitted review waits again, with a new SLA and not escalated
+			stm = stm.
+				SetOpenKey(rowOpenKey(info)).
+				SetDueAt(sla.DueAt(info.ObjectType, time.Now())).
+				SetEscalatedAt(0)
+		} else {
+			stm = stm.ClearOpenKey()
+		}
 	}
 	if in.Message != nil {
 		stm = stm.SetMessage(in.GetMessage())
@@ -144,17 +195,16 @@ func Update(ctx context.Context, in *npool.ReviewReq) (*ent.Review, error) {
 	span = tracer.Trace(span, in)
 
 	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
-		info, err = tx.Review.Query().Where(review.ID(uuid.MustParse(in.GetID()))).ForUpdate().Only(_ctx)
+		old, err := tx.Review.Query().Where(review.ID(uuid.MustParse(in.GetID()))).ForUpdate().Only(_ctx)
 		if err != nil {
-			return fmt.Errorf("fail query review: %v", err)
+			return fmt.Errorf("fail query review: %w", err)
 		}
 
-		c, err := UpdateSet(info, in)
-		if err != nil {
+		if err := checkPrecondition(_ctx, old); err != nil {
 			return err
 		}
 
-		info, err = c.Save(_ctx)
+		info, err = updateTx(_ctx, tx, old, in)
 		return err
 	})
 	if err != nil {
@@ -164,6 +214,58 @@ func Update(ctx context.Context, in *npool.ReviewReq) (*ent.Review, error) {
 	return info, nil
 }
 
+// updateTx applies in to old, which the caller locked in tx, with its decision, history and event
+func updateTx(ctx context.Context, tx *ent.Tx, old *ent.Review, in *npool.ReviewReq) (*ent.Review, error) {
+	actorID := actor.FromContext(ctx)
+	if actorID == uuid.Nil && in.ReviewerID != nil {
+		actorID = uuid.MustParse(in.GetReviewerID())
+	}
+
+	reasons := reason.FromContext(ctx)
+	if err := reason.Validate(old.ObjectType, in.GetState(), reasons); err != nil {
+		return nil, err
+	}
+
+	req, err := decide(ctx, tx, old, actorID, in, reasons)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := UpdateSet(old, req)
+	if err != nil {
+		return nil, err
+	}
+	// A recorded decision ends the lease, the review of a pending quorum goes back to the pool
+	// for the next approver
+	if decision(in) {
+		c.SetLeaseExpiresAt(0)
+		if req.State == nil {
+			c.SetReviewerID(uuid.Nil)
+		}
+	}
+	// The review keeps the reasons of the decision setting its state
+	if req.State != nil {
+		if len(reasons) > 0 {
+			c.SetReasons(reasons)
+		} else {
+			c.ClearReasons()
+		}
+	}
+
+	info, err := c.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eventcrud.CreateTx(ctx, tx, info.AppID, info.ID, eventcrud.Diff(actorID, old, info)); err != nil {
+		return nil, err
+	}
+	if err := publishStateChanged(ctx, tx, old.State, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
 func Row(ctx context.Context, id uuid.UUID) (*ent.Review, error) {
 	var info *ent.Review
 	var err error
@@ -191,76 +293,8 @@ func Row(ctx context.Context, id uuid.UUID) (*ent.Review, error) {
 	return info, nil
 }
 
-func SetQueryConds(conds *npool.Conds, cli *ent.Client) (*ent.ReviewQuery, error) { //nolint
-	stm := cli.Review.Query()
-	if conds.ID != nil {
-		switch conds.GetID().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.ID(uuid.MustParse(conds.GetID().GetValue())))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.AppID != nil {
-		switch conds.GetAppID().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.AppID(uuid.MustParse(conds.GetAppID().GetValue())))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.ReviewerID != nil {
-		switch conds.GetReviewerID().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.ReviewerID(uuid.MustParse(conds.GetReviewerID().GetValue())))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.Domain != nil {
-		switch conds.GetDomain().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.Domain(conds.GetDomain().GetValue()))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.ObjectID != nil {
-		switch conds.GetObjectID().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.ObjectID(uuid.MustParse(conds.GetObjectID().GetValue())))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.Trigger != nil {
-		switch conds.GetTrigger().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.Trigger(npool.ReviewTriggerType(conds.GetTrigger().GetValue()).String()))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.ObjectType != nil {
-		switch conds.GetObjectType().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.ObjectType(npool.ReviewObjectType(conds.GetObjectType().GetValue()).String()))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	if conds.State != nil {
-		switch conds.GetState().GetOp() {
-		case cruder.EQ:
-			stm.Where(review.State(npool.ReviewState(conds.GetState().GetValue()).String()))
-		default:
-			return nil, fmt.Errorf("invalid review field")
-		}
-	}
-	return stm, nil
-}
-
-func Rows(ctx context.Context, conds *npool.Conds, offset, limit int) ([]*ent.Review, int, error) {
+// Rows lists reviews in the given orders, DefaultOrder when none is given
+func Rows(ctx context.Context, conds *Conds, offset, limit int, orders ...*Order) ([]*ent.Review, int, error) {
 	var err error
 
 	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Rows")
@@ -273,7 +307,7 @@ func Rows(ctx context.Context, conds *npool.Conds, offset, limit int) ([]*ent.Re
 		}
 	}()
 
-	span = tracer.TraceConds(span, conds)
+	span = traceConds(span, conds)
 	span = commontracer.TraceOffsetLimit(span, offset, limit)
 
 	rows := []*ent.Review{}
@@ -289,9 +323,13 @@ func Rows(ctx context.Context, conds *npool.Conds, offset, limit int) ([]*ent.Re
 			return err
 		}
 
+		stm, err = setOrders(stm, orders)
+		if err != nil {
+			return err
+		}
+
 		rows, err = stm.
 			Offset(offset).
-			Order(ent.Desc(review.FieldUpdatedAt)).
 			Limit(limit).
 			All(_ctx)
 		if err != nil {
@@ -306,7 +344,7 @@ func Rows(ctx context.Context, conds *npool.Conds, offset, limit int) ([]*ent.Re
 	return rows, total, nil
 }
 
-func RowOnly(ctx context.Context, conds *npool.Conds) (*ent.Review, error) {
+func RowOnly(ctx context.Context, conds *Conds) (*ent.Review, error) {
 	var info *ent.Review
 	var err error
 
@@ -320,7 +358,7 @@ func RowOnly(ctx context.Context, conds *npool.Conds) (*ent.Review, error) {
 		}
 	}()
 
-	span = tracer.TraceConds(span, conds)
+	span = traceConds(span, conds)
 
 	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
 		stm, err := SetQueryConds(conds, cli)
@@ -342,7 +380,7 @@ func RowOnly(ctx context.Context, conds *npool.Conds) (*ent.Review, error) {
 	return info, nil
 }
 
-func Count(ctx context.Context, conds *npool.Conds) (uint32, error) {
+func Count(ctx context.Context, conds *Conds) (uint32, error) {
 	var err error
 	var total int
 
@@ -356,7 +394,7 @@ func Count(ctx context.Context, conds *npool.Conds) (uint32, error) {
 		}
 	}()
 
-	span = tracer.TraceConds(span, conds)
+	span = traceConds(span, conds)
 
 	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
 		stm, err := SetQueryConds(conds, cli)
@@ -404,7 +442,7 @@ func Exist(ctx context.Context, id uuid.UUID) (bool, error) {
 	return exist, nil
 }
 
-func ExistConds(ctx context.Context, conds *npool.Conds) (bool, error) {
+func ExistConds(ctx context.Context, conds *Conds) (bool, error) {
 	var err error
 	exist := false
 
@@ -418,7 +456,7 @@ func ExistConds(ctx context.Context, conds *npool.Conds) (bool, error) {
 		}
 	}()
 
-	span = tracer.TraceConds(span, conds)
+	span = traceConds(span, conds)
 
 	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
 		stm, err := SetQueryConds(conds, cli)
@@ -456,10 +494,13 @@ func Delete(ctx context.Context, id uuid.UUID) (*ent.Review, error) {
 
 	span = commontracer.TraceID(span, id.String())
 
-	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
-		info, err = cli.Review.UpdateOneID(id).
-			SetDeletedAt(uint32(time.Now().Unix())).
-			Save(_ctx)
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		old, err := tx.Review.Query().Where(review.ID(id)).ForUpdate().Only(_ctx)
+		if err != nil {
+			return err
+		}
+
+		info, err = deleteTx(_ctx, tx, old)
 		return err
 	})
 	if err != nil {
@@ -468,3 +509,22 @@ func Delete(ctx context.Context, id uuid.UUID) (*ent.Review, error) {
 
 	return info, nil
 }
+
+// deleteTx soft deletes old, which the caller locked in tx, with its history and event
+func deleteTx(ctx context.Context, tx *ent.Tx, old *ent.Review) (*ent.Review, error) {
+	info, err := old.Update().
+		SetDeletedAt(uint32(time.Now().Unix())).
+		ClearOpenKey().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eventcrud.CreateTx(ctx, tx, info.AppID, info.ID, eventcrud.Diff(actor.FromContext(ctx), old, info)); err != nil {
+		return nil, err
+	}
+	if err := publishDeleted(ctx, tx, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -5,17 +5,34 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
+	outboxcrud "github.com/NpoolPlatform/review-manager/pkg/crud/outbox"
+	decisioncrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewdecision"
+	poolcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewerpool"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/privacy"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
 
 	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
 
 	valuedef "github.com/NpoolPlatform/message/npool"
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+	"github.com/NpoolPlatform/review-manager/pkg/openkey"
+	"github.com/NpoolPlatform/review-manager/pkg/precondition"
+	"github.com/NpoolPlatform/review-manager/pkg/reason"
+	"github.com/NpoolPlatform/review-manager/pkg/sla"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
 	testinit "github.com/NpoolPlatform/review-manager/pkg/testinit"
 	"github.com/google/uuid"
 
+	"google.golang.org/grpc/metadata"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -66,6 +83,9 @@ func create(t *testing.T) {
 	if assert.Nil(t, err) {
 		ret.UpdatedAt = info.UpdatedAt
 		ret.CreatedAt = info.CreatedAt
+		ret.DueAt = info.CreatedAt + uint32(sla.SLA(ret.ObjectType).Seconds())
+		openKey := openkey.Key(ret.AppID, ret.Domain, ret.ObjectID, ret.ObjectType)
+		ret.OpenKey = &openKey
 		assert.Equal(t, info.String(), ret.String())
 	}
 }
@@ -119,6 +139,31 @@ func createBulk(t *testing.T) {
 	}
 }
 
+func createIdempotent(t *testing.T) {
+	_id := uuid.NewString()
+	_req := &npool.ReviewReq{
+		ID:         &_id,
+		AppID:      &appID,
+		Domain:     &ret.Domain,
+		ObjectID:   &objectID,
+		Trigger:    &trigger,
+		ObjectType: &objectType,
+	}
+
+	info, err := Create(context.Background(), _req)
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.ID, ret.ID)
+	}
+
+	infos, err := CreateBulk(context.Background(), []*npool.ReviewReq{_req, _req})
+	if assert.Nil(t, err) {
+		assert.Equal(t, len(infos), 2)
+		for _, info := range infos {
+			assert.Equal(t, info.ID, ret.ID)
+		}
+	}
+}
+
 func row(t *testing.T) {
 	var err error
 	info, err = Row(context.Background(), ret.ID)
@@ -132,6 +177,8 @@ func update(t *testing.T) {
 
 	state := npool.ReviewState_Approved
 	ret.State = state.String()
+	ret.OpenKey = nil
+	ret.Version = 1
 	req.State = &state
 
 	info, err = Update(context.Background(), &req)
@@ -141,12 +188,590 @@ func update(t *testing.T) {
 	}
 }
 
+func updateConflict(t *testing.T) {
+	message := uuid.NewString()
+	stale := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(tenant.AppIDKey, appID, precondition.VersionKey, fmt.Sprintf("%v", ret.Version-1)),
+	)
+
+	_, err := Update(stale, &npool.ReviewReq{
+		ID:      &id,
+		Message: &message,
+	})
+	assert.ErrorAs(t, err, new(*ConflictError))
+
+	current := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(tenant.AppIDKey, appID, precondition.VersionKey, fmt.Sprintf("%v", ret.Version)),
+	)
+	info, err := Update(current, &npool.ReviewReq{
+		ID:      &id,
+		Message: &message,
+	})
+	if assert.Nil(t, err) {
+		ret.Message = message
+		ret.Version = info.Version
+		ret.UpdatedAt = info.UpdatedAt
+		assert.Equal(t, info.Version, uint32(2))
+	}
+}
+
+func updateTerminal(t *testing.T) {
+	state := npool.ReviewState_Wait
+
+	_, err := Update(context.Background(), &npool.ReviewReq{
+		ID:    &id,
+		State: &state,
+	})
+	assert.ErrorAs(t, err, new(*TransitionError))
+}
+
+func history(t *testing.T) {
+	infos, total, err := eventcrud.Rows(context.Background(), ret.ID, 0, 10)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 3)
+		for _, _info := range infos {
+			if _info.Event == eventcrud.EventStateChanged {
+				assert.Equal(t, _info.NewValue, ret.State)
+			}
+		}
+	}
+}
+
+func outboxRows(t *testing.T) {
+	err := db.WithClient(context.Background(), func(ctx context.Context, cli *ent.Client) error {
+		total, err := cli.Outbox.Query().Where(outbox.PayloadContains(ret.ID.String())).Count(ctx)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, total, 2)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func outboxPurge(t *testing.T) {
+	var delivered, pending *ent.Outbox
+	err := db.WithClient(context.Background(), func(ctx context.Context, cli *ent.Client) error {
+		var err error
+		delivered, err = cli.Outbox.Create().
+			SetRoutingKey(uuid.NewString()).
+			SetState(outboxcrud.StateDelivered).
+			SetDeliveredAt(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		pending, err = cli.Outbox.Create().
+			SetRoutingKey(uuid.NewString()).
+			SetState(outboxcrud.StatePending).
+			Save(ctx)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	_, err = outboxcrud.Purge(context.Background(), 2, 100)
+	if assert.Nil(t, err) {
+		err = db.WithClient(context.Background(), func(ctx context.Context, cli *ent.Client) error {
+			exist, err := cli.Outbox.Query().Where(outbox.ID(delivered.ID)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			assert.False(t, exist)
+			exist, err = cli.Outbox.Query().Where(outbox.ID(pending.ID)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			assert.True(t, exist)
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+}
+
+// fixture is a new app and domain with reviews of their own objects, so a step only meets its own reviews
+type fixture struct {
+	appID  string
+	domain string
+	infos  []*ent.Review
+}
+
+// newFixture creates n reviews of objectType, and of trigger when it is not nil, in a new fixture
+func newFixture(t *testing.T, n int, objectType npool.ReviewObjectType, trigger *npool.ReviewTriggerType) (*fixture, bool) {
+	f := &fixture{
+		appID:  uuid.NewString(),
+		domain: uuid.NewString(),
+	}
+	for i := 0; i < n; i++ {
+		objectID := uuid.NewString()
+		info, err := Create(context.Background(), &npool.ReviewReq{
+			AppID:      &f.appID,
+			Domain:     &f.domain,
+			ObjectID:   &objectID,
+			ObjectType: &objectType,
+			Trigger:    trigger,
+		})
+		if !assert.Nil(t, err) {
+			return nil, false
+		}
+		f.infos = append(f.infos, info)
+	}
+	return f, true
+}
+
+func claimRelease(t *testing.T) {
+	objectType := npool.ReviewObjectType_ObjectKyc
+
+	f, ok := newFixture(t, 1, objectType, nil)
+	if !ok {
+		return
+	}
+	created := f.infos[0]
+
+	reviewerID := uuid.New()
+	info, err := Claim(context.Background(), f.domain, objectType, reviewerID, DefaultLease)
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.ID, created.ID)
+		assert.Equal(t, info.ReviewerID, reviewerID)
+	}
+
+	_, err = Claim(context.Background(), f.domain, objectType, uuid.New(), DefaultLease)
+	assert.True(t, ent.IsNotFound(err))
+
+	otherID := uuid.NewString()
+	_createdID := created.ID.String()
+	_, err = Update(context.Background(), &npool.ReviewReq{
+		ID:         &_createdID,
+		ReviewerID: &otherID,
+	})
+	assert.ErrorAs(t, err, new(*LeaseError))
+
+	info, err = Release(context.Background(), created.ID, reviewerID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.ReviewerID, uuid.Nil)
+	}
+
+	_, err = Release(context.Background(), created.ID, reviewerID)
+	assert.ErrorAs(t, err, new(*LeaseError))
+}
+
+func claimOldest(t *testing.T) {
+	objectType := npool.ReviewObjectType_ObjectKyc
+
+	f, ok := newFixture(t, 2, objectType, nil)
+	if !ok {
+		return
+	}
+	created := f.infos
+
+	// Both are created in the same second, so either may come first
+	claimed := map[uuid.UUID]bool{}
+	for range created {
+		info, err := Claim(context.Background(), f.domain, objectType, uuid.New(), DefaultLease)
+		if assert.Nil(t, err) {
+			claimed[info.ID] = true
+		}
+	}
+	assert.Equal(t, claimed, map[uuid.UUID]bool{created[0].ID: true, created[1].ID: true})
+
+	_, err := Claim(context.Background(), f.domain, objectType, uuid.New(), DefaultLease)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func poolRebalance(t *testing.T) {
+	appID := uuid.New()
+	domain := uuid.NewString()
+	objectType := npool.ReviewObjectType_ObjectWithdrawal
+
+	pool, err := poolcrud.CreatePool(context.Background(), appID, domain, objectType, poolcrud.StrategyRoundRobin)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	row, err := poolcrud.Row(context.Background(), pool.ID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, row.AppID, appID)
+	}
+
+	reviewerIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	for _, reviewerID := range reviewerIDs {
+		_, err := poolcrud.AddMember(context.Background(), pool.ID, reviewerID, 1)
+		assert.Nil(t, err)
+	}
+
+	_appID := appID.String()
+	reqs := []*npool.ReviewReq{}
+	for range reviewerIDs {
+		objectID := uuid.NewString()
+		reqs = append(reqs, &npool.ReviewReq{
+			AppID:      &_appID,
+			Domain:     &domain,
+			ObjectID:   &objectID,
+			ObjectType: &objectType,
+		})
+	}
+
+	infos, err := CreateBulk(context.Background(), reqs)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assigned := map[uuid.UUID]bool{}
+	for _, info := range infos {
+		assigned[info.ReviewerID] = true
+	}
+	assert.Equal(t, len(assigned), len(reviewerIDs))
+
+	_, err = poolcrud.SetOnShift(context.Background(), pool.ID, reviewerIDs[0], false)
+	assert.Nil(t, err)
+
+	moved, err := Rebalance(context.Background(), pool.ID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, moved, 1)
+	}
+
+	for _, info := range infos {
+		info, err := Row(context.Background(), info.ID)
+		if assert.Nil(t, err) {
+			assert.Equal(t, info.ReviewerID, reviewerIDs[1])
+		}
+	}
+}
+
+func overdue(t *testing.T) {
+	f, ok := newFixture(t, 1, npool.ReviewObjectType_ObjectWithdrawal, nil)
+	if !ok {
+		return
+	}
+	info := f.infos[0]
+	assert.NotEqual(t, info.DueAt, uint32(0))
+
+	err := db.WithClient(context.Background(), func(ctx context.Context, cli *ent.Client) error {
+		_, err := cli.Review.UpdateOneID(info.ID).SetDueAt(info.CreatedAt - 1).Save(ctx)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	conds := &Conds{
+		ID:      &cruder.Cond{Op: cruder.EQ, Val: info.ID},
+		Overdue: &cruder.Cond{Op: cruder.EQ, Val: true},
+	}
+	_, total, err := Rows(context.Background(), conds, 0, 1)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 1)
+	}
+
+	_, err = Escalate(context.Background(), 100)
+	assert.Nil(t, err)
+
+	conds.Escalated = &cruder.Cond{Op: cruder.EQ, Val: true}
+	_, total, err = Rows(context.Background(), conds, 0, 1)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 1)
+	}
+}
+
+func resubmit(t *testing.T) {
+	f, ok := newFixture(t, 1, npool.ReviewObjectType_ObjectKyc, nil)
+	if !ok {
+		return
+	}
+	info := f.infos[0]
+
+	err := db.WithClient(context.Background(), func(ctx context.Context, cli *ent.Client) error {
+		_, err := cli.Review.UpdateOneID(info.ID).
+			SetDueAt(info.CreatedAt - 1).
+			SetEscalatedAt(info.CreatedAt).
+			Save(ctx)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	id := info.ID.String()
+	reviewerID := uuid.NewString()
+	for _, state := range []npool.ReviewState{npool.ReviewState_Rejected, npool.ReviewState_Wait} {
+		state := state
+		info, err = Update(context.Background(), &npool.ReviewReq{
+			ID:         &id,
+			ReviewerID: &reviewerID,
+			State:      &state,
+		})
+		if !assert.Nil(t, err) {
+			return
+		}
+	}
+
+	assert.True(t, info.DueAt > info.CreatedAt)
+	assert.Equal(t, info.EscalatedAt, uint32(0))
+}
+
+func quorumUpdate(t *testing.T) {
+	trigger := npool.ReviewTriggerType_LargeAmount
+
+	f, ok := newFixture(t, 1, npool.ReviewObjectType_ObjectWithdrawal, &trigger)
+	if !ok {
+		return
+	}
+	created := f.infos[0]
+
+	id := created.ID.String()
+	state := npool.ReviewState_Approved
+	reviewerIDs := []string{uuid.NewString(), uuid.NewString()}
+
+	info, err := Update(context.Background(), &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &reviewerIDs[0],
+		State:      &state,
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.State, npool.ReviewState_Wait.String())
+	}
+
+	_, err = Update(context.Background(), &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &reviewerIDs[0],
+		State:      &state,
+	})
+	assert.ErrorAs(t, err, new(*DecisionError))
+
+	info, err = Update(context.Background(), &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &reviewerIDs[1],
+		State:      &state,
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.State, npool.ReviewState_Approved.String())
+	}
+
+	decisions, err := decisioncrud.Rows(context.Background(), created.ID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, len(decisions), 2)
+	}
+}
+
+func claimQuorum(t *testing.T) {
+	objectType := npool.ReviewObjectType_ObjectWithdrawal
+	trigger := npool.ReviewTriggerType_LargeAmount
+
+	f, ok := newFixture(t, 1, objectType, &trigger)
+	if !ok {
+		return
+	}
+	created := f.infos[0]
+
+	id := created.ID.String()
+	state := npool.ReviewState_Approved
+	reviewerIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	_, err := Claim(context.Background(), f.domain, objectType, reviewerIDs[0], DefaultLease)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	_reviewerID := reviewerIDs[0].String()
+	info, err := Update(context.Background(), &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &_reviewerID,
+		State:      &state,
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.State, npool.ReviewState_Wait.String())
+		assert.Equal(t, info.ReviewerID, uuid.Nil)
+		assert.Equal(t, info.LeaseExpiresAt, uint32(0))
+	}
+
+	info, err = Claim(context.Background(), f.domain, objectType, reviewerIDs[1], DefaultLease)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, info.ID, created.ID)
+
+	_reviewerID = reviewerIDs[2].String()
+	info, err = Update(context.Background(), &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &_reviewerID,
+		State:      &state,
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.State, npool.ReviewState_Approved.String())
+		assert.Equal(t, info.ReviewerID, reviewerIDs[2])
+		assert.Equal(t, info.LeaseExpiresAt, uint32(0))
+	}
+}
+
+func reasonCodes(t *testing.T) {
+	f, ok := newFixture(t, 1, npool.ReviewObjectType_ObjectKyc, nil)
+	if !ok {
+		return
+	}
+	created := f.infos[0]
+
+	id := created.ID.String()
+	reviewerID := uuid.NewString()
+	state := npool.ReviewState_Rejected
+
+	invalid := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(tenant.AppIDKey, f.appID, reason.Key, "DOCUMENTS_VERIFIED"),
+	)
+	_, err := Update(invalid, &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &reviewerID,
+		State:      &state,
+	})
+	assert.ErrorAs(t, err, new(*reason.Error))
+
+	codes := reason.Codes(created.ObjectType, state)[:2]
+	valid := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(tenant.AppIDKey, f.appID, reason.Key, strings.Join(codes, ",")),
+	)
+	info, err := Update(valid, &npool.ReviewReq{
+		ID:         &id,
+		ReviewerID: &reviewerID,
+		State:      &state,
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.Reasons, codes)
+	}
+
+	_, total, err := Rows(context.Background(), &Conds{
+		Domain: &cruder.Cond{Op: cruder.EQ, Val: f.domain},
+		Reason: &cruder.Cond{Op: cruder.EQ, Val: codes[1]},
+	}, 0, 0)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 1)
+	}
+}
+
+func bulkUpdate(t *testing.T) {
+	f, ok := newFixture(t, 2, npool.ReviewObjectType_ObjectWithdrawal, nil)
+	if !ok {
+		return
+	}
+	ids := []uuid.UUID{}
+	for _, created := range f.infos {
+		ids = append(ids, created.ID)
+	}
+
+	reviewerID := uuid.NewString()
+	state := npool.ReviewState_Rejected
+	message := "fraud"
+
+	outcomes, err := UpdateMany(context.Background(), append(ids, uuid.New()), nil, &npool.ReviewReq{
+		ReviewerID: &reviewerID,
+		State:      &state,
+		Message:    &message,
+	})
+	if assert.Nil(t, err) && assert.Equal(t, len(outcomes), 3) {
+		for _, outcome := range outcomes[:2] {
+			if assert.Nil(t, outcome.Err) {
+				assert.Equal(t, outcome.Info.State, state.String())
+				assert.Equal(t, outcome.Info.Message, message)
+			}
+		}
+		assert.True(t, ent.IsNotFound(outcomes[2].Err))
+	}
+
+	state = npool.ReviewState_Approved
+	outcomes, err = UpdateMany(context.Background(), nil, &Conds{
+		Domain: &cruder.Cond{Op: cruder.EQ, Val: f.domain},
+	}, &npool.ReviewReq{
+		ReviewerID: &reviewerID,
+		State:      &state,
+	})
+	if assert.Nil(t, err) && assert.Equal(t, len(outcomes), 2) {
+		for _, outcome := range outcomes {
+			assert.ErrorAs(t, outcome.Err, new(*TransitionError))
+		}
+	}
+}
+
+func bulkDelete(t *testing.T) {
+	f, ok := newFixture(t, 3, npool.ReviewObjectType_DefaultObjectType, nil)
+	if !ok {
+		return
+	}
+
+	conds := &Conds{
+		Domain: &cruder.Cond{Op: cruder.EQ, Val: f.domain},
+	}
+
+	_, err := DeleteMany(context.Background(), nil, conds, 2, false)
+	assert.ErrorAs(t, err, new(*MaxRowsError))
+
+	count, err := DeleteMany(context.Background(), nil, conds, 3, true)
+	if assert.Nil(t, err) {
+		assert.Equal(t, count, 3)
+	}
+
+	count, err = DeleteMany(context.Background(), nil, conds, 3, false)
+	if assert.Nil(t, err) {
+		assert.Equal(t, count, 3)
+	}
+
+	_, total, err := Rows(context.Background(), conds, 0, 0)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 0)
+	}
+}
+
+func restorePurge(t *testing.T) {
+	f, ok := newFixture(t, 1, npool.ReviewObjectType_DefaultObjectType, nil)
+	if !ok {
+		return
+	}
+	created := f.infos[0]
+
+	_, err := Delete(context.Background(), created.ID)
+	assert.Nil(t, err)
+
+	conds := &Conds{
+		ID: &cruder.Cond{Op: cruder.EQ, Val: created.ID},
+	}
+	_, total, err := DeletedRows(context.Background(), conds, 0, 1)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 1)
+	}
+
+	info, err := Restore(context.Background(), created.ID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.DeletedAt, uint32(0))
+		assert.NotNil(t, info.OpenKey)
+	}
+
+	_, err = Delete(context.Background(), created.ID)
+	assert.Nil(t, err)
+
+	err = db.WithClient(withDeleted(context.Background()), func(ctx context.Context, cli *ent.Client) error {
+		_, err := cli.Review.UpdateOneID(created.ID).SetDeletedAt(1).Save(ctx)
+		return err
+	})
+	assert.Nil(t, err)
+
+	purged, err := Purge(context.Background(), 2, 10)
+	if assert.Nil(t, err) {
+		assert.Equal(t, purged, 1)
+	}
+
+	_, total, err = DeletedRows(context.Background(), conds, 0, 1)
+	if assert.Nil(t, err) {
+		assert.Equal(t, total, 0)
+	}
+}
+
 func rows(t *testing.T) {
 	infos, total, err := Rows(context.Background(),
-		&npool.Conds{
-			ID: &valuedef.StringVal{
-				Value: id,
-				Op:    cruder.EQ,
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.EQ,
+				Val: ret.ID,
 			},
 		}, 0, 0)
 	if assert.Nil(t, err) {
@@ -156,13 +781,101 @@ func rows(t *testing.T) {
 	}
 }
 
+func rowsIn(t *testing.T) {
+	infos, total, err := Rows(context.Background(),
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.IN,
+				Val: []uuid.UUID{ret.ID, uuid.New()},
+			},
+			ObjectType: &cruder.Cond{
+				Op:  NEQ,
+				Val: npool.ReviewObjectType_ObjectWithdrawal.String(),
+			},
+			State: &cruder.Cond{
+				Op:  NIN,
+				Val: []string{npool.ReviewState_Wait.String(), npool.ReviewState_Rejected.String()},
+			},
+		}, 0, 0)
+	if assert.Nil(t, err) {
+		if assert.Equal(t, total, 1) {
+			assert.Equal(t, infos[0].String(), ret.String())
+		}
+	}
+}
+
+func rowsExtConds(t *testing.T) {
+	infos, total, err := Rows(context.Background(),
+		ConvertExtConds(&extmgr.Conds{
+			IDs: &valuedef.StringSliceVal{
+				Op:    cruder.IN,
+				Value: []string{ret.ID.String(), uuid.NewString()},
+			},
+			AppIDs: &valuedef.StringSliceVal{
+				Op:    cruder.IN,
+				Value: []string{ret.AppID.String()},
+			},
+			States: &extmgr.Int32SliceVal{
+				Op:    NIN,
+				Value: []int32{int32(npool.ReviewState_Wait), int32(npool.ReviewState_Rejected)},
+			},
+			CreatedAt: []*valuedef.Uint32Val{
+				{Op: GTE, Value: ret.CreatedAt},
+				{Op: LTE, Value: ret.CreatedAt},
+			},
+		}), 0, 0, ConvertOrders([]*extmgr.Order{
+			{Field: review.FieldState},
+			{Field: review.FieldCreatedAt, Desc: true},
+		})...)
+	if assert.Nil(t, err) {
+		if assert.Equal(t, total, 1) {
+			assert.Equal(t, infos[0].String(), ret.String())
+		}
+	}
+}
+
+func rowsRange(t *testing.T) {
+	infos, total, err := Rows(context.Background(),
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.EQ,
+				Val: ret.ID,
+			},
+			CreatedAt: []*cruder.Cond{
+				{Op: GTE, Val: ret.CreatedAt},
+				{Op: cruder.LT, Val: ret.CreatedAt + 1},
+			},
+		}, 0, 1, &Order{Field: review.FieldCreatedAt})
+	if assert.Nil(t, err) {
+		if assert.Equal(t, total, 1) {
+			assert.Equal(t, infos[0].String(), ret.String())
+		}
+	}
+}
+
+func rowsAfter(t *testing.T) {
+	conds := &Conds{
+		ID: &cruder.Cond{
+			Op:  cruder.EQ,
+			Val: ret.ID,
+		},
+	}
+	infos, next, err := RowsAfter(context.Background(), conds, "", 1)
+	if assert.Nil(t, err) {
+		if assert.Equal(t, len(infos), 1) {
+			assert.Equal(t, infos[0].String(), ret.String())
+		}
+		assert.Equal(t, next, "")
+	}
+}
+
 func rowOnly(t *testing.T) {
 	var err error
 	info, err = RowOnly(context.Background(),
-		&npool.Conds{
-			ID: &valuedef.StringVal{
-				Value: id,
-				Op:    cruder.EQ,
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.EQ,
+				Val: ret.ID,
 			},
 		})
 	if assert.Nil(t, err) {
@@ -170,12 +883,56 @@ func rowOnly(t *testing.T) {
 	}
 }
 
+func tenantScope(t *testing.T) {
+	same := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenant.AppIDKey, appID))
+	info, err := Row(same, ret.ID)
+	if assert.Nil(t, err) {
+		assert.Equal(t, info.ID, ret.ID)
+	}
+
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenant.AppIDKey, uuid.NewString()))
+	_, err = Row(other, ret.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	otherAppID := uuid.NewString()
+	domain := uuid.NewString()
+	otherObjectID := uuid.NewString()
+	_, err = Create(same, &npool.ReviewReq{
+		AppID:    &otherAppID,
+		Domain:   &domain,
+		ObjectID: &otherObjectID,
+	})
+	assert.ErrorIs(t, err, privacy.Deny)
+
+	events, total, err := eventcrud.Rows(same, ret.ID, 0, 10)
+	if assert.Nil(t, err) {
+		assert.Equal(t, len(events), total)
+		assert.NotEqual(t, 0, total)
+		for _, event := range events {
+			assert.Equal(t, appID, event.AppID.String())
+		}
+	}
+
+	_, total, err = eventcrud.Rows(other, ret.ID, 0, 10)
+	if assert.Nil(t, err) {
+		assert.Equal(t, 0, total)
+	}
+
+	// tenant.ModeEnforce, the default, denies a caller without app
+	anonymous := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	_, err = Row(anonymous, ret.ID)
+	assert.ErrorIs(t, err, privacy.Deny)
+
+	_, _, err = Rows(anonymous, &Conds{}, 0, 1)
+	assert.ErrorIs(t, err, privacy.Deny)
+}
+
 func count(t *testing.T) {
 	count, err := Count(context.Background(),
-		&npool.Conds{
-			ID: &valuedef.StringVal{
-				Value: id,
-				Op:    cruder.EQ,
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.EQ,
+				Val: ret.ID,
 			},
 		},
 	)
@@ -193,10 +950,10 @@ func exist(t *testing.T) {
 
 func existConds(t *testing.T) {
 	exist, err := ExistConds(context.Background(),
-		&npool.Conds{
-			ID: &valuedef.StringVal{
-				Value: id,
-				Op:    cruder.EQ,
+		&Conds{
+			ID: &cruder.Cond{
+				Op:  cruder.EQ,
+				Val: ret.ID,
 			},
 		},
 	)
@@ -219,10 +976,32 @@ func TestReview(t *testing.T) {
 	}
 	t.Run("create", create)
 	t.Run("createBulk", createBulk)
+	t.Run("createIdempotent", createIdempotent)
 	t.Run("update", update)
+	t.Run("updateConflict", updateConflict)
+	t.Run("updateTerminal", updateTerminal)
+	t.Run("history", history)
+	t.Run("outbox", outboxRows)
+	t.Run("outboxPurge", outboxPurge)
+	t.Run("claimRelease", claimRelease)
+	t.Run("claimOldest", claimOldest)
+	t.Run("poolRebalance", poolRebalance)
+	t.Run("overdue", overdue)
+	t.Run("resubmit", resubmit)
+	t.Run("quorum", quorumUpdate)
+	t.Run("claimQuorum", claimQuorum)
+	t.Run("reasonCodes", reasonCodes)
+	t.Run("bulkUpdate", bulkUpdate)
+	t.Run("bulkDelete", bulkDelete)
+	t.Run("restorePurge", restorePurge)
 	t.Run("row", row)
 	t.Run("rows", rows)
+	t.Run("rowsIn", rowsIn)
+	t.Run("rowsExtConds", rowsExtConds)
+	t.Run("rowsRange", rowsRange)
+	t.Run("rowsAfter", rowsAfter)
 	t.Run("rowOnly", rowOnly)
+	t.Run("tenantScope", tenantScope)
 	t.Run("exist", exist)
 	t.Run("existConds", existConds)
 	t.Run("count", count)
@@ -0,0 +1,132 @@
+package review
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+type cursor struct {
+	UpdatedAt uint32    `json:"u"`
+	ID        uuid.UUID `json:"i"`
+}
+
+func encodeCursor(row *ent.Review) (string, error) {
+	b, err := json.Marshal(&cursor{
+		UpdatedAt: row.UpdatedAt,
+		ID:        row.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(token string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	return c, nil
+}
+
+// ValidateCursor checks token is a token returned by RowsAfter, an empty token is valid
+func ValidateCursor(token string) error {
+	if token == "" {
+		return nil
+	}
+	_, err := decodeCursor(token)
+	return err
+}
+
+// RowsAfter lists reviews by (updated_at, id) descending starting after token, an empty token starts
+// from the latest review. The returned token is empty on the last page.
+func RowsAfter(ctx context.Context, conds *Conds, token string, limit int) ([]*ent.Review, string, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "RowsAfter")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = traceConds(span, conds)
+	span = commontracer.TraceOffsetLimit(span, 0, limit)
+	span.SetAttributes(attribute.String("Cursor", token))
+
+	if limit <= 0 {
+		err = fmt.Errorf("invalid limit %v", limit)
+		return nil, "", err
+	}
+
+	var after *cursor
+	if token != "" {
+		after, err = decodeCursor(token)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	rows := []*ent.Review{}
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		stm, err := SetQueryConds(conds, cli)
+		if err != nil {
+			return err
+		}
+
+		if after != nil {
+			stm.Where(
+				review.Or(
+					review.UpdatedAtLT(after.UpdatedAt),
+					review.And(
+						review.UpdatedAt(after.UpdatedAt),
+						review.IDLT(after.ID),
+					),
+				),
+			)
+		}
+
+		rows, err = stm.
+			Order(ent.Desc(review.FieldUpdatedAt), ent.Desc(review.FieldID)).
+			Limit(limit + 1).
+			All(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(rows) <= limit {
+		return rows, "", nil
+	}
+
+	rows = rows[:limit]
+	next, err := encodeCursor(rows[limit-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rows, next, nil
+}
@@ -0,0 +1,97 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	decisioncrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/quorum"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/google/uuid"
+)
+
+// DecisionError is returned when a reviewer decides twice in one review round
+type DecisionError struct {
+	ID         uuid.UUID
+	ReviewerID uuid.UUID
+}
+
+func (e *DecisionError) Error() string {
+	return fmt.Sprintf("reviewer %v already decided review %v", e.ReviewerID, e.ID)
+}
+
+// decide records an Approved or Rejected request as the decision of reviewerID, and returns
+// the request carrying the state derived from all decisions of the round: Rejected on any
+// rejection, Approved once the quorum approves, otherwise no state change and no reviewer change.
+// A request moving the review back to Wait starts a new round.
+func decide(
+	ctx context.Context,
+	tx *ent.Tx,
+	info *ent.Review,
+	reviewerID uuid.UUID,
+	in *npool.ReviewReq,
+	reasons []string,
+) (*npool.ReviewReq, error) {
+	if in.State == nil {
+		return in, nil
+	}
+
+	switch in.GetState() {
+	case npool.ReviewState_Approved, npool.ReviewState_Rejected:
+	case npool.ReviewState_Wait:
+		if info.State != npool.ReviewState_Wait.String() {
+			if err := decisioncrud.ResetTx(ctx, tx, info.ID); err != nil {
+				return nil, err
+			}
+		}
+		return in, nil
+	default:
+		return in, nil
+	}
+
+	if err := ValidateTransition(info.ObjectType, info.State, in.GetState()); err != nil {
+		return nil, err
+	}
+	if reviewerID == uuid.Nil {
+		return nil, fmt.Errorf("decision of review %v needs a reviewer", info.ID)
+	}
+
+	decisions, err := decisioncrud.RowsTx(ctx, tx, info.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := 0
+	for _, decision := range decisions {
+		if decision.ReviewerID == reviewerID {
+			return nil, &DecisionError{ID: info.ID, ReviewerID: reviewerID}
+		}
+		if decision.Decision == npool.ReviewState_Approved.String() {
+			approvals++
+		}
+	}
+
+	err = decisioncrud.CreateTx(ctx, tx, info.AppID, info.ID, reviewerID, in.GetState().String(), in.GetMessage(), reasons)
+	if err != nil {
+		return nil, err
+	}
+
+	req := proto.Clone(in).(*npool.ReviewReq)
+	if in.GetState() == npool.ReviewState_Approved && approvals+1 < quorum.Approvals(info.ObjectType, info.Trigger) {
+		req.State = nil
+		req.ReviewerID = nil
+	}
+
+	return req, nil
+}
+
+// decision tells if in records the decision of a reviewer
+func decision(in *npool.ReviewReq) bool {
+	return in.State != nil &&
+		(in.GetState() == npool.ReviewState_Approved || in.GetState() == npool.ReviewState_Rejected)
+}
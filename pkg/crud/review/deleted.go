@@ -0,0 +1,184 @@
+package review
+
+import (
+	"context"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/rule"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// withDeleted lets the queries of ctx see soft deleted rows, which rule.FilterTimeRule hides.
+// The app scope of rule.FilterTenantRule still applies.
+func withDeleted(ctx context.Context) context.Context {
+	return rule.WithDeleted(ctx)
+}
+
+// Restore undoes Delete. A restored Wait review takes back its open key,
+// so it fails with a constraint error when its object got another open review meanwhile.
+func Restore(ctx context.Context, id uuid.UUID) (*ent.Review, error) {
+	var info *ent.Review
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Restore")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, id.String())
+
+	err = db.WithTx(withDeleted(ctx), func(_ctx context.Context, tx *ent.Tx) error {
+		old, err := tx.Review.
+			Query().
+			Where(
+				review.ID(id),
+				review.DeletedAtGT(0),
+			).
+			ForUpdate().
+			Only(_ctx)
+		if err != nil {
+			return err
+		}
+
+		stm := old.Update().SetDeletedAt(0)
+		if old.State == npool.ReviewState_Wait.String() {
+			stm = stm.SetOpenKey(rowOpenKey(old))
+		}
+
+		info, err = stm.Save(_ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := eventcrud.CreateTx(_ctx, tx, info.AppID, info.ID, eventcrud.Diff(actor.FromContext(_ctx), old, info)); err != nil {
+			return err
+		}
+		return publishRestored(_ctx, tx, info)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// DeletedRows lists the soft deleted reviews matching conds, the latest deleted first
+func DeletedRows(ctx context.Context, conds *Conds, offset, limit int) ([]*ent.Review, int, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "DeletedRows")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = traceConds(span, conds)
+	span = commontracer.TraceOffsetLimit(span, offset, limit)
+
+	rows := []*ent.Review{}
+	var total int
+	err = db.WithClient(withDeleted(ctx), func(_ctx context.Context, cli *ent.Client) error {
+		stm, err := SetQueryConds(conds, cli)
+		if err != nil {
+			return err
+		}
+		stm.Where(review.DeletedAtGT(0))
+
+		total, err = stm.Count(_ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err = stm.
+			Order(ent.Desc(review.FieldDeletedAt), ent.Desc(review.FieldID)).
+			Offset(offset).
+			Limit(limit).
+			All(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+// Purge hard deletes at most limit reviews soft deleted before deletedBefore, with their events
+// and decisions. It returns the number of purged reviews, call it until it returns less than limit.
+func Purge(ctx context.Context, deletedBefore uint32, limit int) (int, error) {
+	var err error
+	purged := 0
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Purge")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("DeletedBefore", int64(deletedBefore)),
+		attribute.Int("Limit", limit),
+	)
+
+	err = db.WithTx(withDeleted(ctx), func(_ctx context.Context, tx *ent.Tx) error {
+		ids, err := tx.Review.
+			Query().
+			Where(
+				review.DeletedAtGT(0),
+				review.DeletedAtLT(deletedBefore),
+			).
+			Order(ent.Asc(review.FieldDeletedAt)).
+			Limit(limit).
+			ForUpdate().
+			IDs(_ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if _, err := tx.ReviewEvent.Delete().Where(reviewevent.ReviewIDIn(ids...)).Exec(_ctx); err != nil {
+			return err
+		}
+		if _, err := tx.ReviewDecision.Delete().Where(reviewdecision.ReviewIDIn(ids...)).Exec(_ctx); err != nil {
+			return err
+		}
+
+		purged, err = tx.Review.Delete().Where(review.IDIn(ids...)).Exec(_ctx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
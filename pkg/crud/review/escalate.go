@@ -0,0 +1,83 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// Escalate marks at most limit overdue Wait reviews as escalated and queues a ReviewEscalated
+// message for each of them. A review is escalated once, rows locked by another replica are skipped.
+func Escalate(ctx context.Context, limit int) ([]*ent.Review, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Escalate")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(attribute.Int("Limit", limit))
+
+	infos := []*ent.Review{}
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		now := uint32(time.Now().Unix())
+
+		rows, err := tx.Review.
+			Query().
+			Where(
+				review.State(npool.ReviewState_Wait.String()),
+				review.DueAtGT(0),
+				review.DueAtLTE(now),
+				review.EscalatedAt(0),
+			).
+			Order(ent.Asc(review.FieldDueAt)).
+			Limit(limit).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			info, err := row.Update().SetEscalatedAt(now).Save(_ctx)
+			if err != nil {
+				return err
+			}
+			if err := eventcrud.CreateTx(_ctx, tx, info.AppID, info.ID, eventcrud.Diff(uuid.Nil, row, info)); err != nil {
+				return err
+			}
+			if err := publishEscalated(_ctx, tx, info); err != nil {
+				return err
+			}
+			infos = append(infos, info)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,70 @@
+package review
+
+import (
+	"context"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	poolcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewerpool"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/openkey"
+
+	"github.com/google/uuid"
+)
+
+// createAttempts covers a concurrent create of the same object: the loser hits the
+// unique open_key, and its retry finds the review of the winner
+const createAttempts = 2
+
+func reqOpenKey(in *npool.ReviewReq) string {
+	appID := uuid.Nil
+	if in.AppID != nil {
+		appID = uuid.MustParse(in.GetAppID())
+	}
+	objectID := uuid.Nil
+	if in.ObjectID != nil {
+		objectID = uuid.MustParse(in.GetObjectID())
+	}
+	return openkey.Key(appID, in.GetDomain(), objectID, in.GetObjectType().String())
+}
+
+func rowOpenKey(info *ent.Review) string {
+	return openkey.Key(info.AppID, info.Domain, info.ObjectID, info.ObjectType)
+}
+
+// createTx returns the open review of the object of in, creating it when there is none
+func createTx(ctx context.Context, tx *ent.Tx, picker *poolcrud.Picker, in *npool.ReviewReq) (*ent.Review, error) {
+	info, err := tx.Review.
+		Query().
+		Where(review.OpenKey(reqOpenKey(in))).
+		ForUpdate().
+		Only(ctx)
+	if err == nil {
+		return info, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	c := CreateSet(tx.Review.Create(), in)
+	if err := assignReviewer(ctx, picker, c, in); err != nil {
+		return nil, err
+	}
+
+	info, err = c.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eventcrud.CreateTx(ctx, tx, info.AppID, info.ID, eventcrud.Diff(actor.FromContext(ctx), nil, info)); err != nil {
+		return nil, err
+	}
+	if err := publishCreated(ctx, tx, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,60 @@
+package review
+
+import (
+	"fmt"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
+)
+
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// DefaultOrder keeps the latest changed reviews first
+var DefaultOrder = &Order{
+	Field: review.FieldUpdatedAt,
+	Desc:  true,
+}
+
+func ValidateOrder(order *Order) error {
+	switch order.Field {
+	case review.FieldCreatedAt:
+	case review.FieldUpdatedAt:
+	case review.FieldState:
+	default:
+		return fmt.Errorf("invalid order field %v", order.Field)
+	}
+	return nil
+}
+
+func ConvertOrders(in []*extmgr.Order) []*Order {
+	orders := []*Order{}
+	for _, order := range in {
+		orders = append(orders, &Order{Field: order.GetField(), Desc: order.GetDesc()})
+	}
+	return orders
+}
+
+// setOrders sorts stm by orders, DefaultOrder when none is given, then by id
+func setOrders(stm *ent.ReviewQuery, orders []*Order) (*ent.ReviewQuery, error) {
+	if len(orders) == 0 {
+		orders = []*Order{DefaultOrder}
+	}
+
+	for _, order := range orders {
+		if err := ValidateOrder(order); err != nil {
+			return nil, err
+		}
+		if order.Desc {
+			stm = stm.Order(ent.Desc(order.Field))
+			continue
+		}
+		stm = stm.Order(ent.Asc(order.Field))
+	}
+
+	// The id breaks the ties of the orders, so offset pages never skip or repeat a review
+	return stm.Order(ent.Asc(review.FieldID)), nil
+}
@@ -0,0 +1,69 @@
+package review
+
+import (
+	"context"
+
+	converter "github.com/NpoolPlatform/review-manager/pkg/converter/review"
+	outboxcrud "github.com/NpoolPlatform/review-manager/pkg/crud/outbox"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
+)
+
+// Events are queued to the outbox in the transaction of the write and shipped by the relay
+
+func publishCreated(ctx context.Context, tx *ent.Tx, row *ent.Review) error {
+	return outboxcrud.CreateTx(
+		ctx, tx,
+		msg.RoutingKey(row.Domain, row.ObjectType, msg.EventReviewCreated),
+		&msg.ReviewCreated{
+			Review: converter.Ent2Message(row),
+		},
+	)
+}
+
+func publishStateChanged(ctx context.Context, tx *ent.Tx, prevState string, row *ent.Review) error {
+	if prevState == row.State {
+		return nil
+	}
+
+	return outboxcrud.CreateTx(
+		ctx, tx,
+		msg.RoutingKey(row.Domain, row.ObjectType, msg.EventReviewStateChanged),
+		&msg.ReviewStateChanged{
+			Review:    converter.Ent2Message(row),
+			PrevState: prevState,
+		},
+	)
+}
+
+func publishEscalated(ctx context.Context, tx *ent.Tx, row *ent.Review) error {
+	return outboxcrud.CreateTx(
+		ctx, tx,
+		msg.RoutingKey(row.Domain, row.ObjectType, msg.EventReviewEscalated),
+		&msg.ReviewEscalated{
+			Review:      converter.Ent2Message(row),
+			EscalatedAt: row.EscalatedAt,
+		},
+	)
+}
+
+func publishDeleted(ctx context.Context, tx *ent.Tx, row *ent.Review) error {
+	return outboxcrud.CreateTx(
+		ctx, tx,
+		msg.RoutingKey(row.Domain, row.ObjectType, msg.EventReviewDeleted),
+		&msg.ReviewDeleted{
+			Review:    converter.Ent2Message(row),
+			DeletedAt: row.DeletedAt,
+		},
+	)
+}
+
+func publishRestored(ctx context.Context, tx *ent.Tx, row *ent.Review) error {
+	return outboxcrud.CreateTx(
+		ctx, tx,
+		msg.RoutingKey(row.Domain, row.ObjectType, msg.EventReviewRestored),
+		&msg.ReviewRestored{
+			Review: converter.Ent2Message(row),
+		},
+	)
+}
@@ -0,0 +1,117 @@
+package review
+
+import (
+	"context"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+	poolcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewerpool"
+	eventcrud "github.com/NpoolPlatform/review-manager/pkg/crud/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// Rebalance moves the Wait reviews of the off shift members of a pool to the members
+// on shift, following the pool strategy. It returns the number of moved reviews.
+func Rebalance(ctx context.Context, poolID uuid.UUID) (int, error) {
+	var err error
+	moved := 0
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Rebalance")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, poolID.String())
+
+	err = db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		pool, err := tx.ReviewerPool.Query().Where(reviewerpool.ID(poolID)).Only(_ctx)
+		if err != nil {
+			return err
+		}
+
+		offShift, err := tx.ReviewerPoolMember.
+			Query().
+			Where(
+				reviewerpoolmember.PoolID(poolID),
+				reviewerpoolmember.OnShift(false),
+			).
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+		if len(offShift) == 0 {
+			return nil
+		}
+
+		reviewerIDs := []uuid.UUID{}
+		for _, member := range offShift {
+			reviewerIDs = append(reviewerIDs, member.ReviewerID)
+		}
+
+		rows, err := tx.Review.
+			Query().
+			Where(
+				review.AppID(pool.AppID),
+				review.Domain(pool.Domain),
+				review.ObjectType(pool.ObjectType),
+				review.State(npool.ReviewState_Wait.String()),
+				review.ReviewerIDIn(reviewerIDs...),
+			).
+			Order(ent.Asc(review.FieldCreatedAt)).
+			ForUpdate().
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+
+		picker := poolcrud.NewPicker(tx)
+		for _, row := range rows {
+			reviewerID, err := picker.Pick(_ctx, pool.AppID, pool.Domain, pool.ObjectType)
+			if err != nil {
+				return err
+			}
+			if reviewerID == uuid.Nil {
+				// Nobody is on shift, the reviews stay where they are
+				return nil
+			}
+
+			info, err := row.Update().
+				SetReviewerID(reviewerID).
+				SetLeaseExpiresAt(0).
+				Save(_ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := eventcrud.CreateTx(_ctx, tx, info.AppID, info.ID, eventcrud.Diff(actor.FromContext(_ctx), row, info)); err != nil {
+				return err
+			}
+			moved++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
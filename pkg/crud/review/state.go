@@ -0,0 +1,60 @@
+package review
+
+import (
+	"fmt"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+)
+
+type transitions map[npool.ReviewState][]npool.ReviewState
+
+// States missing from a table are terminal for that object type.
+var stateMachines = map[npool.ReviewObjectType]transitions{
+	npool.ReviewObjectType_ObjectKyc: {
+		npool.ReviewState_Wait: {
+			npool.ReviewState_Approved,
+			npool.ReviewState_Rejected,
+		},
+		// User resubmits the kyc documents
+		npool.ReviewState_Rejected: {
+			npool.ReviewState_Wait,
+		},
+	},
+	npool.ReviewObjectType_ObjectWithdrawal: {
+		npool.ReviewState_Wait: {
+			npool.ReviewState_Approved,
+			npool.ReviewState_Rejected,
+		},
+	},
+}
+
+type TransitionError struct {
+	ObjectType string
+	From       string
+	To         string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("invalid %v review state transition from %v to %v", e.ObjectType, e.From, e.To)
+}
+
+func ValidateTransition(objectType, from string, to npool.ReviewState) error {
+	err := &TransitionError{
+		ObjectType: objectType,
+		From:       from,
+		To:         to.String(),
+	}
+
+	machine, ok := stateMachines[npool.ReviewObjectType(npool.ReviewObjectType_value[objectType])]
+	if !ok {
+		return err
+	}
+
+	for _, state := range machine[npool.ReviewState(npool.ReviewState_value[from])] {
+		if state == to {
+			return nil
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,89 @@
+package reviewdecision
+
+import (
+	"context"
+	"time"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// CreateTx records the decision of one reviewer on a review of appID, it must run in the transaction holding the review row
+func CreateTx(
+	ctx context.Context,
+	tx *ent.Tx,
+	appID, reviewID, reviewerID uuid.UUID,
+	decision, message string,
+	reasons []string,
+) error {
+	c := tx.ReviewDecision.
+		Create().
+		SetAppID(appID).
+		SetReviewID(reviewID).
+		SetReviewerID(reviewerID).
+		SetDecision(decision).
+		SetMessage(message)
+	if len(reasons) > 0 {
+		c.SetReasons(reasons)
+	}
+	_, err := c.Save(ctx)
+	return err
+}
+
+// RowsTx lists the decisions of the current review round
+func RowsTx(ctx context.Context, tx *ent.Tx, reviewID uuid.UUID) ([]*ent.ReviewDecision, error) {
+	return tx.ReviewDecision.
+		Query().
+		Where(reviewdecision.ReviewID(reviewID)).
+		Order(ent.Asc(reviewdecision.FieldCreatedAt)).
+		All(ctx)
+}
+
+// ResetTx drops the decisions of a review going back to Wait, so a new round starts from scratch
+func ResetTx(ctx context.Context, tx *ent.Tx, reviewID uuid.UUID) error {
+	_, err := tx.ReviewDecision.
+		Update().
+		Where(reviewdecision.ReviewID(reviewID)).
+		SetDeletedAt(uint32(time.Now().Unix())).
+		Save(ctx)
+	return err
+}
+
+func Rows(ctx context.Context, reviewID uuid.UUID) ([]*ent.ReviewDecision, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Rows")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, reviewID.String())
+
+	rows := []*ent.ReviewDecision{}
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		rows, err = cli.ReviewDecision.
+			Query().
+			Where(reviewdecision.ReviewID(reviewID)).
+			Order(ent.Asc(reviewdecision.FieldCreatedAt)).
+			All(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,197 @@
+package reviewerpool
+
+import (
+	"context"
+	"fmt"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+// Strategies picking the reviewer of a new review among the on shift members of a pool
+const (
+	// StrategyRoundRobin takes the members in turn
+	StrategyRoundRobin = "RoundRobin"
+	// StrategyLeastOpen takes the member with the fewest Wait reviews
+	StrategyLeastOpen = "LeastOpen"
+	// StrategyWeighted takes the member with the fewest Wait reviews relative to its weight
+	StrategyWeighted = "Weighted"
+)
+
+func ValidateStrategy(strategy string) error {
+	switch strategy {
+	case StrategyRoundRobin, StrategyLeastOpen, StrategyWeighted:
+		return nil
+	}
+	return fmt.Errorf("invalid strategy %v", strategy)
+}
+
+func CreatePool(
+	ctx context.Context,
+	appID uuid.UUID,
+	domain string,
+	objectType npool.ReviewObjectType,
+	strategy string,
+) (*ent.ReviewerPool, error) {
+	var info *ent.ReviewerPool
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "CreatePool")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span.SetAttributes(
+		attribute.String("AppID", appID.String()),
+		attribute.String("Domain", domain),
+		attribute.String("ObjectType", objectType.String()),
+		attribute.String("Strategy", strategy),
+	)
+
+	if err = ValidateStrategy(strategy); err != nil {
+		return nil, err
+	}
+
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		info, err = cli.ReviewerPool.
+			Create().
+			SetAppID(appID).
+			SetDomain(domain).
+			SetObjectType(objectType.String()).
+			SetStrategy(strategy).
+			Save(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// Row returns the pool id, members carry no app so their callers check the pool with Row first
+func Row(ctx context.Context, id uuid.UUID) (*ent.ReviewerPool, error) {
+	var info *ent.ReviewerPool
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Row")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, id.String())
+
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		info, err = cli.ReviewerPool.Query().Where(reviewerpool.ID(id)).Only(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func AddMember(ctx context.Context, poolID, reviewerID uuid.UUID, weight uint32) (*ent.ReviewerPoolMember, error) {
+	var info *ent.ReviewerPoolMember
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "AddMember")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, poolID.String())
+	span.SetAttributes(
+		attribute.String("ReviewerID", reviewerID.String()),
+		attribute.Int64("Weight", int64(weight)),
+	)
+
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		info, err = cli.ReviewerPoolMember.
+			Create().
+			SetPoolID(poolID).
+			SetReviewerID(reviewerID).
+			SetWeight(weight).
+			SetOnShift(true).
+			Save(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// SetOnShift puts a member on or off shift, call Rebalance of the review crud to move
+// the Wait reviews away from a member going off shift
+func SetOnShift(ctx context.Context, poolID, reviewerID uuid.UUID, onShift bool) (*ent.ReviewerPoolMember, error) {
+	var info *ent.ReviewerPoolMember
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "SetOnShift")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, poolID.String())
+	span.SetAttributes(
+		attribute.String("ReviewerID", reviewerID.String()),
+		attribute.Bool("OnShift", onShift),
+	)
+
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		member, err := cli.ReviewerPoolMember.
+			Query().
+			Where(
+				reviewerpoolmember.PoolID(poolID),
+				reviewerpoolmember.ReviewerID(reviewerID),
+			).
+			Only(_ctx)
+		if err != nil {
+			return err
+		}
+
+		info, err = member.Update().SetOnShift(onShift).Save(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
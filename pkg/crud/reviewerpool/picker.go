@@ -0,0 +1,168 @@
+package reviewerpool
+
+import (
+	"context"
+	"fmt"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+
+	"github.com/google/uuid"
+)
+
+type poolState struct {
+	pool    *ent.ReviewerPool
+	members []*ent.ReviewerPoolMember
+	open    map[uuid.UUID]int
+}
+
+// Picker picks reviewers inside one transaction. The pools it touches are locked until
+// the transaction ends, and the reviewers it picks are counted as open at once,
+// so a bulk of reviews is spread as the strategy says.
+type Picker struct {
+	tx    *ent.Tx
+	pools map[string]*poolState
+}
+
+func NewPicker(tx *ent.Tx) *Picker {
+	return &Picker{
+		tx:    tx,
+		pools: map[string]*poolState{},
+	}
+}
+
+func (p *Picker) openReviews(ctx context.Context, pool *ent.ReviewerPool) (map[uuid.UUID]int, error) {
+	var counts []struct {
+		ReviewerID uuid.UUID `json:"reviewer_id"`
+		Count      int       `json:"count"`
+	}
+
+	err := p.tx.Review.
+		Query().
+		Where(
+			review.AppID(pool.AppID),
+			review.Domain(pool.Domain),
+			review.ObjectType(pool.ObjectType),
+			review.State(npool.ReviewState_Wait.String()),
+		).
+		GroupBy(review.FieldReviewerID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	open := map[uuid.UUID]int{}
+	for _, count := range counts {
+		open[count.ReviewerID] = count.Count
+	}
+	return open, nil
+}
+
+func (p *Picker) load(ctx context.Context, appID uuid.UUID, domain, objectType string) (*poolState, error) {
+	key := fmt.Sprintf("%v:%v:%v", appID, domain, objectType)
+	if state, ok := p.pools[key]; ok {
+		return state, nil
+	}
+
+	state := &poolState{}
+	p.pools[key] = state
+
+	pool, err := p.tx.ReviewerPool.
+		Query().
+		Where(
+			reviewerpool.AppID(appID),
+			reviewerpool.Domain(domain),
+			reviewerpool.ObjectType(objectType),
+		).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := p.tx.ReviewerPoolMember.
+		Query().
+		Where(
+			reviewerpoolmember.PoolID(pool.ID),
+			reviewerpoolmember.OnShift(true),
+			reviewerpoolmember.WeightGT(0),
+		).
+		Order(ent.Asc(reviewerpoolmember.FieldCreatedAt), ent.Asc(reviewerpoolmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := p.openReviews(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	state.pool = pool
+	state.members = members
+	state.open = open
+
+	return state, nil
+}
+
+func leastOpen(state *poolState, weighted bool) *ent.ReviewerPoolMember {
+	var picked *ent.ReviewerPoolMember
+	for _, member := range state.members {
+		if picked == nil {
+			picked = member
+			continue
+		}
+
+		open := state.open[member.ReviewerID] + 1
+		pickedOpen := state.open[picked.ReviewerID] + 1
+		if !weighted {
+			if open < pickedOpen {
+				picked = member
+			}
+			continue
+		}
+		// open / weight < pickedOpen / picked.weight
+		if uint64(open)*uint64(picked.Weight) < uint64(pickedOpen)*uint64(member.Weight) {
+			picked = member
+		}
+	}
+	return picked
+}
+
+// Pick returns the reviewer for a new review, uuid.Nil when there is no pool or nobody is on shift
+func (p *Picker) Pick(ctx context.Context, appID uuid.UUID, domain, objectType string) (uuid.UUID, error) {
+	state, err := p.load(ctx, appID, domain, objectType)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(state.members) == 0 {
+		return uuid.Nil, nil
+	}
+
+	var picked *ent.ReviewerPoolMember
+
+	switch state.pool.Strategy {
+	case StrategyLeastOpen:
+		picked = leastOpen(state, false)
+	case StrategyWeighted:
+		picked = leastOpen(state, true)
+	default:
+		picked = state.members[int(state.pool.Cursor)%len(state.members)]
+		state.pool, err = state.pool.Update().SetCursor(state.pool.Cursor + 1).Save(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	state.open[picked.ReviewerID]++
+
+	return picked.ReviewerID, nil
+}
@@ -0,0 +1,171 @@
+package reviewevent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	commontracer "github.com/NpoolPlatform/review-manager/pkg/tracer"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventCreated         = "Created"
+	EventStateChanged    = "StateChanged"
+	EventReviewerChanged = "ReviewerChanged"
+	EventMessageChanged  = "MessageChanged"
+	EventReasonsChanged  = "ReasonsChanged"
+	EventDeleted         = "Deleted"
+	EventEscalated       = "Escalated"
+	EventRestored        = "Restored"
+)
+
+type Event struct {
+	ActorID  uuid.UUID
+	Event    string
+	OldValue string
+	NewValue string
+}
+
+// Diff lists the events turning prev into cur, prev is nil for a created review
+func Diff(actorID uuid.UUID, prev, cur *ent.Review) []*Event {
+	if prev == nil {
+		return []*Event{{
+			ActorID:  actorID,
+			Event:    EventCreated,
+			NewValue: cur.State,
+		}}
+	}
+
+	events := []*Event{}
+	if prev.State != cur.State {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventStateChanged,
+			OldValue: prev.State,
+			NewValue: cur.State,
+		})
+	}
+	if prev.ReviewerID != cur.ReviewerID {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventReviewerChanged,
+			OldValue: prev.ReviewerID.String(),
+			NewValue: cur.ReviewerID.String(),
+		})
+	}
+	if prev.Message != cur.Message {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventMessageChanged,
+			OldValue: prev.Message,
+			NewValue: cur.Message,
+		})
+	}
+	if strings.Join(prev.Reasons, ",") != strings.Join(cur.Reasons, ",") {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventReasonsChanged,
+			OldValue: strings.Join(prev.Reasons, ","),
+			NewValue: strings.Join(cur.Reasons, ","),
+		})
+	}
+	if prev.EscalatedAt == 0 && cur.EscalatedAt != 0 {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventEscalated,
+			OldValue: fmt.Sprintf("%v", prev.DueAt),
+			NewValue: fmt.Sprintf("%v", cur.EscalatedAt),
+		})
+	}
+	if prev.DeletedAt != 0 && cur.DeletedAt == 0 {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventRestored,
+			NewValue: cur.State,
+		})
+	}
+	if prev.DeletedAt == 0 && cur.DeletedAt != 0 {
+		events = append(events, &Event{
+			ActorID:  actorID,
+			Event:    EventDeleted,
+			OldValue: prev.State,
+		})
+	}
+
+	return events
+}
+
+// CreateTx records the events of one review of appID, it must run in the transaction writing the review
+func CreateTx(ctx context.Context, tx *ent.Tx, appID, reviewID uuid.UUID, events []*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.ReviewEventCreate, len(events))
+	for i, event := range events {
+		bulk[i] = tx.ReviewEvent.
+			Create().
+			SetAppID(appID).
+			SetReviewID(reviewID).
+			SetActorID(event.ActorID).
+			SetEvent(event.Event).
+			SetOldValue(event.OldValue).
+			SetNewValue(event.NewValue)
+	}
+
+	_, err := tx.ReviewEvent.CreateBulk(bulk...).Save(ctx)
+	return err
+}
+
+func Rows(ctx context.Context, reviewID uuid.UUID, offset, limit int) ([]*ent.ReviewEvent, int, error) {
+	var err error
+
+	_, span := otel.Tracer(constant.ServiceName).Start(ctx, "Rows")
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, "db operation fail")
+			span.RecordError(err)
+		}
+	}()
+
+	span = commontracer.TraceID(span, reviewID.String())
+	span = commontracer.TraceOffsetLimit(span, offset, limit)
+
+	rows := []*ent.ReviewEvent{}
+	var total int
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		stm := cli.ReviewEvent.Query().Where(reviewevent.ReviewID(reviewID))
+
+		total, err = stm.Count(_ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err = stm.
+			Offset(offset).
+			Order(ent.Asc(reviewevent.FieldCreatedAt)).
+			Limit(limit).
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
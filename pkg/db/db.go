@@ -2,8 +2,12 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/NpoolPlatform/go-service-framework/pkg/config"
 	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
 
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
@@ -12,31 +16,120 @@ import (
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/NpoolPlatform/go-service-framework/pkg/mysql"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
 	// ent policy runtime
 	_ "github.com/NpoolPlatform/review-manager/pkg/db/ent/runtime"
 )
 
+const (
+	keyMaxOpenConns    = "mysql_max_open_conns"
+	keyMaxIdleConns    = "mysql_max_idle_conns"
+	keyConnMaxLifetime = "mysql_conn_max_lifetime_seconds"
+
+	defaultMaxOpenConns    = 100
+	defaultMaxIdleConns    = 20
+	defaultConnMaxLifetime = 3 * time.Minute
+
+	statsDBName = "review_manager"
+)
+
+var (
+	mu       sync.Mutex
+	myConn   *sql.DB
+	myClient *ent.Client
+	myStats  prometheus.Collector
+)
+
+func poolValue(key string, def int) int {
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	if val := config.GetIntValueWithNameSpace(hostname, key); val > 0 {
+		return val
+	}
+	return def
+}
+
+func setPool(conn *sql.DB) {
+	conn.SetMaxOpenConns(poolValue(keyMaxOpenConns, defaultMaxOpenConns))
+	conn.SetMaxIdleConns(poolValue(keyMaxIdleConns, defaultMaxIdleConns))
+	conn.SetConnMaxLifetime(
+		time.Duration(poolValue(keyConnMaxLifetime, int(defaultConnMaxLifetime/time.Second))) * time.Second,
+	)
+}
+
+// registerStats exports the pool stats of conn on the prometheus port
+func registerStats(conn *sql.DB) {
+	if myStats != nil {
+		prometheus.Unregister(myStats)
+	}
+
+	myStats = collectors.NewDBStatsCollector(conn, statsDBName)
+	if err := prometheus.Register(myStats); err != nil {
+		logger.Sugar().Warnf("fail register db stats collector: %v", err)
+	}
+}
+
+// client shares one ent client, it is only rebuilt when the mysql package reconnects
 func client() (*ent.Client, error) {
 	conn, err := mysql.GetConn()
 	if err != nil {
 		return nil, err
 	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if myClient != nil && myConn == conn {
+		return myClient, nil
+	}
+
+	setPool(conn)
 	drv := entsql.OpenDB(dialect.MySQL, conn)
-	return ent.NewClient(ent.Driver(drv)), nil
+
+	myConn = conn
+	myClient = ent.NewClient(ent.Driver(drv))
+	registerStats(conn)
+
+	return myClient, nil
 }
 
+// Init only checks the database, schema changes belong to pkg/migrator
 func Init() error {
-	cli, err := client()
-	if err != nil {
-		return err
-	}
-	return cli.Schema.Create(context.Background())
+	_, err := client()
+	return err
 }
 
 func Client() (*ent.Client, error) {
 	return client()
 }
 
+// Close releases the pooled connections on shutdown
+func Close() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if myClient == nil {
+		return nil
+	}
+
+	err := myClient.Close()
+	myClient = nil
+	myConn = nil
+
+	return err
+}
+
+func Stats() (sql.DBStats, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if myConn == nil {
+		return sql.DBStats{}, fmt.Errorf("db is not initialized")
+	}
+	return myConn.Stats(), nil
+}
+
 func WithTx(ctx context.Context, fn func(ctx context.Context, tx *ent.Tx) error) error {
 	cli, err := Client()
 	if err != nil {
@@ -11,10 +11,16 @@ import (
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/migrate"
 	"github.com/google/uuid"
 
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +28,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Outbox is the client for interacting with the Outbox builders.
+	Outbox *OutboxClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
+	// ReviewDecision is the client for interacting with the ReviewDecision builders.
+	ReviewDecision *ReviewDecisionClient
+	// ReviewEvent is the client for interacting with the ReviewEvent builders.
+	ReviewEvent *ReviewEventClient
+	// ReviewerPool is the client for interacting with the ReviewerPool builders.
+	ReviewerPool *ReviewerPoolClient
+	// ReviewerPoolMember is the client for interacting with the ReviewerPoolMember builders.
+	ReviewerPoolMember *ReviewerPoolMemberClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,7 +53,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Outbox = NewOutboxClient(c.config)
 	c.Review = NewReviewClient(c.config)
+	c.ReviewDecision = NewReviewDecisionClient(c.config)
+	c.ReviewEvent = NewReviewEventClient(c.config)
+	c.ReviewerPool = NewReviewerPoolClient(c.config)
+	c.ReviewerPoolMember = NewReviewerPoolMemberClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -69,9 +90,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Review: NewReviewClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Outbox:             NewOutboxClient(cfg),
+		Review:             NewReviewClient(cfg),
+		ReviewDecision:     NewReviewDecisionClient(cfg),
+		ReviewEvent:        NewReviewEventClient(cfg),
+		ReviewerPool:       NewReviewerPoolClient(cfg),
+		ReviewerPoolMember: NewReviewerPoolMemberClient(cfg),
 	}, nil
 }
 
@@ -89,19 +115,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Review: NewReviewClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Outbox:             NewOutboxClient(cfg),
+		Review:             NewReviewClient(cfg),
+		ReviewDecision:     NewReviewDecisionClient(cfg),
+		ReviewEvent:        NewReviewEventClient(cfg),
+		ReviewerPool:       NewReviewerPoolClient(cfg),
+		ReviewerPoolMember: NewReviewerPoolMemberClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Review.
+//		Outbox.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
@@ -121,7 +151,103 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Outbox.Use(hooks...)
 	c.Review.Use(hooks...)
+	c.ReviewDecision.Use(hooks...)
+	c.ReviewEvent.Use(hooks...)
+	c.ReviewerPool.Use(hooks...)
+	c.ReviewerPoolMember.Use(hooks...)
+}
+
+// OutboxClient is a client for the Outbox schema.
+type OutboxClient struct {
+	config
+}
+
+// NewOutboxClient returns a client for the Outbox from the given config.
+func NewOutboxClient(c config) *OutboxClient {
+	return &OutboxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outbox.Hooks(f(g(h())))`.
+func (c *OutboxClient) Use(hooks ...Hook) {
+	c.hooks.Outbox = append(c.hooks.Outbox, hooks...)
+}
+
+// Create returns a builder for creating a Outbox entity.
+func (c *OutboxClient) Create() *OutboxCreate {
+	mutation := newOutboxMutation(c.config, OpCreate)
+	return &OutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Outbox entities.
+func (c *OutboxClient) CreateBulk(builders ...*OutboxCreate) *OutboxCreateBulk {
+	return &OutboxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Outbox.
+func (c *OutboxClient) Update() *OutboxUpdate {
+	mutation := newOutboxMutation(c.config, OpUpdate)
+	return &OutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxClient) UpdateOne(o *Outbox) *OutboxUpdateOne {
+	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutbox(o))
+	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxClient) UpdateOneID(id uuid.UUID) *OutboxUpdateOne {
+	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutboxID(id))
+	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Outbox.
+func (c *OutboxClient) Delete() *OutboxDelete {
+	mutation := newOutboxMutation(c.config, OpDelete)
+	return &OutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxClient) DeleteOne(o *Outbox) *OutboxDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *OutboxClient) DeleteOneID(id uuid.UUID) *OutboxDeleteOne {
+	builder := c.Delete().Where(outbox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxDeleteOne{builder}
+}
+
+// Query returns a query builder for Outbox.
+func (c *OutboxClient) Query() *OutboxQuery {
+	return &OutboxQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Outbox entity by its id.
+func (c *OutboxClient) Get(ctx context.Context, id uuid.UUID) (*Outbox, error) {
+	return c.Query().Where(outbox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxClient) GetX(ctx context.Context, id uuid.UUID) *Outbox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxClient) Hooks() []Hook {
+	hooks := c.hooks.Outbox
+	return append(hooks[:len(hooks):len(hooks)], outbox.Hooks[:]...)
 }
 
 // ReviewClient is a client for the Review schema.
@@ -209,8 +335,468 @@ func (c *ReviewClient) GetX(ctx context.Context, id uuid.UUID) *Review {
 	return obj
 }
 
+// QueryEvents queries the events edge of a Review.
+func (c *ReviewClient) QueryEvents(r *Review) *ReviewEventQuery {
+	query := &ReviewEventQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(reviewevent.Table, reviewevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, review.EventsTable, review.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDecisions queries the decisions edge of a Review.
+func (c *ReviewClient) QueryDecisions(r *Review) *ReviewDecisionQuery {
+	query := &ReviewDecisionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(reviewdecision.Table, reviewdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, review.DecisionsTable, review.DecisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ReviewClient) Hooks() []Hook {
 	hooks := c.hooks.Review
 	return append(hooks[:len(hooks):len(hooks)], review.Hooks[:]...)
 }
+
+// ReviewDecisionClient is a client for the ReviewDecision schema.
+type ReviewDecisionClient struct {
+	config
+}
+
+// NewReviewDecisionClient returns a client for the ReviewDecision from the given config.
+func NewReviewDecisionClient(c config) *ReviewDecisionClient {
+	return &ReviewDecisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewdecision.Hooks(f(g(h())))`.
+func (c *ReviewDecisionClient) Use(hooks ...Hook) {
+	c.hooks.ReviewDecision = append(c.hooks.ReviewDecision, hooks...)
+}
+
+// Create returns a builder for creating a ReviewDecision entity.
+func (c *ReviewDecisionClient) Create() *ReviewDecisionCreate {
+	mutation := newReviewDecisionMutation(c.config, OpCreate)
+	return &ReviewDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewDecision entities.
+func (c *ReviewDecisionClient) CreateBulk(builders ...*ReviewDecisionCreate) *ReviewDecisionCreateBulk {
+	return &ReviewDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewDecision.
+func (c *ReviewDecisionClient) Update() *ReviewDecisionUpdate {
+	mutation := newReviewDecisionMutation(c.config, OpUpdate)
+	return &ReviewDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewDecisionClient) UpdateOne(rd *ReviewDecision) *ReviewDecisionUpdateOne {
+	mutation := newReviewDecisionMutation(c.config, OpUpdateOne, withReviewDecision(rd))
+	return &ReviewDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewDecisionClient) UpdateOneID(id uuid.UUID) *ReviewDecisionUpdateOne {
+	mutation := newReviewDecisionMutation(c.config, OpUpdateOne, withReviewDecisionID(id))
+	return &ReviewDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewDecision.
+func (c *ReviewDecisionClient) Delete() *ReviewDecisionDelete {
+	mutation := newReviewDecisionMutation(c.config, OpDelete)
+	return &ReviewDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewDecisionClient) DeleteOne(rd *ReviewDecision) *ReviewDecisionDeleteOne {
+	return c.DeleteOneID(rd.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReviewDecisionClient) DeleteOneID(id uuid.UUID) *ReviewDecisionDeleteOne {
+	builder := c.Delete().Where(reviewdecision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewDecisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewDecision.
+func (c *ReviewDecisionClient) Query() *ReviewDecisionQuery {
+	return &ReviewDecisionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ReviewDecision entity by its id.
+func (c *ReviewDecisionClient) Get(ctx context.Context, id uuid.UUID) (*ReviewDecision, error) {
+	return c.Query().Where(reviewdecision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewDecisionClient) GetX(ctx context.Context, id uuid.UUID) *ReviewDecision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReview queries the review edge of a ReviewDecision.
+func (c *ReviewDecisionClient) QueryReview(rd *ReviewDecision) *ReviewQuery {
+	query := &ReviewQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewdecision.Table, reviewdecision.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewdecision.ReviewTable, reviewdecision.ReviewColumn),
+		)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewDecisionClient) Hooks() []Hook {
+	hooks := c.hooks.ReviewDecision
+	return append(hooks[:len(hooks):len(hooks)], reviewdecision.Hooks[:]...)
+}
+
+// ReviewEventClient is a client for the ReviewEvent schema.
+type ReviewEventClient struct {
+	config
+}
+
+// NewReviewEventClient returns a client for the ReviewEvent from the given config.
+func NewReviewEventClient(c config) *ReviewEventClient {
+	return &ReviewEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewevent.Hooks(f(g(h())))`.
+func (c *ReviewEventClient) Use(hooks ...Hook) {
+	c.hooks.ReviewEvent = append(c.hooks.ReviewEvent, hooks...)
+}
+
+// Create returns a builder for creating a ReviewEvent entity.
+func (c *ReviewEventClient) Create() *ReviewEventCreate {
+	mutation := newReviewEventMutation(c.config, OpCreate)
+	return &ReviewEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewEvent entities.
+func (c *ReviewEventClient) CreateBulk(builders ...*ReviewEventCreate) *ReviewEventCreateBulk {
+	return &ReviewEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewEvent.
+func (c *ReviewEventClient) Update() *ReviewEventUpdate {
+	mutation := newReviewEventMutation(c.config, OpUpdate)
+	return &ReviewEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewEventClient) UpdateOne(re *ReviewEvent) *ReviewEventUpdateOne {
+	mutation := newReviewEventMutation(c.config, OpUpdateOne, withReviewEvent(re))
+	return &ReviewEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewEventClient) UpdateOneID(id uuid.UUID) *ReviewEventUpdateOne {
+	mutation := newReviewEventMutation(c.config, OpUpdateOne, withReviewEventID(id))
+	return &ReviewEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewEvent.
+func (c *ReviewEventClient) Delete() *ReviewEventDelete {
+	mutation := newReviewEventMutation(c.config, OpDelete)
+	return &ReviewEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewEventClient) DeleteOne(re *ReviewEvent) *ReviewEventDeleteOne {
+	return c.DeleteOneID(re.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReviewEventClient) DeleteOneID(id uuid.UUID) *ReviewEventDeleteOne {
+	builder := c.Delete().Where(reviewevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewEvent.
+func (c *ReviewEventClient) Query() *ReviewEventQuery {
+	return &ReviewEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ReviewEvent entity by its id.
+func (c *ReviewEventClient) Get(ctx context.Context, id uuid.UUID) (*ReviewEvent, error) {
+	return c.Query().Where(reviewevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewEventClient) GetX(ctx context.Context, id uuid.UUID) *ReviewEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReview queries the review edge of a ReviewEvent.
+func (c *ReviewEventClient) QueryReview(re *ReviewEvent) *ReviewQuery {
+	query := &ReviewQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := re.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewevent.Table, reviewevent.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewevent.ReviewTable, reviewevent.ReviewColumn),
+		)
+		fromV = sqlgraph.Neighbors(re.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewEventClient) Hooks() []Hook {
+	hooks := c.hooks.ReviewEvent
+	return append(hooks[:len(hooks):len(hooks)], reviewevent.Hooks[:]...)
+}
+
+// ReviewerPoolClient is a client for the ReviewerPool schema.
+type ReviewerPoolClient struct {
+	config
+}
+
+// NewReviewerPoolClient returns a client for the ReviewerPool from the given config.
+func NewReviewerPoolClient(c config) *ReviewerPoolClient {
+	return &ReviewerPoolClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewerpool.Hooks(f(g(h())))`.
+func (c *ReviewerPoolClient) Use(hooks ...Hook) {
+	c.hooks.ReviewerPool = append(c.hooks.ReviewerPool, hooks...)
+}
+
+// Create returns a builder for creating a ReviewerPool entity.
+func (c *ReviewerPoolClient) Create() *ReviewerPoolCreate {
+	mutation := newReviewerPoolMutation(c.config, OpCreate)
+	return &ReviewerPoolCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewerPool entities.
+func (c *ReviewerPoolClient) CreateBulk(builders ...*ReviewerPoolCreate) *ReviewerPoolCreateBulk {
+	return &ReviewerPoolCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewerPool.
+func (c *ReviewerPoolClient) Update() *ReviewerPoolUpdate {
+	mutation := newReviewerPoolMutation(c.config, OpUpdate)
+	return &ReviewerPoolUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewerPoolClient) UpdateOne(rp *ReviewerPool) *ReviewerPoolUpdateOne {
+	mutation := newReviewerPoolMutation(c.config, OpUpdateOne, withReviewerPool(rp))
+	return &ReviewerPoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewerPoolClient) UpdateOneID(id uuid.UUID) *ReviewerPoolUpdateOne {
+	mutation := newReviewerPoolMutation(c.config, OpUpdateOne, withReviewerPoolID(id))
+	return &ReviewerPoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewerPool.
+func (c *ReviewerPoolClient) Delete() *ReviewerPoolDelete {
+	mutation := newReviewerPoolMutation(c.config, OpDelete)
+	return &ReviewerPoolDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewerPoolClient) DeleteOne(rp *ReviewerPool) *ReviewerPoolDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReviewerPoolClient) DeleteOneID(id uuid.UUID) *ReviewerPoolDeleteOne {
+	builder := c.Delete().Where(reviewerpool.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewerPoolDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewerPool.
+func (c *ReviewerPoolClient) Query() *ReviewerPoolQuery {
+	return &ReviewerPoolQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ReviewerPool entity by its id.
+func (c *ReviewerPoolClient) Get(ctx context.Context, id uuid.UUID) (*ReviewerPool, error) {
+	return c.Query().Where(reviewerpool.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewerPoolClient) GetX(ctx context.Context, id uuid.UUID) *ReviewerPool {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a ReviewerPool.
+func (c *ReviewerPoolClient) QueryMembers(rp *ReviewerPool) *ReviewerPoolMemberQuery {
+	query := &ReviewerPoolMemberQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewerpool.Table, reviewerpool.FieldID, id),
+			sqlgraph.To(reviewerpoolmember.Table, reviewerpoolmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reviewerpool.MembersTable, reviewerpool.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewerPoolClient) Hooks() []Hook {
+	hooks := c.hooks.ReviewerPool
+	return append(hooks[:len(hooks):len(hooks)], reviewerpool.Hooks[:]...)
+}
+
+// ReviewerPoolMemberClient is a client for the ReviewerPoolMember schema.
+type ReviewerPoolMemberClient struct {
+	config
+}
+
+// NewReviewerPoolMemberClient returns a client for the ReviewerPoolMember from the given config.
+func NewReviewerPoolMemberClient(c config) *ReviewerPoolMemberClient {
+	return &ReviewerPoolMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewerpoolmember.Hooks(f(g(h())))`.
+func (c *ReviewerPoolMemberClient) Use(hooks ...Hook) {
+	c.hooks.ReviewerPoolMember = append(c.hooks.ReviewerPoolMember, hooks...)
+}
+
+// Create returns a builder for creating a ReviewerPoolMember entity.
+func (c *ReviewerPoolMemberClient) Create() *ReviewerPoolMemberCreate {
+	mutation := newReviewerPoolMemberMutation(c.config, OpCreate)
+	return &ReviewerPoolMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewerPoolMember entities.
+func (c *ReviewerPoolMemberClient) CreateBulk(builders ...*ReviewerPoolMemberCreate) *ReviewerPoolMemberCreateBulk {
+	return &ReviewerPoolMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewerPoolMember.
+func (c *ReviewerPoolMemberClient) Update() *ReviewerPoolMemberUpdate {
+	mutation := newReviewerPoolMemberMutation(c.config, OpUpdate)
+	return &ReviewerPoolMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewerPoolMemberClient) UpdateOne(rpm *ReviewerPoolMember) *ReviewerPoolMemberUpdateOne {
+	mutation := newReviewerPoolMemberMutation(c.config, OpUpdateOne, withReviewerPoolMember(rpm))
+	return &ReviewerPoolMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewerPoolMemberClient) UpdateOneID(id uuid.UUID) *ReviewerPoolMemberUpdateOne {
+	mutation := newReviewerPoolMemberMutation(c.config, OpUpdateOne, withReviewerPoolMemberID(id))
+	return &ReviewerPoolMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewerPoolMember.
+func (c *ReviewerPoolMemberClient) Delete() *ReviewerPoolMemberDelete {
+	mutation := newReviewerPoolMemberMutation(c.config, OpDelete)
+	return &ReviewerPoolMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewerPoolMemberClient) DeleteOne(rpm *ReviewerPoolMember) *ReviewerPoolMemberDeleteOne {
+	return c.DeleteOneID(rpm.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReviewerPoolMemberClient) DeleteOneID(id uuid.UUID) *ReviewerPoolMemberDeleteOne {
+	builder := c.Delete().Where(reviewerpoolmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewerPoolMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewerPoolMember.
+func (c *ReviewerPoolMemberClient) Query() *ReviewerPoolMemberQuery {
+	return &ReviewerPoolMemberQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ReviewerPoolMember entity by its id.
+func (c *ReviewerPoolMemberClient) Get(ctx context.Context, id uuid.UUID) (*ReviewerPoolMember, error) {
+	return c.Query().Where(reviewerpoolmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewerPoolMemberClient) GetX(ctx context.Context, id uuid.UUID) *ReviewerPoolMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPool queries the pool edge of a ReviewerPoolMember.
+func (c *ReviewerPoolMemberClient) QueryPool(rpm *ReviewerPoolMember) *ReviewerPoolQuery {
+	query := &ReviewerPoolQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rpm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewerpoolmember.Table, reviewerpoolmember.FieldID, id),
+			sqlgraph.To(reviewerpool.Table, reviewerpool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewerpoolmember.PoolTable, reviewerpoolmember.PoolColumn),
+		)
+		fromV = sqlgraph.Neighbors(rpm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewerPoolMemberClient) Hooks() []Hook {
+	hooks := c.hooks.ReviewerPoolMember
+	return append(hooks[:len(hooks):len(hooks)], reviewerpoolmember.Hooks[:]...)
+}
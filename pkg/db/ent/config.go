@@ -28,7 +28,12 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Review []ent.Hook
+	Outbox             []ent.Hook
+	Review             []ent.Hook
+	ReviewDecision     []ent.Hook
+	ReviewEvent        []ent.Hook
+	ReviewerPool       []ent.Hook
+	ReviewerPoolMember []ent.Hook
 }
 
 // Options applies the options on the config object.
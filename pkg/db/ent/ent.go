@@ -10,7 +10,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -31,7 +36,12 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		review.Table: review.ValidColumn,
+		outbox.Table:             outbox.ValidColumn,
+		review.Table:             review.ValidColumn,
+		reviewdecision.Table:     reviewdecision.ValidColumn,
+		reviewevent.Table:        reviewevent.ValidColumn,
+		reviewerpool.Table:       reviewerpool.ValidColumn,
+		reviewerpoolmember.Table: reviewerpoolmember.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -81,7 +91,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
@@ -3,7 +3,13 @@
 package ent
 
 import (
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -13,8 +19,31 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 1)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 6)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   outbox.Table,
+			Columns: outbox.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: outbox.FieldID,
+			},
+		},
+		Type: "Outbox",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			outbox.FieldCreatedAt:   {Type: field.TypeUint32, Column: outbox.FieldCreatedAt},
+			outbox.FieldUpdatedAt:   {Type: field.TypeUint32, Column: outbox.FieldUpdatedAt},
+			outbox.FieldDeletedAt:   {Type: field.TypeUint32, Column: outbox.FieldDeletedAt},
+			outbox.FieldRoutingKey:  {Type: field.TypeString, Column: outbox.FieldRoutingKey},
+			outbox.FieldPayload:     {Type: field.TypeString, Column: outbox.FieldPayload},
+			outbox.FieldState:       {Type: field.TypeString, Column: outbox.FieldState},
+			outbox.FieldAttempts:    {Type: field.TypeUint32, Column: outbox.FieldAttempts},
+			outbox.FieldNextRetryAt: {Type: field.TypeUint32, Column: outbox.FieldNextRetryAt},
+			outbox.FieldDeliveredAt: {Type: field.TypeUint32, Column: outbox.FieldDeliveredAt},
+			outbox.FieldLastError:   {Type: field.TypeString, Column: outbox.FieldLastError},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   review.Table,
 			Columns: review.Columns,
@@ -25,19 +54,182 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Review",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			review.FieldCreatedAt:  {Type: field.TypeUint32, Column: review.FieldCreatedAt},
-			review.FieldUpdatedAt:  {Type: field.TypeUint32, Column: review.FieldUpdatedAt},
-			review.FieldDeletedAt:  {Type: field.TypeUint32, Column: review.FieldDeletedAt},
-			review.FieldAppID:      {Type: field.TypeUUID, Column: review.FieldAppID},
-			review.FieldReviewerID: {Type: field.TypeUUID, Column: review.FieldReviewerID},
-			review.FieldDomain:     {Type: field.TypeString, Column: review.FieldDomain},
-			review.FieldObjectID:   {Type: field.TypeUUID, Column: review.FieldObjectID},
-			review.FieldTrigger:    {Type: field.TypeString, Column: review.FieldTrigger},
-			review.FieldObjectType: {Type: field.TypeString, Column: review.FieldObjectType},
-			review.FieldState:      {Type: field.TypeString, Column: review.FieldState},
-			review.FieldMessage:    {Type: field.TypeString, Column: review.FieldMessage},
+			review.FieldCreatedAt:      {Type: field.TypeUint32, Column: review.FieldCreatedAt},
+			review.FieldUpdatedAt:      {Type: field.TypeUint32, Column: review.FieldUpdatedAt},
+			review.FieldDeletedAt:      {Type: field.TypeUint32, Column: review.FieldDeletedAt},
+			review.FieldAppID:          {Type: field.TypeUUID, Column: review.FieldAppID},
+			review.FieldReviewerID:     {Type: field.TypeUUID, Column: review.FieldReviewerID},
+			review.FieldDomain:         {Type: field.TypeString, Column: review.FieldDomain},
+			review.FieldObjectID:       {Type: field.TypeUUID, Column: review.FieldObjectID},
+			review.FieldTrigger:        {Type: field.TypeString, Column: review.FieldTrigger},
+			review.FieldObjectType:     {Type: field.TypeString, Column: review.FieldObjectType},
+			review.FieldState:          {Type: field.TypeString, Column: review.FieldState},
+			review.FieldMessage:        {Type: field.TypeString, Column: review.FieldMessage},
+			review.FieldReasons:        {Type: field.TypeJSON, Column: review.FieldReasons},
+			review.FieldLeaseExpiresAt: {Type: field.TypeUint32, Column: review.FieldLeaseExpiresAt},
+			review.FieldDueAt:          {Type: field.TypeUint32, Column: review.FieldDueAt},
+			review.FieldEscalatedAt:    {Type: field.TypeUint32, Column: review.FieldEscalatedAt},
+			review.FieldVersion:        {Type: field.TypeUint32, Column: review.FieldVersion},
+			review.FieldOpenKey:        {Type: field.TypeString, Column: review.FieldOpenKey},
 		},
 	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reviewdecision.Table,
+			Columns: reviewdecision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		},
+		Type: "ReviewDecision",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reviewdecision.FieldCreatedAt:  {Type: field.TypeUint32, Column: reviewdecision.FieldCreatedAt},
+			reviewdecision.FieldUpdatedAt:  {Type: field.TypeUint32, Column: reviewdecision.FieldUpdatedAt},
+			reviewdecision.FieldDeletedAt:  {Type: field.TypeUint32, Column: reviewdecision.FieldDeletedAt},
+			reviewdecision.FieldAppID:      {Type: field.TypeUUID, Column: reviewdecision.FieldAppID},
+			reviewdecision.FieldReviewID:   {Type: field.TypeUUID, Column: reviewdecision.FieldReviewID},
+			reviewdecision.FieldReviewerID: {Type: field.TypeUUID, Column: reviewdecision.FieldReviewerID},
+			reviewdecision.FieldDecision:   {Type: field.TypeString, Column: reviewdecision.FieldDecision},
+			reviewdecision.FieldMessage:    {Type: field.TypeString, Column: reviewdecision.FieldMessage},
+			reviewdecision.FieldReasons:    {Type: field.TypeJSON, Column: reviewdecision.FieldReasons},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reviewevent.Table,
+			Columns: reviewevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		},
+		Type: "ReviewEvent",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reviewevent.FieldCreatedAt: {Type: field.TypeUint32, Column: reviewevent.FieldCreatedAt},
+			reviewevent.FieldUpdatedAt: {Type: field.TypeUint32, Column: reviewevent.FieldUpdatedAt},
+			reviewevent.FieldDeletedAt: {Type: field.TypeUint32, Column: reviewevent.FieldDeletedAt},
+			reviewevent.FieldAppID:     {Type: field.TypeUUID, Column: reviewevent.FieldAppID},
+			reviewevent.FieldReviewID:  {Type: field.TypeUUID, Column: reviewevent.FieldReviewID},
+			reviewevent.FieldActorID:   {Type: field.TypeUUID, Column: reviewevent.FieldActorID},
+			reviewevent.FieldEvent:     {Type: field.TypeString, Column: reviewevent.FieldEvent},
+			reviewevent.FieldOldValue:  {Type: field.TypeString, Column: reviewevent.FieldOldValue},
+			reviewevent.FieldNewValue:  {Type: field.TypeString, Column: reviewevent.FieldNewValue},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reviewerpool.Table,
+			Columns: reviewerpool.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		},
+		Type: "ReviewerPool",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reviewerpool.FieldCreatedAt:  {Type: field.TypeUint32, Column: reviewerpool.FieldCreatedAt},
+			reviewerpool.FieldUpdatedAt:  {Type: field.TypeUint32, Column: reviewerpool.FieldUpdatedAt},
+			reviewerpool.FieldDeletedAt:  {Type: field.TypeUint32, Column: reviewerpool.FieldDeletedAt},
+			reviewerpool.FieldAppID:      {Type: field.TypeUUID, Column: reviewerpool.FieldAppID},
+			reviewerpool.FieldDomain:     {Type: field.TypeString, Column: reviewerpool.FieldDomain},
+			reviewerpool.FieldObjectType: {Type: field.TypeString, Column: reviewerpool.FieldObjectType},
+			reviewerpool.FieldStrategy:   {Type: field.TypeString, Column: reviewerpool.FieldStrategy},
+			reviewerpool.FieldCursor:     {Type: field.TypeUint32, Column: reviewerpool.FieldCursor},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   reviewerpoolmember.Table,
+			Columns: reviewerpoolmember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		},
+		Type: "ReviewerPoolMember",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			reviewerpoolmember.FieldCreatedAt:  {Type: field.TypeUint32, Column: reviewerpoolmember.FieldCreatedAt},
+			reviewerpoolmember.FieldUpdatedAt:  {Type: field.TypeUint32, Column: reviewerpoolmember.FieldUpdatedAt},
+			reviewerpoolmember.FieldDeletedAt:  {Type: field.TypeUint32, Column: reviewerpoolmember.FieldDeletedAt},
+			reviewerpoolmember.FieldPoolID:     {Type: field.TypeUUID, Column: reviewerpoolmember.FieldPoolID},
+			reviewerpoolmember.FieldReviewerID: {Type: field.TypeUUID, Column: reviewerpoolmember.FieldReviewerID},
+			reviewerpoolmember.FieldWeight:     {Type: field.TypeUint32, Column: reviewerpoolmember.FieldWeight},
+			reviewerpoolmember.FieldOnShift:    {Type: field.TypeBool, Column: reviewerpoolmember.FieldOnShift},
+		},
+	}
+	graph.MustAddE(
+		"events",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+		},
+		"Review",
+		"ReviewEvent",
+	)
+	graph.MustAddE(
+		"decisions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+		},
+		"Review",
+		"ReviewDecision",
+	)
+	graph.MustAddE(
+		"review",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+		},
+		"ReviewDecision",
+		"Review",
+	)
+	graph.MustAddE(
+		"review",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+		},
+		"ReviewEvent",
+		"Review",
+	)
+	graph.MustAddE(
+		"members",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+		},
+		"ReviewerPool",
+		"ReviewerPoolMember",
+	)
+	graph.MustAddE(
+		"pool",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+		},
+		"ReviewerPoolMember",
+		"ReviewerPool",
+	)
 	return graph
 }()
 
@@ -47,6 +239,96 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (oq *OutboxQuery) addPredicate(pred func(s *sql.Selector)) {
+	oq.predicates = append(oq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OutboxQuery builder.
+func (oq *OutboxQuery) Filter() *OutboxFilter {
+	return &OutboxFilter{config: oq.config, predicateAdder: oq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OutboxMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OutboxMutation builder.
+func (m *OutboxMutation) Filter() *OutboxFilter {
+	return &OutboxFilter{config: m.config, predicateAdder: m}
+}
+
+// OutboxFilter provides a generic filtering capability at runtime for OutboxQuery.
+type OutboxFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OutboxFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *OutboxFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(outbox.FieldID))
+}
+
+// WhereCreatedAt applies the entql uint32 predicate on the created_at field.
+func (f *OutboxFilter) WhereCreatedAt(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql uint32 predicate on the updated_at field.
+func (f *OutboxFilter) WhereUpdatedAt(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql uint32 predicate on the deleted_at field.
+func (f *OutboxFilter) WhereDeletedAt(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldDeletedAt))
+}
+
+// WhereRoutingKey applies the entql string predicate on the routing_key field.
+func (f *OutboxFilter) WhereRoutingKey(p entql.StringP) {
+	f.Where(p.Field(outbox.FieldRoutingKey))
+}
+
+// WherePayload applies the entql string predicate on the payload field.
+func (f *OutboxFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(outbox.FieldPayload))
+}
+
+// WhereState applies the entql string predicate on the state field.
+func (f *OutboxFilter) WhereState(p entql.StringP) {
+	f.Where(p.Field(outbox.FieldState))
+}
+
+// WhereAttempts applies the entql uint32 predicate on the attempts field.
+func (f *OutboxFilter) WhereAttempts(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldAttempts))
+}
+
+// WhereNextRetryAt applies the entql uint32 predicate on the next_retry_at field.
+func (f *OutboxFilter) WhereNextRetryAt(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldNextRetryAt))
+}
+
+// WhereDeliveredAt applies the entql uint32 predicate on the delivered_at field.
+func (f *OutboxFilter) WhereDeliveredAt(p entql.Uint32P) {
+	f.Where(p.Field(outbox.FieldDeliveredAt))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *OutboxFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(outbox.FieldLastError))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (rq *ReviewQuery) addPredicate(pred func(s *sql.Selector)) {
 	rq.predicates = append(rq.predicates, pred)
@@ -76,7 +358,7 @@ type ReviewFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ReviewFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -141,3 +423,442 @@ func (f *ReviewFilter) WhereState(p entql.StringP) {
 func (f *ReviewFilter) WhereMessage(p entql.StringP) {
 	f.Where(p.Field(review.FieldMessage))
 }
+
+// WhereReasons applies the entql json.RawMessage predicate on the reasons field.
+func (f *ReviewFilter) WhereReasons(p entql.BytesP) {
+	f.Where(p.Field(review.FieldReasons))
+}
+
+// WhereLeaseExpiresAt applies the entql uint32 predicate on the lease_expires_at field.
+func (f *ReviewFilter) WhereLeaseExpiresAt(p entql.Uint32P) {
+	f.Where(p.Field(review.FieldLeaseExpiresAt))
+}
+
+// WhereDueAt applies the entql uint32 predicate on the due_at field.
+func (f *ReviewFilter) WhereDueAt(p entql.Uint32P) {
+	f.Where(p.Field(review.FieldDueAt))
+}
+
+// WhereEscalatedAt applies the entql uint32 predicate on the escalated_at field.
+func (f *ReviewFilter) WhereEscalatedAt(p entql.Uint32P) {
+	f.Where(p.Field(review.FieldEscalatedAt))
+}
+
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *ReviewFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(review.FieldVersion))
+}
+
+// WhereOpenKey applies the entql string predicate on the open_key field.
+func (f *ReviewFilter) WhereOpenKey(p entql.StringP) {
+	f.Where(p.Field(review.FieldOpenKey))
+}
+
+// WhereHasEvents applies a predicate to check if query has an edge events.
+func (f *ReviewFilter) WhereHasEvents() {
+	f.Where(entql.HasEdge("events"))
+}
+
+// WhereHasEventsWith applies a predicate to check if query has an edge events with a given conditions (other predicates).
+func (f *ReviewFilter) WhereHasEventsWith(preds ...predicate.ReviewEvent) {
+	f.Where(entql.HasEdgeWith("events", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDecisions applies a predicate to check if query has an edge decisions.
+func (f *ReviewFilter) WhereHasDecisions() {
+	f.Where(entql.HasEdge("decisions"))
+}
+
+// WhereHasDecisionsWith applies a predicate to check if query has an edge decisions with a given conditions (other predicates).
+func (f *ReviewFilter) WhereHasDecisionsWith(preds ...predicate.ReviewDecision) {
+	f.Where(entql.HasEdgeWith("decisions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rdq *ReviewDecisionQuery) addPredicate(pred func(s *sql.Selector)) {
+	rdq.predicates = append(rdq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReviewDecisionQuery builder.
+func (rdq *ReviewDecisionQuery) Filter() *ReviewDecisionFilter {
+	return &ReviewDecisionFilter{config: rdq.config, predicateAdder: rdq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReviewDecisionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReviewDecisionMutation builder.
+func (m *ReviewDecisionMutation) Filter() *ReviewDecisionFilter {
+	return &ReviewDecisionFilter{config: m.config, predicateAdder: m}
+}
+
+// ReviewDecisionFilter provides a generic filtering capability at runtime for ReviewDecisionQuery.
+type ReviewDecisionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReviewDecisionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *ReviewDecisionFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(reviewdecision.FieldID))
+}
+
+// WhereCreatedAt applies the entql uint32 predicate on the created_at field.
+func (f *ReviewDecisionFilter) WhereCreatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewdecision.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql uint32 predicate on the updated_at field.
+func (f *ReviewDecisionFilter) WhereUpdatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewdecision.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql uint32 predicate on the deleted_at field.
+func (f *ReviewDecisionFilter) WhereDeletedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewdecision.FieldDeletedAt))
+}
+
+// WhereAppID applies the entql [16]byte predicate on the app_id field.
+func (f *ReviewDecisionFilter) WhereAppID(p entql.ValueP) {
+	f.Where(p.Field(reviewdecision.FieldAppID))
+}
+
+// WhereReviewID applies the entql [16]byte predicate on the review_id field.
+func (f *ReviewDecisionFilter) WhereReviewID(p entql.ValueP) {
+	f.Where(p.Field(reviewdecision.FieldReviewID))
+}
+
+// WhereReviewerID applies the entql [16]byte predicate on the reviewer_id field.
+func (f *ReviewDecisionFilter) WhereReviewerID(p entql.ValueP) {
+	f.Where(p.Field(reviewdecision.FieldReviewerID))
+}
+
+// WhereDecision applies the entql string predicate on the decision field.
+func (f *ReviewDecisionFilter) WhereDecision(p entql.StringP) {
+	f.Where(p.Field(reviewdecision.FieldDecision))
+}
+
+// WhereMessage applies the entql string predicate on the message field.
+func (f *ReviewDecisionFilter) WhereMessage(p entql.StringP) {
+	f.Where(p.Field(reviewdecision.FieldMessage))
+}
+
+// WhereReasons applies the entql json.RawMessage predicate on the reasons field.
+func (f *ReviewDecisionFilter) WhereReasons(p entql.BytesP) {
+	f.Where(p.Field(reviewdecision.FieldReasons))
+}
+
+// WhereHasReview applies a predicate to check if query has an edge review.
+func (f *ReviewDecisionFilter) WhereHasReview() {
+	f.Where(entql.HasEdge("review"))
+}
+
+// WhereHasReviewWith applies a predicate to check if query has an edge review with a given conditions (other predicates).
+func (f *ReviewDecisionFilter) WhereHasReviewWith(preds ...predicate.Review) {
+	f.Where(entql.HasEdgeWith("review", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (req *ReviewEventQuery) addPredicate(pred func(s *sql.Selector)) {
+	req.predicates = append(req.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReviewEventQuery builder.
+func (req *ReviewEventQuery) Filter() *ReviewEventFilter {
+	return &ReviewEventFilter{config: req.config, predicateAdder: req}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReviewEventMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReviewEventMutation builder.
+func (m *ReviewEventMutation) Filter() *ReviewEventFilter {
+	return &ReviewEventFilter{config: m.config, predicateAdder: m}
+}
+
+// ReviewEventFilter provides a generic filtering capability at runtime for ReviewEventQuery.
+type ReviewEventFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReviewEventFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *ReviewEventFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(reviewevent.FieldID))
+}
+
+// WhereCreatedAt applies the entql uint32 predicate on the created_at field.
+func (f *ReviewEventFilter) WhereCreatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewevent.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql uint32 predicate on the updated_at field.
+func (f *ReviewEventFilter) WhereUpdatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewevent.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql uint32 predicate on the deleted_at field.
+func (f *ReviewEventFilter) WhereDeletedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewevent.FieldDeletedAt))
+}
+
+// WhereAppID applies the entql [16]byte predicate on the app_id field.
+func (f *ReviewEventFilter) WhereAppID(p entql.ValueP) {
+	f.Where(p.Field(reviewevent.FieldAppID))
+}
+
+// WhereReviewID applies the entql [16]byte predicate on the review_id field.
+func (f *ReviewEventFilter) WhereReviewID(p entql.ValueP) {
+	f.Where(p.Field(reviewevent.FieldReviewID))
+}
+
+// WhereActorID applies the entql [16]byte predicate on the actor_id field.
+func (f *ReviewEventFilter) WhereActorID(p entql.ValueP) {
+	f.Where(p.Field(reviewevent.FieldActorID))
+}
+
+// WhereEvent applies the entql string predicate on the event field.
+func (f *ReviewEventFilter) WhereEvent(p entql.StringP) {
+	f.Where(p.Field(reviewevent.FieldEvent))
+}
+
+// WhereOldValue applies the entql string predicate on the old_value field.
+func (f *ReviewEventFilter) WhereOldValue(p entql.StringP) {
+	f.Where(p.Field(reviewevent.FieldOldValue))
+}
+
+// WhereNewValue applies the entql string predicate on the new_value field.
+func (f *ReviewEventFilter) WhereNewValue(p entql.StringP) {
+	f.Where(p.Field(reviewevent.FieldNewValue))
+}
+
+// WhereHasReview applies a predicate to check if query has an edge review.
+func (f *ReviewEventFilter) WhereHasReview() {
+	f.Where(entql.HasEdge("review"))
+}
+
+// WhereHasReviewWith applies a predicate to check if query has an edge review with a given conditions (other predicates).
+func (f *ReviewEventFilter) WhereHasReviewWith(preds ...predicate.Review) {
+	f.Where(entql.HasEdgeWith("review", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rpq *ReviewerPoolQuery) addPredicate(pred func(s *sql.Selector)) {
+	rpq.predicates = append(rpq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReviewerPoolQuery builder.
+func (rpq *ReviewerPoolQuery) Filter() *ReviewerPoolFilter {
+	return &ReviewerPoolFilter{config: rpq.config, predicateAdder: rpq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReviewerPoolMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReviewerPoolMutation builder.
+func (m *ReviewerPoolMutation) Filter() *ReviewerPoolFilter {
+	return &ReviewerPoolFilter{config: m.config, predicateAdder: m}
+}
+
+// ReviewerPoolFilter provides a generic filtering capability at runtime for ReviewerPoolQuery.
+type ReviewerPoolFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReviewerPoolFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *ReviewerPoolFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(reviewerpool.FieldID))
+}
+
+// WhereCreatedAt applies the entql uint32 predicate on the created_at field.
+func (f *ReviewerPoolFilter) WhereCreatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpool.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql uint32 predicate on the updated_at field.
+func (f *ReviewerPoolFilter) WhereUpdatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpool.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql uint32 predicate on the deleted_at field.
+func (f *ReviewerPoolFilter) WhereDeletedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpool.FieldDeletedAt))
+}
+
+// WhereAppID applies the entql [16]byte predicate on the app_id field.
+func (f *ReviewerPoolFilter) WhereAppID(p entql.ValueP) {
+	f.Where(p.Field(reviewerpool.FieldAppID))
+}
+
+// WhereDomain applies the entql string predicate on the domain field.
+func (f *ReviewerPoolFilter) WhereDomain(p entql.StringP) {
+	f.Where(p.Field(reviewerpool.FieldDomain))
+}
+
+// WhereObjectType applies the entql string predicate on the object_type field.
+func (f *ReviewerPoolFilter) WhereObjectType(p entql.StringP) {
+	f.Where(p.Field(reviewerpool.FieldObjectType))
+}
+
+// WhereStrategy applies the entql string predicate on the strategy field.
+func (f *ReviewerPoolFilter) WhereStrategy(p entql.StringP) {
+	f.Where(p.Field(reviewerpool.FieldStrategy))
+}
+
+// WhereCursor applies the entql uint32 predicate on the cursor field.
+func (f *ReviewerPoolFilter) WhereCursor(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpool.FieldCursor))
+}
+
+// WhereHasMembers applies a predicate to check if query has an edge members.
+func (f *ReviewerPoolFilter) WhereHasMembers() {
+	f.Where(entql.HasEdge("members"))
+}
+
+// WhereHasMembersWith applies a predicate to check if query has an edge members with a given conditions (other predicates).
+func (f *ReviewerPoolFilter) WhereHasMembersWith(preds ...predicate.ReviewerPoolMember) {
+	f.Where(entql.HasEdgeWith("members", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (rpmq *ReviewerPoolMemberQuery) addPredicate(pred func(s *sql.Selector)) {
+	rpmq.predicates = append(rpmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ReviewerPoolMemberQuery builder.
+func (rpmq *ReviewerPoolMemberQuery) Filter() *ReviewerPoolMemberFilter {
+	return &ReviewerPoolMemberFilter{config: rpmq.config, predicateAdder: rpmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ReviewerPoolMemberMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ReviewerPoolMemberMutation builder.
+func (m *ReviewerPoolMemberMutation) Filter() *ReviewerPoolMemberFilter {
+	return &ReviewerPoolMemberFilter{config: m.config, predicateAdder: m}
+}
+
+// ReviewerPoolMemberFilter provides a generic filtering capability at runtime for ReviewerPoolMemberQuery.
+type ReviewerPoolMemberFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ReviewerPoolMemberFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *ReviewerPoolMemberFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(reviewerpoolmember.FieldID))
+}
+
+// WhereCreatedAt applies the entql uint32 predicate on the created_at field.
+func (f *ReviewerPoolMemberFilter) WhereCreatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpoolmember.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql uint32 predicate on the updated_at field.
+func (f *ReviewerPoolMemberFilter) WhereUpdatedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpoolmember.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql uint32 predicate on the deleted_at field.
+func (f *ReviewerPoolMemberFilter) WhereDeletedAt(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpoolmember.FieldDeletedAt))
+}
+
+// WherePoolID applies the entql [16]byte predicate on the pool_id field.
+func (f *ReviewerPoolMemberFilter) WherePoolID(p entql.ValueP) {
+	f.Where(p.Field(reviewerpoolmember.FieldPoolID))
+}
+
+// WhereReviewerID applies the entql [16]byte predicate on the reviewer_id field.
+func (f *ReviewerPoolMemberFilter) WhereReviewerID(p entql.ValueP) {
+	f.Where(p.Field(reviewerpoolmember.FieldReviewerID))
+}
+
+// WhereWeight applies the entql uint32 predicate on the weight field.
+func (f *ReviewerPoolMemberFilter) WhereWeight(p entql.Uint32P) {
+	f.Where(p.Field(reviewerpoolmember.FieldWeight))
+}
+
+// WhereOnShift applies the entql bool predicate on the on_shift field.
+func (f *ReviewerPoolMemberFilter) WhereOnShift(p entql.BoolP) {
+	f.Where(p.Field(reviewerpoolmember.FieldOnShift))
+}
+
+// WhereHasPool applies a predicate to check if query has an edge pool.
+func (f *ReviewerPoolMemberFilter) WhereHasPool() {
+	f.Where(entql.HasEdge("pool"))
+}
+
+// WhereHasPoolWith applies a predicate to check if query has an edge pool with a given conditions (other predicates).
+func (f *ReviewerPoolMemberFilter) WhereHasPoolWith(preds ...predicate.ReviewerPool) {
+	f.Where(entql.HasEdgeWith("pool", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -9,6 +9,19 @@ import (
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
 )
 
+// The OutboxFunc type is an adapter to allow the use of ordinary
+// function as Outbox mutator.
+type OutboxFunc func(context.Context, *ent.OutboxMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.OutboxMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The ReviewFunc type is an adapter to allow the use of ordinary
 // function as Review mutator.
 type ReviewFunc func(context.Context, *ent.ReviewMutation) (ent.Value, error)
@@ -22,6 +35,58 @@ func (f ReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return f(ctx, mv)
 }
 
+// The ReviewDecisionFunc type is an adapter to allow the use of ordinary
+// function as ReviewDecision mutator.
+type ReviewDecisionFunc func(context.Context, *ent.ReviewDecisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewDecisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ReviewDecisionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewDecisionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ReviewEventFunc type is an adapter to allow the use of ordinary
+// function as ReviewEvent mutator.
+type ReviewEventFunc func(context.Context, *ent.ReviewEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ReviewEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ReviewerPoolFunc type is an adapter to allow the use of ordinary
+// function as ReviewerPool mutator.
+type ReviewerPoolFunc func(context.Context, *ent.ReviewerPoolMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewerPoolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ReviewerPoolMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewerPoolMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ReviewerPoolMemberFunc type is an adapter to allow the use of ordinary
+// function as ReviewerPoolMember mutator.
+type ReviewerPoolMemberFunc func(context.Context, *ent.ReviewerPoolMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewerPoolMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ReviewerPoolMemberMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewerPoolMemberMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -117,7 +182,6 @@ func HasFields(field string, fields ...string) Condition {
 // If executes the given hook under condition.
 //
 //	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
-//
 func If(hk ent.Hook, cond Condition) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -132,7 +196,6 @@ func If(hk ent.Hook, cond Condition) ent.Hook {
 // On executes the given hook only for the given operation.
 //
 //	hook.On(Log, ent.Delete|ent.Create)
-//
 func On(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, HasOp(op))
 }
@@ -140,7 +203,6 @@ func On(hk ent.Hook, op ent.Op) ent.Hook {
 // Unless skips the given hook only for the given operation.
 //
 //	hook.Unless(Log, ent.Update|ent.UpdateOne)
-//
 func Unless(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, Not(HasOp(op)))
 }
@@ -161,7 +223,6 @@ func FixedError(err error) ent.Hook {
 //			Reject(ent.Delete|ent.Update),
 //		}
 //	}
-//
 func Reject(op ent.Op) ent.Hook {
 	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
 	return On(hk, op)
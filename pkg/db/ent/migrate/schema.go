@@ -8,6 +8,38 @@ import (
 )
 
 var (
+	// OutboxesColumns holds the columns for the "outboxes" table.
+	OutboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeUint32},
+		{Name: "updated_at", Type: field.TypeUint32},
+		{Name: "deleted_at", Type: field.TypeUint32},
+		{Name: "routing_key", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "payload", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "state", Type: field.TypeString, Nullable: true, Default: "Pending"},
+		{Name: "attempts", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "next_retry_at", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "delivered_at", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+	}
+	// OutboxesTable holds the schema information for the "outboxes" table.
+	OutboxesTable = &schema.Table{
+		Name:       "outboxes",
+		Columns:    OutboxesColumns,
+		PrimaryKey: []*schema.Column{OutboxesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outbox_state_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxesColumns[6], OutboxesColumns[8]},
+			},
+			{
+				Name:    "outbox_state_delivered_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxesColumns[6], OutboxesColumns[9]},
+			},
+		},
+	}
 	// ReviewsColumns holds the columns for the "reviews" table.
 	ReviewsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -22,18 +54,164 @@ var (
 		{Name: "object_type", Type: field.TypeString, Nullable: true, Default: "DefaultObjectType"},
 		{Name: "state", Type: field.TypeString, Nullable: true, Default: "DefaultReviewState"},
 		{Name: "message", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "due_at", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "escalated_at", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "version", Type: field.TypeUint32, Nullable: true, Default: 0},
+		{Name: "open_key", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// ReviewsTable holds the schema information for the "reviews" table.
 	ReviewsTable = &schema.Table{
 		Name:       "reviews",
 		Columns:    ReviewsColumns,
 		PrimaryKey: []*schema.Column{ReviewsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "review_state_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewsColumns[10], ReviewsColumns[14]},
+			},
+		},
+	}
+	// ReviewDecisionsColumns holds the columns for the "review_decisions" table.
+	ReviewDecisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeUint32},
+		{Name: "updated_at", Type: field.TypeUint32},
+		{Name: "deleted_at", Type: field.TypeUint32},
+		{Name: "app_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewer_id", Type: field.TypeUUID},
+		{Name: "decision", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "review_id", Type: field.TypeUUID},
+	}
+	// ReviewDecisionsTable holds the schema information for the "review_decisions" table.
+	ReviewDecisionsTable = &schema.Table{
+		Name:       "review_decisions",
+		Columns:    ReviewDecisionsColumns,
+		PrimaryKey: []*schema.Column{ReviewDecisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "review_decisions_reviews_decisions",
+				Columns:    []*schema.Column{ReviewDecisionsColumns[9]},
+				RefColumns: []*schema.Column{ReviewsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewdecision_review_id_reviewer_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewDecisionsColumns[9], ReviewDecisionsColumns[5]},
+			},
+		},
+	}
+	// ReviewEventsColumns holds the columns for the "review_events" table.
+	ReviewEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeUint32},
+		{Name: "updated_at", Type: field.TypeUint32},
+		{Name: "deleted_at", Type: field.TypeUint32},
+		{Name: "app_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "event", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "old_value", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "new_value", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "review_id", Type: field.TypeUUID},
+	}
+	// ReviewEventsTable holds the schema information for the "review_events" table.
+	ReviewEventsTable = &schema.Table{
+		Name:       "review_events",
+		Columns:    ReviewEventsColumns,
+		PrimaryKey: []*schema.Column{ReviewEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "review_events_reviews_events",
+				Columns:    []*schema.Column{ReviewEventsColumns[9]},
+				RefColumns: []*schema.Column{ReviewsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewevent_review_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewEventsColumns[9], ReviewEventsColumns[1]},
+			},
+		},
+	}
+	// ReviewerPoolsColumns holds the columns for the "reviewer_pools" table.
+	ReviewerPoolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeUint32},
+		{Name: "updated_at", Type: field.TypeUint32},
+		{Name: "deleted_at", Type: field.TypeUint32},
+		{Name: "app_id", Type: field.TypeUUID},
+		{Name: "domain", Type: field.TypeString},
+		{Name: "object_type", Type: field.TypeString},
+		{Name: "strategy", Type: field.TypeString, Nullable: true, Default: "RoundRobin"},
+		{Name: "cursor", Type: field.TypeUint32, Nullable: true, Default: 0},
+	}
+	// ReviewerPoolsTable holds the schema information for the "reviewer_pools" table.
+	ReviewerPoolsTable = &schema.Table{
+		Name:       "reviewer_pools",
+		Columns:    ReviewerPoolsColumns,
+		PrimaryKey: []*schema.Column{ReviewerPoolsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewerpool_app_id_domain_object_type",
+				Unique:  true,
+				Columns: []*schema.Column{ReviewerPoolsColumns[4], ReviewerPoolsColumns[5], ReviewerPoolsColumns[6]},
+			},
+		},
+	}
+	// ReviewerPoolMembersColumns holds the columns for the "reviewer_pool_members" table.
+	ReviewerPoolMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeUint32},
+		{Name: "updated_at", Type: field.TypeUint32},
+		{Name: "deleted_at", Type: field.TypeUint32},
+		{Name: "reviewer_id", Type: field.TypeUUID},
+		{Name: "weight", Type: field.TypeUint32, Nullable: true, Default: 1},
+		{Name: "on_shift", Type: field.TypeBool, Nullable: true, Default: true},
+		{Name: "pool_id", Type: field.TypeUUID},
+	}
+	// ReviewerPoolMembersTable holds the schema information for the "reviewer_pool_members" table.
+	ReviewerPoolMembersTable = &schema.Table{
+		Name:       "reviewer_pool_members",
+		Columns:    ReviewerPoolMembersColumns,
+		PrimaryKey: []*schema.Column{ReviewerPoolMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reviewer_pool_members_reviewer_pools_members",
+				Columns:    []*schema.Column{ReviewerPoolMembersColumns[7]},
+				RefColumns: []*schema.Column{ReviewerPoolsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewerpoolmember_pool_id_reviewer_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReviewerPoolMembersColumns[7], ReviewerPoolMembersColumns[4]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		OutboxesTable,
 		ReviewsTable,
+		ReviewDecisionsTable,
+		ReviewEventsTable,
+		ReviewerPoolsTable,
+		ReviewerPoolMembersTable,
 	}
 )
 
 func init() {
+	ReviewDecisionsTable.ForeignKeys[0].RefTable = ReviewsTable
+	ReviewEventsTable.ForeignKeys[0].RefTable = ReviewsTable
+	ReviewerPoolMembersTable.ForeignKeys[0].RefTable = ReviewerPoolsTable
 }
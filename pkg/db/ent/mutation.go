@@ -8,8 +8,13 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -24,46 +29,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeReview = "Review"
+	TypeOutbox             = "Outbox"
+	TypeReview             = "Review"
+	TypeReviewDecision     = "ReviewDecision"
+	TypeReviewEvent        = "ReviewEvent"
+	TypeReviewerPool       = "ReviewerPool"
+	TypeReviewerPoolMember = "ReviewerPoolMember"
 )
 
-// ReviewMutation represents an operation that mutates the Review nodes in the graph.
-type ReviewMutation struct {
+// OutboxMutation represents an operation that mutates the Outbox nodes in the graph.
+type OutboxMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *uint32
-	addcreated_at *int32
-	updated_at    *uint32
-	addupdated_at *int32
-	deleted_at    *uint32
-	adddeleted_at *int32
-	app_id        *uuid.UUID
-	reviewer_id   *uuid.UUID
-	domain        *string
-	object_id     *uuid.UUID
-	trigger       *string
-	object_type   *string
-	state         *string
-	message       *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Review, error)
-	predicates    []predicate.Review
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *uint32
+	addcreated_at    *int32
+	updated_at       *uint32
+	addupdated_at    *int32
+	deleted_at       *uint32
+	adddeleted_at    *int32
+	routing_key      *string
+	payload          *string
+	state            *string
+	attempts         *uint32
+	addattempts      *int32
+	next_retry_at    *uint32
+	addnext_retry_at *int32
+	delivered_at     *uint32
+	adddelivered_at  *int32
+	last_error       *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Outbox, error)
+	predicates       []predicate.Outbox
 }
 
-var _ ent.Mutation = (*ReviewMutation)(nil)
+var _ ent.Mutation = (*OutboxMutation)(nil)
 
-// reviewOption allows management of the mutation configuration using functional options.
-type reviewOption func(*ReviewMutation)
+// outboxOption allows management of the mutation configuration using functional options.
+type outboxOption func(*OutboxMutation)
 
-// newReviewMutation creates new mutation for the Review entity.
-func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
-	m := &ReviewMutation{
+// newOutboxMutation creates new mutation for the Outbox entity.
+func newOutboxMutation(c config, op Op, opts ...outboxOption) *OutboxMutation {
+	m := &OutboxMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReview,
+		typ:           TypeOutbox,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +84,20 @@ func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
 	return m
 }
 
-// withReviewID sets the ID field of the mutation.
-func withReviewID(id uuid.UUID) reviewOption {
-	return func(m *ReviewMutation) {
+// withOutboxID sets the ID field of the mutation.
+func withOutboxID(id uuid.UUID) outboxOption {
+	return func(m *OutboxMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Review
+			value *Outbox
 		)
-		m.oldValue = func(ctx context.Context) (*Review, error) {
+		m.oldValue = func(ctx context.Context) (*Outbox, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Review.Get(ctx, id)
+					value, err = m.Client().Outbox.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +106,10 @@ func withReviewID(id uuid.UUID) reviewOption {
 	}
 }
 
-// withReview sets the old Review of the mutation.
-func withReview(node *Review) reviewOption {
-	return func(m *ReviewMutation) {
-		m.oldValue = func(context.Context) (*Review, error) {
+// withOutbox sets the old Outbox of the mutation.
+func withOutbox(node *Outbox) outboxOption {
+	return func(m *OutboxMutation) {
+		m.oldValue = func(context.Context) (*Outbox, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +118,7 @@ func withReview(node *Review) reviewOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReviewMutation) Client() *Client {
+func (m OutboxMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +126,7 @@ func (m ReviewMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReviewMutation) Tx() (*Tx, error) {
+func (m OutboxMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,14 +136,14 @@ func (m ReviewMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Review entities.
-func (m *ReviewMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Outbox entities.
+func (m *OutboxMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReviewMutation) ID() (id uuid.UUID, exists bool) {
+func (m *OutboxMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -142,7 +154,7 @@ func (m *ReviewMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *OutboxMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -151,20 +163,20 @@ func (m *ReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Outbox.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ReviewMutation) SetCreatedAt(u uint32) {
+func (m *OutboxMutation) SetCreatedAt(u uint32) {
 	m.created_at = &u
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReviewMutation) CreatedAt() (r uint32, exists bool) {
+func (m *OutboxMutation) CreatedAt() (r uint32, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -172,10 +184,10 @@ func (m *ReviewMutation) CreatedAt() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+func (m *OutboxMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -190,7 +202,7 @@ func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v uint32, err error)
 }
 
 // AddCreatedAt adds u to the "created_at" field.
-func (m *ReviewMutation) AddCreatedAt(u int32) {
+func (m *OutboxMutation) AddCreatedAt(u int32) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += u
 	} else {
@@ -199,7 +211,7 @@ func (m *ReviewMutation) AddCreatedAt(u int32) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *ReviewMutation) AddedCreatedAt() (r int32, exists bool) {
+func (m *OutboxMutation) AddedCreatedAt() (r int32, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -208,19 +220,19 @@ func (m *ReviewMutation) AddedCreatedAt() (r int32, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReviewMutation) ResetCreatedAt() {
+func (m *OutboxMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ReviewMutation) SetUpdatedAt(u uint32) {
+func (m *OutboxMutation) SetUpdatedAt(u uint32) {
 	m.updated_at = &u
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ReviewMutation) UpdatedAt() (r uint32, exists bool) {
+func (m *OutboxMutation) UpdatedAt() (r uint32, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -228,10 +240,10 @@ func (m *ReviewMutation) UpdatedAt() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+func (m *OutboxMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -246,7 +258,7 @@ func (m *ReviewMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error)
 }
 
 // AddUpdatedAt adds u to the "updated_at" field.
-func (m *ReviewMutation) AddUpdatedAt(u int32) {
+func (m *OutboxMutation) AddUpdatedAt(u int32) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += u
 	} else {
@@ -255,7 +267,7 @@ func (m *ReviewMutation) AddUpdatedAt(u int32) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *ReviewMutation) AddedUpdatedAt() (r int32, exists bool) {
+func (m *OutboxMutation) AddedUpdatedAt() (r int32, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -264,19 +276,19 @@ func (m *ReviewMutation) AddedUpdatedAt() (r int32, exists bool) {
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ReviewMutation) ResetUpdatedAt() {
+func (m *OutboxMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *ReviewMutation) SetDeletedAt(u uint32) {
+func (m *OutboxMutation) SetDeletedAt(u uint32) {
 	m.deleted_at = &u
 	m.adddeleted_at = nil
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ReviewMutation) DeletedAt() (r uint32, exists bool) {
+func (m *OutboxMutation) DeletedAt() (r uint32, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -284,10 +296,10 @@ func (m *ReviewMutation) DeletedAt() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+func (m *OutboxMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -302,7 +314,7 @@ func (m *ReviewMutation) OldDeletedAt(ctx context.Context) (v uint32, err error)
 }
 
 // AddDeletedAt adds u to the "deleted_at" field.
-func (m *ReviewMutation) AddDeletedAt(u int32) {
+func (m *OutboxMutation) AddDeletedAt(u int32) {
 	if m.adddeleted_at != nil {
 		*m.adddeleted_at += u
 	} else {
@@ -311,7 +323,7 @@ func (m *ReviewMutation) AddDeletedAt(u int32) {
 }
 
 // AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *ReviewMutation) AddedDeletedAt() (r int32, exists bool) {
+func (m *OutboxMutation) AddedDeletedAt() (r int32, exists bool) {
 	v := m.adddeleted_at
 	if v == nil {
 		return
@@ -320,312 +332,1469 @@ func (m *ReviewMutation) AddedDeletedAt() (r int32, exists bool) {
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ReviewMutation) ResetDeletedAt() {
+func (m *OutboxMutation) ResetDeletedAt() {
 	m.deleted_at = nil
 	m.adddeleted_at = nil
 }
 
-// SetAppID sets the "app_id" field.
-func (m *ReviewMutation) SetAppID(u uuid.UUID) {
-	m.app_id = &u
+// SetRoutingKey sets the "routing_key" field.
+func (m *OutboxMutation) SetRoutingKey(s string) {
+	m.routing_key = &s
 }
 
-// AppID returns the value of the "app_id" field in the mutation.
-func (m *ReviewMutation) AppID() (r uuid.UUID, exists bool) {
-	v := m.app_id
+// RoutingKey returns the value of the "routing_key" field in the mutation.
+func (m *OutboxMutation) RoutingKey() (r string, exists bool) {
+	v := m.routing_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppID returns the old "app_id" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldRoutingKey returns the old "routing_key" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *OutboxMutation) OldRoutingKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoutingKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppID requires an ID field in the mutation")
+		return v, errors.New("OldRoutingKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoutingKey: %w", err)
 	}
-	return oldValue.AppID, nil
+	return oldValue.RoutingKey, nil
 }
 
-// ClearAppID clears the value of the "app_id" field.
-func (m *ReviewMutation) ClearAppID() {
-	m.app_id = nil
-	m.clearedFields[review.FieldAppID] = struct{}{}
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (m *OutboxMutation) ClearRoutingKey() {
+	m.routing_key = nil
+	m.clearedFields[outbox.FieldRoutingKey] = struct{}{}
 }
 
-// AppIDCleared returns if the "app_id" field was cleared in this mutation.
-func (m *ReviewMutation) AppIDCleared() bool {
-	_, ok := m.clearedFields[review.FieldAppID]
+// RoutingKeyCleared returns if the "routing_key" field was cleared in this mutation.
+func (m *OutboxMutation) RoutingKeyCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldRoutingKey]
 	return ok
 }
 
-// ResetAppID resets all changes to the "app_id" field.
-func (m *ReviewMutation) ResetAppID() {
-	m.app_id = nil
-	delete(m.clearedFields, review.FieldAppID)
+// ResetRoutingKey resets all changes to the "routing_key" field.
+func (m *OutboxMutation) ResetRoutingKey() {
+	m.routing_key = nil
+	delete(m.clearedFields, outbox.FieldRoutingKey)
 }
 
-// SetReviewerID sets the "reviewer_id" field.
-func (m *ReviewMutation) SetReviewerID(u uuid.UUID) {
-	m.reviewer_id = &u
+// SetPayload sets the "payload" field.
+func (m *OutboxMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ReviewerID returns the value of the "reviewer_id" field in the mutation.
-func (m *ReviewMutation) ReviewerID() (r uuid.UUID, exists bool) {
-	v := m.reviewer_id
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewerID returns the old "reviewer_id" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldReviewerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *OutboxMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ReviewerID, nil
+	return oldValue.Payload, nil
 }
 
-// ClearReviewerID clears the value of the "reviewer_id" field.
-func (m *ReviewMutation) ClearReviewerID() {
-	m.reviewer_id = nil
-	m.clearedFields[review.FieldReviewerID] = struct{}{}
+// ClearPayload clears the value of the "payload" field.
+func (m *OutboxMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[outbox.FieldPayload] = struct{}{}
 }
 
-// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
-func (m *ReviewMutation) ReviewerIDCleared() bool {
-	_, ok := m.clearedFields[review.FieldReviewerID]
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *OutboxMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldPayload]
 	return ok
 }
 
-// ResetReviewerID resets all changes to the "reviewer_id" field.
-func (m *ReviewMutation) ResetReviewerID() {
-	m.reviewer_id = nil
-	delete(m.clearedFields, review.FieldReviewerID)
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, outbox.FieldPayload)
 }
 
-// SetDomain sets the "domain" field.
-func (m *ReviewMutation) SetDomain(s string) {
-	m.domain = &s
+// SetState sets the "state" field.
+func (m *OutboxMutation) SetState(s string) {
+	m.state = &s
 }
 
-// Domain returns the value of the "domain" field in the mutation.
-func (m *ReviewMutation) Domain() (r string, exists bool) {
-	v := m.domain
+// State returns the value of the "state" field in the mutation.
+func (m *OutboxMutation) State() (r string, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomain returns the old "domain" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldDomain(ctx context.Context) (v string, err error) {
+func (m *OutboxMutation) OldState(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomain requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.Domain, nil
+	return oldValue.State, nil
 }
 
-// ClearDomain clears the value of the "domain" field.
-func (m *ReviewMutation) ClearDomain() {
-	m.domain = nil
-	m.clearedFields[review.FieldDomain] = struct{}{}
+// ClearState clears the value of the "state" field.
+func (m *OutboxMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[outbox.FieldState] = struct{}{}
 }
 
-// DomainCleared returns if the "domain" field was cleared in this mutation.
-func (m *ReviewMutation) DomainCleared() bool {
-	_, ok := m.clearedFields[review.FieldDomain]
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *OutboxMutation) StateCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldState]
 	return ok
 }
 
-// ResetDomain resets all changes to the "domain" field.
-func (m *ReviewMutation) ResetDomain() {
-	m.domain = nil
-	delete(m.clearedFields, review.FieldDomain)
+// ResetState resets all changes to the "state" field.
+func (m *OutboxMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, outbox.FieldState)
 }
 
-// SetObjectID sets the "object_id" field.
-func (m *ReviewMutation) SetObjectID(u uuid.UUID) {
-	m.object_id = &u
+// SetAttempts sets the "attempts" field.
+func (m *OutboxMutation) SetAttempts(u uint32) {
+	m.attempts = &u
+	m.addattempts = nil
 }
 
-// ObjectID returns the value of the "object_id" field in the mutation.
-func (m *ReviewMutation) ObjectID() (r uuid.UUID, exists bool) {
-	v := m.object_id
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxMutation) Attempts() (r uint32, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObjectID returns the old "object_id" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldObjectID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *OutboxMutation) OldAttempts(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObjectID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObjectID requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObjectID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.ObjectID, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearObjectID clears the value of the "object_id" field.
-func (m *ReviewMutation) ClearObjectID() {
-	m.object_id = nil
-	m.clearedFields[review.FieldObjectID] = struct{}{}
+// AddAttempts adds u to the "attempts" field.
+func (m *OutboxMutation) AddAttempts(u int32) {
+	if m.addattempts != nil {
+		*m.addattempts += u
+	} else {
+		m.addattempts = &u
+	}
 }
 
-// ObjectIDCleared returns if the "object_id" field was cleared in this mutation.
-func (m *ReviewMutation) ObjectIDCleared() bool {
-	_, ok := m.clearedFields[review.FieldObjectID]
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (m *OutboxMutation) ClearAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	m.clearedFields[outbox.FieldAttempts] = struct{}{}
+}
+
+// AttemptsCleared returns if the "attempts" field was cleared in this mutation.
+func (m *OutboxMutation) AttemptsCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldAttempts]
 	return ok
 }
 
-// ResetObjectID resets all changes to the "object_id" field.
-func (m *ReviewMutation) ResetObjectID() {
-	m.object_id = nil
-	delete(m.clearedFields, review.FieldObjectID)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	delete(m.clearedFields, outbox.FieldAttempts)
 }
 
-// SetTrigger sets the "trigger" field.
-func (m *ReviewMutation) SetTrigger(s string) {
-	m.trigger = &s
+// SetNextRetryAt sets the "next_retry_at" field.
+func (m *OutboxMutation) SetNextRetryAt(u uint32) {
+	m.next_retry_at = &u
+	m.addnext_retry_at = nil
 }
 
-// Trigger returns the value of the "trigger" field in the mutation.
-func (m *ReviewMutation) Trigger() (r string, exists bool) {
-	v := m.trigger
+// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
+func (m *OutboxMutation) NextRetryAt() (r uint32, exists bool) {
+	v := m.next_retry_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrigger returns the old "trigger" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldNextRetryAt returns the old "next_retry_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldTrigger(ctx context.Context) (v string, err error) {
+func (m *OutboxMutation) OldNextRetryAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrigger requires an ID field in the mutation")
+		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
 	}
-	return oldValue.Trigger, nil
+	return oldValue.NextRetryAt, nil
 }
 
-// ClearTrigger clears the value of the "trigger" field.
-func (m *ReviewMutation) ClearTrigger() {
-	m.trigger = nil
-	m.clearedFields[review.FieldTrigger] = struct{}{}
+// AddNextRetryAt adds u to the "next_retry_at" field.
+func (m *OutboxMutation) AddNextRetryAt(u int32) {
+	if m.addnext_retry_at != nil {
+		*m.addnext_retry_at += u
+	} else {
+		m.addnext_retry_at = &u
+	}
 }
 
-// TriggerCleared returns if the "trigger" field was cleared in this mutation.
-func (m *ReviewMutation) TriggerCleared() bool {
-	_, ok := m.clearedFields[review.FieldTrigger]
+// AddedNextRetryAt returns the value that was added to the "next_retry_at" field in this mutation.
+func (m *OutboxMutation) AddedNextRetryAt() (r int32, exists bool) {
+	v := m.addnext_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (m *OutboxMutation) ClearNextRetryAt() {
+	m.next_retry_at = nil
+	m.addnext_retry_at = nil
+	m.clearedFields[outbox.FieldNextRetryAt] = struct{}{}
+}
+
+// NextRetryAtCleared returns if the "next_retry_at" field was cleared in this mutation.
+func (m *OutboxMutation) NextRetryAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldNextRetryAt]
 	return ok
 }
 
-// ResetTrigger resets all changes to the "trigger" field.
-func (m *ReviewMutation) ResetTrigger() {
-	m.trigger = nil
-	delete(m.clearedFields, review.FieldTrigger)
+// ResetNextRetryAt resets all changes to the "next_retry_at" field.
+func (m *OutboxMutation) ResetNextRetryAt() {
+	m.next_retry_at = nil
+	m.addnext_retry_at = nil
+	delete(m.clearedFields, outbox.FieldNextRetryAt)
 }
 
-// SetObjectType sets the "object_type" field.
-func (m *ReviewMutation) SetObjectType(s string) {
-	m.object_type = &s
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *OutboxMutation) SetDeliveredAt(u uint32) {
+	m.delivered_at = &u
+	m.adddelivered_at = nil
 }
 
-// ObjectType returns the value of the "object_type" field in the mutation.
-func (m *ReviewMutation) ObjectType() (r string, exists bool) {
-	v := m.object_type
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *OutboxMutation) DeliveredAt() (r uint32, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObjectType returns the old "object_type" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldObjectType(ctx context.Context) (v string, err error) {
+func (m *OutboxMutation) OldDeliveredAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObjectType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObjectType requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObjectType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.ObjectType, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ClearObjectType clears the value of the "object_type" field.
-func (m *ReviewMutation) ClearObjectType() {
-	m.object_type = nil
-	m.clearedFields[review.FieldObjectType] = struct{}{}
+// AddDeliveredAt adds u to the "delivered_at" field.
+func (m *OutboxMutation) AddDeliveredAt(u int32) {
+	if m.adddelivered_at != nil {
+		*m.adddelivered_at += u
+	} else {
+		m.adddelivered_at = &u
+	}
 }
 
-// ObjectTypeCleared returns if the "object_type" field was cleared in this mutation.
-func (m *ReviewMutation) ObjectTypeCleared() bool {
-	_, ok := m.clearedFields[review.FieldObjectType]
+// AddedDeliveredAt returns the value that was added to the "delivered_at" field in this mutation.
+func (m *OutboxMutation) AddedDeliveredAt() (r int32, exists bool) {
+	v := m.adddelivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *OutboxMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.adddelivered_at = nil
+	m.clearedFields[outbox.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *OutboxMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldDeliveredAt]
 	return ok
 }
 
-// ResetObjectType resets all changes to the "object_type" field.
-func (m *ReviewMutation) ResetObjectType() {
-	m.object_type = nil
-	delete(m.clearedFields, review.FieldObjectType)
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *OutboxMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	m.adddelivered_at = nil
+	delete(m.clearedFields, outbox.FieldDeliveredAt)
 }
 
-// SetState sets the "state" field.
-func (m *ReviewMutation) SetState(s string) {
-	m.state = &s
+// SetLastError sets the "last_error" field.
+func (m *OutboxMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *ReviewMutation) State() (r string, exists bool) {
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outbox.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outbox.FieldLastError)
+}
+
+// Where appends a list predicates to the OutboxMutation builder.
+func (m *OutboxMutation) Where(ps ...predicate.Outbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OutboxMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Outbox).
+func (m *OutboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, outbox.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, outbox.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, outbox.FieldDeletedAt)
+	}
+	if m.routing_key != nil {
+		fields = append(fields, outbox.FieldRoutingKey)
+	}
+	if m.payload != nil {
+		fields = append(fields, outbox.FieldPayload)
+	}
+	if m.state != nil {
+		fields = append(fields, outbox.FieldState)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outbox.FieldAttempts)
+	}
+	if m.next_retry_at != nil {
+		fields = append(fields, outbox.FieldNextRetryAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, outbox.FieldDeliveredAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outbox.FieldLastError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outbox.FieldCreatedAt:
+		return m.CreatedAt()
+	case outbox.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case outbox.FieldDeletedAt:
+		return m.DeletedAt()
+	case outbox.FieldRoutingKey:
+		return m.RoutingKey()
+	case outbox.FieldPayload:
+		return m.Payload()
+	case outbox.FieldState:
+		return m.State()
+	case outbox.FieldAttempts:
+		return m.Attempts()
+	case outbox.FieldNextRetryAt:
+		return m.NextRetryAt()
+	case outbox.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case outbox.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outbox.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case outbox.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case outbox.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case outbox.FieldRoutingKey:
+		return m.OldRoutingKey(ctx)
+	case outbox.FieldPayload:
+		return m.OldPayload(ctx)
+	case outbox.FieldState:
+		return m.OldState(ctx)
+	case outbox.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outbox.FieldNextRetryAt:
+		return m.OldNextRetryAt(ctx)
+	case outbox.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case outbox.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outbox.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case outbox.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case outbox.FieldDeletedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case outbox.FieldRoutingKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingKey(v)
+		return nil
+	case outbox.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outbox.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case outbox.FieldAttempts:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outbox.FieldNextRetryAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryAt(v)
+		return nil
+	case outbox.FieldDeliveredAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case outbox.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, outbox.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, outbox.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, outbox.FieldDeletedAt)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, outbox.FieldAttempts)
+	}
+	if m.addnext_retry_at != nil {
+		fields = append(fields, outbox.FieldNextRetryAt)
+	}
+	if m.adddelivered_at != nil {
+		fields = append(fields, outbox.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outbox.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case outbox.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case outbox.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	case outbox.FieldAttempts:
+		return m.AddedAttempts()
+	case outbox.FieldNextRetryAt:
+		return m.AddedNextRetryAt()
+	case outbox.FieldDeliveredAt:
+		return m.AddedDeliveredAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outbox.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case outbox.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case outbox.FieldDeletedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	case outbox.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case outbox.FieldNextRetryAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextRetryAt(v)
+		return nil
+	case outbox.FieldDeliveredAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outbox.FieldRoutingKey) {
+		fields = append(fields, outbox.FieldRoutingKey)
+	}
+	if m.FieldCleared(outbox.FieldPayload) {
+		fields = append(fields, outbox.FieldPayload)
+	}
+	if m.FieldCleared(outbox.FieldState) {
+		fields = append(fields, outbox.FieldState)
+	}
+	if m.FieldCleared(outbox.FieldAttempts) {
+		fields = append(fields, outbox.FieldAttempts)
+	}
+	if m.FieldCleared(outbox.FieldNextRetryAt) {
+		fields = append(fields, outbox.FieldNextRetryAt)
+	}
+	if m.FieldCleared(outbox.FieldDeliveredAt) {
+		fields = append(fields, outbox.FieldDeliveredAt)
+	}
+	if m.FieldCleared(outbox.FieldLastError) {
+		fields = append(fields, outbox.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxMutation) ClearField(name string) error {
+	switch name {
+	case outbox.FieldRoutingKey:
+		m.ClearRoutingKey()
+		return nil
+	case outbox.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case outbox.FieldState:
+		m.ClearState()
+		return nil
+	case outbox.FieldAttempts:
+		m.ClearAttempts()
+		return nil
+	case outbox.FieldNextRetryAt:
+		m.ClearNextRetryAt()
+		return nil
+	case outbox.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case outbox.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxMutation) ResetField(name string) error {
+	switch name {
+	case outbox.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case outbox.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case outbox.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case outbox.FieldRoutingKey:
+		m.ResetRoutingKey()
+		return nil
+	case outbox.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outbox.FieldState:
+		m.ResetState()
+		return nil
+	case outbox.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outbox.FieldNextRetryAt:
+		m.ResetNextRetryAt()
+		return nil
+	case outbox.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case outbox.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Outbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Outbox edge %s", name)
+}
+
+// ReviewMutation represents an operation that mutates the Review nodes in the graph.
+type ReviewMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *uint32
+	addcreated_at       *int32
+	updated_at          *uint32
+	addupdated_at       *int32
+	deleted_at          *uint32
+	adddeleted_at       *int32
+	app_id              *uuid.UUID
+	reviewer_id         *uuid.UUID
+	domain              *string
+	object_id           *uuid.UUID
+	trigger             *string
+	object_type         *string
+	state               *string
+	message             *string
+	reasons             *[]string
+	lease_expires_at    *uint32
+	addlease_expires_at *int32
+	due_at              *uint32
+	adddue_at           *int32
+	escalated_at        *uint32
+	addescalated_at     *int32
+	version             *uint32
+	addversion          *int32
+	open_key            *string
+	clearedFields       map[string]struct{}
+	events              map[uuid.UUID]struct{}
+	removedevents       map[uuid.UUID]struct{}
+	clearedevents       bool
+	decisions           map[uuid.UUID]struct{}
+	removeddecisions    map[uuid.UUID]struct{}
+	cleareddecisions    bool
+	done                bool
+	oldValue            func(context.Context) (*Review, error)
+	predicates          []predicate.Review
+}
+
+var _ ent.Mutation = (*ReviewMutation)(nil)
+
+// reviewOption allows management of the mutation configuration using functional options.
+type reviewOption func(*ReviewMutation)
+
+// newReviewMutation creates new mutation for the Review entity.
+func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
+	m := &ReviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewID sets the ID field of the mutation.
+func withReviewID(id uuid.UUID) reviewOption {
+	return func(m *ReviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Review
+		)
+		m.oldValue = func(ctx context.Context) (*Review, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Review.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReview sets the old Review of the mutation.
+func withReview(node *Review) reviewOption {
+	return func(m *ReviewMutation) {
+		m.oldValue = func(context.Context) (*Review, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Review entities.
+func (m *ReviewMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *ReviewMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *ReviewMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ReviewMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewMutation) SetDeletedAt(u uint32) {
+	m.deleted_at = &u
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewMutation) DeletedAt() (r uint32, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (m *ReviewMutation) AddDeletedAt(u int32) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += u
+	} else {
+		m.adddeleted_at = &u
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ReviewMutation) AddedDeletedAt() (r int32, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *ReviewMutation) SetAppID(u uuid.UUID) {
+	m.app_id = &u
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *ReviewMutation) AppID() (r uuid.UUID, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *ReviewMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[review.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *ReviewMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[review.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *ReviewMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, review.FieldAppID)
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (m *ReviewMutation) SetReviewerID(u uuid.UUID) {
+	m.reviewer_id = &u
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *ReviewMutation) ReviewerID() (r uuid.UUID, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerID returns the old "reviewer_id" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldReviewerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (m *ReviewMutation) ClearReviewerID() {
+	m.reviewer_id = nil
+	m.clearedFields[review.FieldReviewerID] = struct{}{}
+}
+
+// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
+func (m *ReviewMutation) ReviewerIDCleared() bool {
+	_, ok := m.clearedFields[review.FieldReviewerID]
+	return ok
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *ReviewMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+	delete(m.clearedFields, review.FieldReviewerID)
+}
+
+// SetDomain sets the "domain" field.
+func (m *ReviewMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *ReviewMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (m *ReviewMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[review.FieldDomain] = struct{}{}
+}
+
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *ReviewMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[review.FieldDomain]
+	return ok
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *ReviewMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, review.FieldDomain)
+}
+
+// SetObjectID sets the "object_id" field.
+func (m *ReviewMutation) SetObjectID(u uuid.UUID) {
+	m.object_id = &u
+}
+
+// ObjectID returns the value of the "object_id" field in the mutation.
+func (m *ReviewMutation) ObjectID() (r uuid.UUID, exists bool) {
+	v := m.object_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectID returns the old "object_id" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldObjectID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectID: %w", err)
+	}
+	return oldValue.ObjectID, nil
+}
+
+// ClearObjectID clears the value of the "object_id" field.
+func (m *ReviewMutation) ClearObjectID() {
+	m.object_id = nil
+	m.clearedFields[review.FieldObjectID] = struct{}{}
+}
+
+// ObjectIDCleared returns if the "object_id" field was cleared in this mutation.
+func (m *ReviewMutation) ObjectIDCleared() bool {
+	_, ok := m.clearedFields[review.FieldObjectID]
+	return ok
+}
+
+// ResetObjectID resets all changes to the "object_id" field.
+func (m *ReviewMutation) ResetObjectID() {
+	m.object_id = nil
+	delete(m.clearedFields, review.FieldObjectID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *ReviewMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ReviewMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (m *ReviewMutation) ClearTrigger() {
+	m.trigger = nil
+	m.clearedFields[review.FieldTrigger] = struct{}{}
+}
+
+// TriggerCleared returns if the "trigger" field was cleared in this mutation.
+func (m *ReviewMutation) TriggerCleared() bool {
+	_, ok := m.clearedFields[review.FieldTrigger]
+	return ok
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ReviewMutation) ResetTrigger() {
+	m.trigger = nil
+	delete(m.clearedFields, review.FieldTrigger)
+}
+
+// SetObjectType sets the "object_type" field.
+func (m *ReviewMutation) SetObjectType(s string) {
+	m.object_type = &s
+}
+
+// ObjectType returns the value of the "object_type" field in the mutation.
+func (m *ReviewMutation) ObjectType() (r string, exists bool) {
+	v := m.object_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectType returns the old "object_type" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldObjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectType: %w", err)
+	}
+	return oldValue.ObjectType, nil
+}
+
+// ClearObjectType clears the value of the "object_type" field.
+func (m *ReviewMutation) ClearObjectType() {
+	m.object_type = nil
+	m.clearedFields[review.FieldObjectType] = struct{}{}
+}
+
+// ObjectTypeCleared returns if the "object_type" field was cleared in this mutation.
+func (m *ReviewMutation) ObjectTypeCleared() bool {
+	_, ok := m.clearedFields[review.FieldObjectType]
+	return ok
+}
+
+// ResetObjectType resets all changes to the "object_type" field.
+func (m *ReviewMutation) ResetObjectType() {
+	m.object_type = nil
+	delete(m.clearedFields, review.FieldObjectType)
+}
+
+// SetState sets the "state" field.
+func (m *ReviewMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *ReviewMutation) State() (r string, exists bool) {
 	v := m.state
 	if v == nil {
 		return
@@ -633,142 +1802,4736 @@ func (m *ReviewMutation) State() (r string, exists bool) {
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ClearState clears the value of the "state" field.
+func (m *ReviewMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[review.FieldState] = struct{}{}
+}
+
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *ReviewMutation) StateCleared() bool {
+	_, ok := m.clearedFields[review.FieldState]
+	return ok
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *ReviewMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, review.FieldState)
+}
+
+// SetMessage sets the "message" field.
+func (m *ReviewMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ReviewMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ReviewMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[review.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ReviewMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[review.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ReviewMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, review.FieldMessage)
+}
+
+// SetReasons sets the "reasons" field.
+func (m *ReviewMutation) SetReasons(s []string) {
+	m.reasons = &s
+}
+
+// Reasons returns the value of the "reasons" field in the mutation.
+func (m *ReviewMutation) Reasons() (r []string, exists bool) {
+	v := m.reasons
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReasons returns the old "reasons" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldReasons(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReasons is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReasons requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReasons: %w", err)
+	}
+	return oldValue.Reasons, nil
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (m *ReviewMutation) ClearReasons() {
+	m.reasons = nil
+	m.clearedFields[review.FieldReasons] = struct{}{}
+}
+
+// ReasonsCleared returns if the "reasons" field was cleared in this mutation.
+func (m *ReviewMutation) ReasonsCleared() bool {
+	_, ok := m.clearedFields[review.FieldReasons]
+	return ok
+}
+
+// ResetReasons resets all changes to the "reasons" field.
+func (m *ReviewMutation) ResetReasons() {
+	m.reasons = nil
+	delete(m.clearedFields, review.FieldReasons)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *ReviewMutation) SetLeaseExpiresAt(u uint32) {
+	m.lease_expires_at = &u
+	m.addlease_expires_at = nil
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *ReviewMutation) LeaseExpiresAt() (r uint32, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldLeaseExpiresAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// AddLeaseExpiresAt adds u to the "lease_expires_at" field.
+func (m *ReviewMutation) AddLeaseExpiresAt(u int32) {
+	if m.addlease_expires_at != nil {
+		*m.addlease_expires_at += u
+	} else {
+		m.addlease_expires_at = &u
+	}
+}
+
+// AddedLeaseExpiresAt returns the value that was added to the "lease_expires_at" field in this mutation.
+func (m *ReviewMutation) AddedLeaseExpiresAt() (r int32, exists bool) {
+	v := m.addlease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *ReviewMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.addlease_expires_at = nil
+	m.clearedFields[review.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *ReviewMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[review.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *ReviewMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.addlease_expires_at = nil
+	delete(m.clearedFields, review.FieldLeaseExpiresAt)
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *ReviewMutation) SetDueAt(u uint32) {
+	m.due_at = &u
+	m.adddue_at = nil
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *ReviewMutation) DueAt() (r uint32, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDueAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// AddDueAt adds u to the "due_at" field.
+func (m *ReviewMutation) AddDueAt(u int32) {
+	if m.adddue_at != nil {
+		*m.adddue_at += u
+	} else {
+		m.adddue_at = &u
+	}
+}
+
+// AddedDueAt returns the value that was added to the "due_at" field in this mutation.
+func (m *ReviewMutation) AddedDueAt() (r int32, exists bool) {
+	v := m.adddue_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *ReviewMutation) ClearDueAt() {
+	m.due_at = nil
+	m.adddue_at = nil
+	m.clearedFields[review.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *ReviewMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[review.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *ReviewMutation) ResetDueAt() {
+	m.due_at = nil
+	m.adddue_at = nil
+	delete(m.clearedFields, review.FieldDueAt)
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (m *ReviewMutation) SetEscalatedAt(u uint32) {
+	m.escalated_at = &u
+	m.addescalated_at = nil
+}
+
+// EscalatedAt returns the value of the "escalated_at" field in the mutation.
+func (m *ReviewMutation) EscalatedAt() (r uint32, exists bool) {
+	v := m.escalated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalatedAt returns the old "escalated_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldEscalatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalatedAt: %w", err)
+	}
+	return oldValue.EscalatedAt, nil
+}
+
+// AddEscalatedAt adds u to the "escalated_at" field.
+func (m *ReviewMutation) AddEscalatedAt(u int32) {
+	if m.addescalated_at != nil {
+		*m.addescalated_at += u
+	} else {
+		m.addescalated_at = &u
+	}
+}
+
+// AddedEscalatedAt returns the value that was added to the "escalated_at" field in this mutation.
+func (m *ReviewMutation) AddedEscalatedAt() (r int32, exists bool) {
+	v := m.addescalated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (m *ReviewMutation) ClearEscalatedAt() {
+	m.escalated_at = nil
+	m.addescalated_at = nil
+	m.clearedFields[review.FieldEscalatedAt] = struct{}{}
+}
+
+// EscalatedAtCleared returns if the "escalated_at" field was cleared in this mutation.
+func (m *ReviewMutation) EscalatedAtCleared() bool {
+	_, ok := m.clearedFields[review.FieldEscalatedAt]
+	return ok
+}
+
+// ResetEscalatedAt resets all changes to the "escalated_at" field.
+func (m *ReviewMutation) ResetEscalatedAt() {
+	m.escalated_at = nil
+	m.addescalated_at = nil
+	delete(m.clearedFields, review.FieldEscalatedAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *ReviewMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ReviewMutation) Version() (r uint32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldVersion(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *ReviewMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ReviewMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *ReviewMutation) ClearVersion() {
+	m.version = nil
+	m.addversion = nil
+	m.clearedFields[review.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *ReviewMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[review.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ReviewMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+	delete(m.clearedFields, review.FieldVersion)
+}
+
+// SetOpenKey sets the "open_key" field.
+func (m *ReviewMutation) SetOpenKey(s string) {
+	m.open_key = &s
+}
+
+// OpenKey returns the value of the "open_key" field in the mutation.
+func (m *ReviewMutation) OpenKey() (r string, exists bool) {
+	v := m.open_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenKey returns the old "open_key" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldOpenKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenKey: %w", err)
+	}
+	return oldValue.OpenKey, nil
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (m *ReviewMutation) ClearOpenKey() {
+	m.open_key = nil
+	m.clearedFields[review.FieldOpenKey] = struct{}{}
+}
+
+// OpenKeyCleared returns if the "open_key" field was cleared in this mutation.
+func (m *ReviewMutation) OpenKeyCleared() bool {
+	_, ok := m.clearedFields[review.FieldOpenKey]
+	return ok
+}
+
+// ResetOpenKey resets all changes to the "open_key" field.
+func (m *ReviewMutation) ResetOpenKey() {
+	m.open_key = nil
+	delete(m.clearedFields, review.FieldOpenKey)
+}
+
+// AddEventIDs adds the "events" edge to the ReviewEvent entity by ids.
+func (m *ReviewMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the ReviewEvent entity.
+func (m *ReviewMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the ReviewEvent entity was cleared.
+func (m *ReviewMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the ReviewEvent entity by IDs.
+func (m *ReviewMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the ReviewEvent entity.
+func (m *ReviewMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *ReviewMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *ReviewMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ReviewDecision entity by ids.
+func (m *ReviewMutation) AddDecisionIDs(ids ...uuid.UUID) {
+	if m.decisions == nil {
+		m.decisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDecisions clears the "decisions" edge to the ReviewDecision entity.
+func (m *ReviewMutation) ClearDecisions() {
+	m.cleareddecisions = true
+}
+
+// DecisionsCleared reports if the "decisions" edge to the ReviewDecision entity was cleared.
+func (m *ReviewMutation) DecisionsCleared() bool {
+	return m.cleareddecisions
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to the ReviewDecision entity by IDs.
+func (m *ReviewMutation) RemoveDecisionIDs(ids ...uuid.UUID) {
+	if m.removeddecisions == nil {
+		m.removeddecisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.decisions, ids[i])
+		m.removeddecisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDecisions returns the removed IDs of the "decisions" edge to the ReviewDecision entity.
+func (m *ReviewMutation) RemovedDecisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddecisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DecisionsIDs returns the "decisions" edge IDs in the mutation.
+func (m *ReviewMutation) DecisionsIDs() (ids []uuid.UUID) {
+	for id := range m.decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDecisions resets all changes to the "decisions" edge.
+func (m *ReviewMutation) ResetDecisions() {
+	m.decisions = nil
+	m.cleareddecisions = false
+	m.removeddecisions = nil
+}
+
+// Where appends a list predicates to the ReviewMutation builder.
+func (m *ReviewMutation) Where(ps ...predicate.Review) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReviewMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Review).
+func (m *ReviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.created_at != nil {
+		fields = append(fields, review.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, review.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	if m.app_id != nil {
+		fields = append(fields, review.FieldAppID)
+	}
+	if m.reviewer_id != nil {
+		fields = append(fields, review.FieldReviewerID)
+	}
+	if m.domain != nil {
+		fields = append(fields, review.FieldDomain)
+	}
+	if m.object_id != nil {
+		fields = append(fields, review.FieldObjectID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, review.FieldTrigger)
+	}
+	if m.object_type != nil {
+		fields = append(fields, review.FieldObjectType)
+	}
+	if m.state != nil {
+		fields = append(fields, review.FieldState)
+	}
+	if m.message != nil {
+		fields = append(fields, review.FieldMessage)
+	}
+	if m.reasons != nil {
+		fields = append(fields, review.FieldReasons)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, review.FieldLeaseExpiresAt)
+	}
+	if m.due_at != nil {
+		fields = append(fields, review.FieldDueAt)
+	}
+	if m.escalated_at != nil {
+		fields = append(fields, review.FieldEscalatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, review.FieldVersion)
+	}
+	if m.open_key != nil {
+		fields = append(fields, review.FieldOpenKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldCreatedAt:
+		return m.CreatedAt()
+	case review.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case review.FieldDeletedAt:
+		return m.DeletedAt()
+	case review.FieldAppID:
+		return m.AppID()
+	case review.FieldReviewerID:
+		return m.ReviewerID()
+	case review.FieldDomain:
+		return m.Domain()
+	case review.FieldObjectID:
+		return m.ObjectID()
+	case review.FieldTrigger:
+		return m.Trigger()
+	case review.FieldObjectType:
+		return m.ObjectType()
+	case review.FieldState:
+		return m.State()
+	case review.FieldMessage:
+		return m.Message()
+	case review.FieldReasons:
+		return m.Reasons()
+	case review.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	case review.FieldDueAt:
+		return m.DueAt()
+	case review.FieldEscalatedAt:
+		return m.EscalatedAt()
+	case review.FieldVersion:
+		return m.Version()
+	case review.FieldOpenKey:
+		return m.OpenKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case review.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case review.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case review.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case review.FieldAppID:
+		return m.OldAppID(ctx)
+	case review.FieldReviewerID:
+		return m.OldReviewerID(ctx)
+	case review.FieldDomain:
+		return m.OldDomain(ctx)
+	case review.FieldObjectID:
+		return m.OldObjectID(ctx)
+	case review.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case review.FieldObjectType:
+		return m.OldObjectType(ctx)
+	case review.FieldState:
+		return m.OldState(ctx)
+	case review.FieldMessage:
+		return m.OldMessage(ctx)
+	case review.FieldReasons:
+		return m.OldReasons(ctx)
+	case review.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	case review.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case review.FieldEscalatedAt:
+		return m.OldEscalatedAt(ctx)
+	case review.FieldVersion:
+		return m.OldVersion(ctx)
+	case review.FieldOpenKey:
+		return m.OldOpenKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Review field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case review.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case review.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case review.FieldDeletedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case review.FieldAppID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case review.FieldReviewerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerID(v)
+		return nil
+	case review.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case review.FieldObjectID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectID(v)
+		return nil
+	case review.FieldTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case review.FieldObjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectType(v)
+		return nil
+	case review.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case review.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case review.FieldReasons:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasons(v)
+		return nil
+	case review.FieldLeaseExpiresAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	case review.FieldDueAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case review.FieldEscalatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalatedAt(v)
+		return nil
+	case review.FieldVersion:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case review.FieldOpenKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, review.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, review.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	if m.addlease_expires_at != nil {
+		fields = append(fields, review.FieldLeaseExpiresAt)
+	}
+	if m.adddue_at != nil {
+		fields = append(fields, review.FieldDueAt)
+	}
+	if m.addescalated_at != nil {
+		fields = append(fields, review.FieldEscalatedAt)
+	}
+	if m.addversion != nil {
+		fields = append(fields, review.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case review.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case review.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	case review.FieldLeaseExpiresAt:
+		return m.AddedLeaseExpiresAt()
+	case review.FieldDueAt:
+		return m.AddedDueAt()
+	case review.FieldEscalatedAt:
+		return m.AddedEscalatedAt()
+	case review.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case review.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case review.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case review.FieldDeletedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	case review.FieldLeaseExpiresAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeaseExpiresAt(v)
+		return nil
+	case review.FieldDueAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDueAt(v)
+		return nil
+	case review.FieldEscalatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalatedAt(v)
+		return nil
+	case review.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Review numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(review.FieldAppID) {
+		fields = append(fields, review.FieldAppID)
+	}
+	if m.FieldCleared(review.FieldReviewerID) {
+		fields = append(fields, review.FieldReviewerID)
+	}
+	if m.FieldCleared(review.FieldDomain) {
+		fields = append(fields, review.FieldDomain)
+	}
+	if m.FieldCleared(review.FieldObjectID) {
+		fields = append(fields, review.FieldObjectID)
+	}
+	if m.FieldCleared(review.FieldTrigger) {
+		fields = append(fields, review.FieldTrigger)
+	}
+	if m.FieldCleared(review.FieldObjectType) {
+		fields = append(fields, review.FieldObjectType)
+	}
+	if m.FieldCleared(review.FieldState) {
+		fields = append(fields, review.FieldState)
+	}
+	if m.FieldCleared(review.FieldMessage) {
+		fields = append(fields, review.FieldMessage)
+	}
+	if m.FieldCleared(review.FieldReasons) {
+		fields = append(fields, review.FieldReasons)
+	}
+	if m.FieldCleared(review.FieldLeaseExpiresAt) {
+		fields = append(fields, review.FieldLeaseExpiresAt)
+	}
+	if m.FieldCleared(review.FieldDueAt) {
+		fields = append(fields, review.FieldDueAt)
+	}
+	if m.FieldCleared(review.FieldEscalatedAt) {
+		fields = append(fields, review.FieldEscalatedAt)
+	}
+	if m.FieldCleared(review.FieldVersion) {
+		fields = append(fields, review.FieldVersion)
+	}
+	if m.FieldCleared(review.FieldOpenKey) {
+		fields = append(fields, review.FieldOpenKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewMutation) ClearField(name string) error {
+	switch name {
+	case review.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case review.FieldReviewerID:
+		m.ClearReviewerID()
+		return nil
+	case review.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case review.FieldObjectID:
+		m.ClearObjectID()
+		return nil
+	case review.FieldTrigger:
+		m.ClearTrigger()
+		return nil
+	case review.FieldObjectType:
+		m.ClearObjectType()
+		return nil
+	case review.FieldState:
+		m.ClearState()
+		return nil
+	case review.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case review.FieldReasons:
+		m.ClearReasons()
+		return nil
+	case review.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	case review.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case review.FieldEscalatedAt:
+		m.ClearEscalatedAt()
+		return nil
+	case review.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case review.FieldOpenKey:
+		m.ClearOpenKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Review nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewMutation) ResetField(name string) error {
+	switch name {
+	case review.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case review.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case review.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case review.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case review.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
+	case review.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case review.FieldObjectID:
+		m.ResetObjectID()
+		return nil
+	case review.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case review.FieldObjectType:
+		m.ResetObjectType()
+		return nil
+	case review.FieldState:
+		m.ResetState()
+		return nil
+	case review.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case review.FieldReasons:
+		m.ResetReasons()
+		return nil
+	case review.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	case review.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case review.FieldEscalatedAt:
+		m.ResetEscalatedAt()
+		return nil
+	case review.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case review.FieldOpenKey:
+		m.ResetOpenKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.events != nil {
+		edges = append(edges, review.EdgeEvents)
+	}
+	if m.decisions != nil {
+		edges = append(edges, review.EdgeDecisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case review.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case review.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.decisions))
+		for id := range m.decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedevents != nil {
+		edges = append(edges, review.EdgeEvents)
+	}
+	if m.removeddecisions != nil {
+		edges = append(edges, review.EdgeDecisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case review.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case review.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.removeddecisions))
+		for id := range m.removeddecisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedevents {
+		edges = append(edges, review.EdgeEvents)
+	}
+	if m.cleareddecisions {
+		edges = append(edges, review.EdgeDecisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case review.EdgeEvents:
+		return m.clearedevents
+	case review.EdgeDecisions:
+		return m.cleareddecisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Review unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewMutation) ResetEdge(name string) error {
+	switch name {
+	case review.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case review.EdgeDecisions:
+		m.ResetDecisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Review edge %s", name)
+}
+
+// ReviewDecisionMutation represents an operation that mutates the ReviewDecision nodes in the graph.
+type ReviewDecisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *uint32
+	addcreated_at *int32
+	updated_at    *uint32
+	addupdated_at *int32
+	deleted_at    *uint32
+	adddeleted_at *int32
+	app_id        *uuid.UUID
+	reviewer_id   *uuid.UUID
+	decision      *string
+	message       *string
+	reasons       *[]string
+	clearedFields map[string]struct{}
+	review        *uuid.UUID
+	clearedreview bool
+	done          bool
+	oldValue      func(context.Context) (*ReviewDecision, error)
+	predicates    []predicate.ReviewDecision
+}
+
+var _ ent.Mutation = (*ReviewDecisionMutation)(nil)
+
+// reviewdecisionOption allows management of the mutation configuration using functional options.
+type reviewdecisionOption func(*ReviewDecisionMutation)
+
+// newReviewDecisionMutation creates new mutation for the ReviewDecision entity.
+func newReviewDecisionMutation(c config, op Op, opts ...reviewdecisionOption) *ReviewDecisionMutation {
+	m := &ReviewDecisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewDecision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewDecisionID sets the ID field of the mutation.
+func withReviewDecisionID(id uuid.UUID) reviewdecisionOption {
+	return func(m *ReviewDecisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewDecision
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewDecision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewDecision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewDecision sets the old ReviewDecision of the mutation.
+func withReviewDecision(node *ReviewDecision) reviewdecisionOption {
+	return func(m *ReviewDecisionMutation) {
+		m.oldValue = func(context.Context) (*ReviewDecision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewDecisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewDecisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReviewDecision entities.
+func (m *ReviewDecisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewDecisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewDecisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewDecision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewDecisionMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewDecisionMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *ReviewDecisionMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewDecisionMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewDecisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewDecisionMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewDecisionMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *ReviewDecisionMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ReviewDecisionMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewDecisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewDecisionMutation) SetDeletedAt(u uint32) {
+	m.deleted_at = &u
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewDecisionMutation) DeletedAt() (r uint32, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (m *ReviewDecisionMutation) AddDeletedAt(u int32) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += u
+	} else {
+		m.adddeleted_at = &u
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ReviewDecisionMutation) AddedDeletedAt() (r int32, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewDecisionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *ReviewDecisionMutation) SetAppID(u uuid.UUID) {
+	m.app_id = &u
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *ReviewDecisionMutation) AppID() (r uuid.UUID, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *ReviewDecisionMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[reviewdecision.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *ReviewDecisionMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[reviewdecision.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *ReviewDecisionMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, reviewdecision.FieldAppID)
+}
+
+// SetReviewID sets the "review_id" field.
+func (m *ReviewDecisionMutation) SetReviewID(u uuid.UUID) {
+	m.review = &u
+}
+
+// ReviewID returns the value of the "review_id" field in the mutation.
+func (m *ReviewDecisionMutation) ReviewID() (r uuid.UUID, exists bool) {
+	v := m.review
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewID returns the old "review_id" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldReviewID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewID: %w", err)
+	}
+	return oldValue.ReviewID, nil
+}
+
+// ResetReviewID resets all changes to the "review_id" field.
+func (m *ReviewDecisionMutation) ResetReviewID() {
+	m.review = nil
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (m *ReviewDecisionMutation) SetReviewerID(u uuid.UUID) {
+	m.reviewer_id = &u
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *ReviewDecisionMutation) ReviewerID() (r uuid.UUID, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerID returns the old "reviewer_id" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldReviewerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *ReviewDecisionMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+}
+
+// SetDecision sets the "decision" field.
+func (m *ReviewDecisionMutation) SetDecision(s string) {
+	m.decision = &s
+}
+
+// Decision returns the value of the "decision" field in the mutation.
+func (m *ReviewDecisionMutation) Decision() (r string, exists bool) {
+	v := m.decision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecision returns the old "decision" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldDecision(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecision: %w", err)
+	}
+	return oldValue.Decision, nil
+}
+
+// ResetDecision resets all changes to the "decision" field.
+func (m *ReviewDecisionMutation) ResetDecision() {
+	m.decision = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ReviewDecisionMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ReviewDecisionMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ReviewDecisionMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[reviewdecision.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ReviewDecisionMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[reviewdecision.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ReviewDecisionMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, reviewdecision.FieldMessage)
+}
+
+// SetReasons sets the "reasons" field.
+func (m *ReviewDecisionMutation) SetReasons(s []string) {
+	m.reasons = &s
+}
+
+// Reasons returns the value of the "reasons" field in the mutation.
+func (m *ReviewDecisionMutation) Reasons() (r []string, exists bool) {
+	v := m.reasons
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReasons returns the old "reasons" field's value of the ReviewDecision entity.
+// If the ReviewDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewDecisionMutation) OldReasons(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReasons is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReasons requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReasons: %w", err)
+	}
+	return oldValue.Reasons, nil
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (m *ReviewDecisionMutation) ClearReasons() {
+	m.reasons = nil
+	m.clearedFields[reviewdecision.FieldReasons] = struct{}{}
+}
+
+// ReasonsCleared returns if the "reasons" field was cleared in this mutation.
+func (m *ReviewDecisionMutation) ReasonsCleared() bool {
+	_, ok := m.clearedFields[reviewdecision.FieldReasons]
+	return ok
+}
+
+// ResetReasons resets all changes to the "reasons" field.
+func (m *ReviewDecisionMutation) ResetReasons() {
+	m.reasons = nil
+	delete(m.clearedFields, reviewdecision.FieldReasons)
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (m *ReviewDecisionMutation) ClearReview() {
+	m.clearedreview = true
+}
+
+// ReviewCleared reports if the "review" edge to the Review entity was cleared.
+func (m *ReviewDecisionMutation) ReviewCleared() bool {
+	return m.clearedreview
+}
+
+// ReviewIDs returns the "review" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReviewID instead. It exists only for internal usage by the builders.
+func (m *ReviewDecisionMutation) ReviewIDs() (ids []uuid.UUID) {
+	if id := m.review; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReview resets all changes to the "review" edge.
+func (m *ReviewDecisionMutation) ResetReview() {
+	m.review = nil
+	m.clearedreview = false
+}
+
+// Where appends a list predicates to the ReviewDecisionMutation builder.
+func (m *ReviewDecisionMutation) Where(ps ...predicate.ReviewDecision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReviewDecisionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ReviewDecision).
+func (m *ReviewDecisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewDecisionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, reviewdecision.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, reviewdecision.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, reviewdecision.FieldDeletedAt)
+	}
+	if m.app_id != nil {
+		fields = append(fields, reviewdecision.FieldAppID)
+	}
+	if m.review != nil {
+		fields = append(fields, reviewdecision.FieldReviewID)
+	}
+	if m.reviewer_id != nil {
+		fields = append(fields, reviewdecision.FieldReviewerID)
+	}
+	if m.decision != nil {
+		fields = append(fields, reviewdecision.FieldDecision)
+	}
+	if m.message != nil {
+		fields = append(fields, reviewdecision.FieldMessage)
+	}
+	if m.reasons != nil {
+		fields = append(fields, reviewdecision.FieldReasons)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewDecisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		return m.CreatedAt()
+	case reviewdecision.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case reviewdecision.FieldDeletedAt:
+		return m.DeletedAt()
+	case reviewdecision.FieldAppID:
+		return m.AppID()
+	case reviewdecision.FieldReviewID:
+		return m.ReviewID()
+	case reviewdecision.FieldReviewerID:
+		return m.ReviewerID()
+	case reviewdecision.FieldDecision:
+		return m.Decision()
+	case reviewdecision.FieldMessage:
+		return m.Message()
+	case reviewdecision.FieldReasons:
+		return m.Reasons()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewDecisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reviewdecision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case reviewdecision.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case reviewdecision.FieldAppID:
+		return m.OldAppID(ctx)
+	case reviewdecision.FieldReviewID:
+		return m.OldReviewID(ctx)
+	case reviewdecision.FieldReviewerID:
+		return m.OldReviewerID(ctx)
+	case reviewdecision.FieldDecision:
+		return m.OldDecision(ctx)
+	case reviewdecision.FieldMessage:
+		return m.OldMessage(ctx)
+	case reviewdecision.FieldReasons:
+		return m.OldReasons(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReviewDecision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewDecisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case reviewdecision.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case reviewdecision.FieldDeletedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case reviewdecision.FieldAppID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case reviewdecision.FieldReviewID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewID(v)
+		return nil
+	case reviewdecision.FieldReviewerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerID(v)
+		return nil
+	case reviewdecision.FieldDecision:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecision(v)
+		return nil
+	case reviewdecision.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case reviewdecision.FieldReasons:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasons(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewDecisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, reviewdecision.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, reviewdecision.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, reviewdecision.FieldDeletedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewDecisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case reviewdecision.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case reviewdecision.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewDecisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case reviewdecision.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case reviewdecision.FieldDeletedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewDecisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reviewdecision.FieldAppID) {
+		fields = append(fields, reviewdecision.FieldAppID)
+	}
+	if m.FieldCleared(reviewdecision.FieldMessage) {
+		fields = append(fields, reviewdecision.FieldMessage)
+	}
+	if m.FieldCleared(reviewdecision.FieldReasons) {
+		fields = append(fields, reviewdecision.FieldReasons)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewDecisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewDecisionMutation) ClearField(name string) error {
+	switch name {
+	case reviewdecision.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case reviewdecision.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case reviewdecision.FieldReasons:
+		m.ClearReasons()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewDecisionMutation) ResetField(name string) error {
+	switch name {
+	case reviewdecision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case reviewdecision.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case reviewdecision.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case reviewdecision.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case reviewdecision.FieldReviewID:
+		m.ResetReviewID()
+		return nil
+	case reviewdecision.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
+	case reviewdecision.FieldDecision:
+		m.ResetDecision()
+		return nil
+	case reviewdecision.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case reviewdecision.FieldReasons:
+		m.ResetReasons()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewDecisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.review != nil {
+		edges = append(edges, reviewdecision.EdgeReview)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewDecisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reviewdecision.EdgeReview:
+		if id := m.review; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewDecisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewDecisionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewDecisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreview {
+		edges = append(edges, reviewdecision.EdgeReview)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewDecisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reviewdecision.EdgeReview:
+		return m.clearedreview
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewDecisionMutation) ClearEdge(name string) error {
+	switch name {
+	case reviewdecision.EdgeReview:
+		m.ClearReview()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewDecisionMutation) ResetEdge(name string) error {
+	switch name {
+	case reviewdecision.EdgeReview:
+		m.ResetReview()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewDecision edge %s", name)
+}
+
+// ReviewEventMutation represents an operation that mutates the ReviewEvent nodes in the graph.
+type ReviewEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *uint32
+	addcreated_at *int32
+	updated_at    *uint32
+	addupdated_at *int32
+	deleted_at    *uint32
+	adddeleted_at *int32
+	app_id        *uuid.UUID
+	actor_id      *uuid.UUID
+	event         *string
+	old_value     *string
+	new_value     *string
+	clearedFields map[string]struct{}
+	review        *uuid.UUID
+	clearedreview bool
+	done          bool
+	oldValue      func(context.Context) (*ReviewEvent, error)
+	predicates    []predicate.ReviewEvent
+}
+
+var _ ent.Mutation = (*ReviewEventMutation)(nil)
+
+// revieweventOption allows management of the mutation configuration using functional options.
+type revieweventOption func(*ReviewEventMutation)
+
+// newReviewEventMutation creates new mutation for the ReviewEvent entity.
+func newReviewEventMutation(c config, op Op, opts ...revieweventOption) *ReviewEventMutation {
+	m := &ReviewEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewEventID sets the ID field of the mutation.
+func withReviewEventID(id uuid.UUID) revieweventOption {
+	return func(m *ReviewEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewEvent sets the old ReviewEvent of the mutation.
+func withReviewEvent(node *ReviewEvent) revieweventOption {
+	return func(m *ReviewEventMutation) {
+		m.oldValue = func(context.Context) (*ReviewEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReviewEvent entities.
+func (m *ReviewEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewEventMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewEventMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *ReviewEventMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewEventMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewEventMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewEventMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *ReviewEventMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ReviewEventMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewEventMutation) SetDeletedAt(u uint32) {
+	m.deleted_at = &u
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewEventMutation) DeletedAt() (r uint32, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (m *ReviewEventMutation) AddDeletedAt(u int32) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += u
+	} else {
+		m.adddeleted_at = &u
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ReviewEventMutation) AddedDeletedAt() (r int32, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewEventMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *ReviewEventMutation) SetAppID(u uuid.UUID) {
+	m.app_id = &u
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *ReviewEventMutation) AppID() (r uuid.UUID, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *ReviewEventMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[reviewevent.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *ReviewEventMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[reviewevent.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *ReviewEventMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, reviewevent.FieldAppID)
+}
+
+// SetReviewID sets the "review_id" field.
+func (m *ReviewEventMutation) SetReviewID(u uuid.UUID) {
+	m.review = &u
+}
+
+// ReviewID returns the value of the "review_id" field in the mutation.
+func (m *ReviewEventMutation) ReviewID() (r uuid.UUID, exists bool) {
+	v := m.review
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewID returns the old "review_id" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldReviewID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewID: %w", err)
+	}
+	return oldValue.ReviewID, nil
+}
+
+// ResetReviewID resets all changes to the "review_id" field.
+func (m *ReviewEventMutation) ResetReviewID() {
+	m.review = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *ReviewEventMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *ReviewEventMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldActorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *ReviewEventMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[reviewevent.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *ReviewEventMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[reviewevent.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *ReviewEventMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, reviewevent.FieldActorID)
+}
+
+// SetEvent sets the "event" field.
+func (m *ReviewEventMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *ReviewEventMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ClearEvent clears the value of the "event" field.
+func (m *ReviewEventMutation) ClearEvent() {
+	m.event = nil
+	m.clearedFields[reviewevent.FieldEvent] = struct{}{}
+}
+
+// EventCleared returns if the "event" field was cleared in this mutation.
+func (m *ReviewEventMutation) EventCleared() bool {
+	_, ok := m.clearedFields[reviewevent.FieldEvent]
+	return ok
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *ReviewEventMutation) ResetEvent() {
+	m.event = nil
+	delete(m.clearedFields, reviewevent.FieldEvent)
+}
+
+// SetOldValue sets the "old_value" field.
+func (m *ReviewEventMutation) SetOldValue(s string) {
+	m.old_value = &s
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *ReviewEventMutation) OldValue() (r string, exists bool) {
+	v := m.old_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValue returns the old "old_value" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldOldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
+	}
+	return oldValue.OldValue, nil
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (m *ReviewEventMutation) ClearOldValue() {
+	m.old_value = nil
+	m.clearedFields[reviewevent.FieldOldValue] = struct{}{}
+}
+
+// OldValueCleared returns if the "old_value" field was cleared in this mutation.
+func (m *ReviewEventMutation) OldValueCleared() bool {
+	_, ok := m.clearedFields[reviewevent.FieldOldValue]
+	return ok
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *ReviewEventMutation) ResetOldValue() {
+	m.old_value = nil
+	delete(m.clearedFields, reviewevent.FieldOldValue)
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *ReviewEventMutation) SetNewValue(s string) {
+	m.new_value = &s
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *ReviewEventMutation) NewValue() (r string, exists bool) {
+	v := m.new_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewValue returns the old "new_value" field's value of the ReviewEvent entity.
+// If the ReviewEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEventMutation) OldNewValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
+	}
+	return oldValue.NewValue, nil
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (m *ReviewEventMutation) ClearNewValue() {
+	m.new_value = nil
+	m.clearedFields[reviewevent.FieldNewValue] = struct{}{}
+}
+
+// NewValueCleared returns if the "new_value" field was cleared in this mutation.
+func (m *ReviewEventMutation) NewValueCleared() bool {
+	_, ok := m.clearedFields[reviewevent.FieldNewValue]
+	return ok
+}
+
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *ReviewEventMutation) ResetNewValue() {
+	m.new_value = nil
+	delete(m.clearedFields, reviewevent.FieldNewValue)
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (m *ReviewEventMutation) ClearReview() {
+	m.clearedreview = true
+}
+
+// ReviewCleared reports if the "review" edge to the Review entity was cleared.
+func (m *ReviewEventMutation) ReviewCleared() bool {
+	return m.clearedreview
+}
+
+// ReviewIDs returns the "review" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReviewID instead. It exists only for internal usage by the builders.
+func (m *ReviewEventMutation) ReviewIDs() (ids []uuid.UUID) {
+	if id := m.review; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReview resets all changes to the "review" edge.
+func (m *ReviewEventMutation) ResetReview() {
+	m.review = nil
+	m.clearedreview = false
+}
+
+// Where appends a list predicates to the ReviewEventMutation builder.
+func (m *ReviewEventMutation) Where(ps ...predicate.ReviewEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReviewEventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ReviewEvent).
+func (m *ReviewEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, reviewevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, reviewevent.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, reviewevent.FieldDeletedAt)
+	}
+	if m.app_id != nil {
+		fields = append(fields, reviewevent.FieldAppID)
+	}
+	if m.review != nil {
+		fields = append(fields, reviewevent.FieldReviewID)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, reviewevent.FieldActorID)
+	}
+	if m.event != nil {
+		fields = append(fields, reviewevent.FieldEvent)
+	}
+	if m.old_value != nil {
+		fields = append(fields, reviewevent.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, reviewevent.FieldNewValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case reviewevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case reviewevent.FieldDeletedAt:
+		return m.DeletedAt()
+	case reviewevent.FieldAppID:
+		return m.AppID()
+	case reviewevent.FieldReviewID:
+		return m.ReviewID()
+	case reviewevent.FieldActorID:
+		return m.ActorID()
+	case reviewevent.FieldEvent:
+		return m.Event()
+	case reviewevent.FieldOldValue:
+		return m.OldValue()
+	case reviewevent.FieldNewValue:
+		return m.NewValue()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reviewevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case reviewevent.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case reviewevent.FieldAppID:
+		return m.OldAppID(ctx)
+	case reviewevent.FieldReviewID:
+		return m.OldReviewID(ctx)
+	case reviewevent.FieldActorID:
+		return m.OldActorID(ctx)
+	case reviewevent.FieldEvent:
+		return m.OldEvent(ctx)
+	case reviewevent.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case reviewevent.FieldNewValue:
+		return m.OldNewValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReviewEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case reviewevent.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case reviewevent.FieldDeletedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case reviewevent.FieldAppID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case reviewevent.FieldReviewID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewID(v)
+		return nil
+	case reviewevent.FieldActorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case reviewevent.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case reviewevent.FieldOldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case reviewevent.FieldNewValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, reviewevent.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, reviewevent.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, reviewevent.FieldDeletedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case reviewevent.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case reviewevent.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case reviewevent.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case reviewevent.FieldDeletedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reviewevent.FieldAppID) {
+		fields = append(fields, reviewevent.FieldAppID)
+	}
+	if m.FieldCleared(reviewevent.FieldActorID) {
+		fields = append(fields, reviewevent.FieldActorID)
+	}
+	if m.FieldCleared(reviewevent.FieldEvent) {
+		fields = append(fields, reviewevent.FieldEvent)
+	}
+	if m.FieldCleared(reviewevent.FieldOldValue) {
+		fields = append(fields, reviewevent.FieldOldValue)
+	}
+	if m.FieldCleared(reviewevent.FieldNewValue) {
+		fields = append(fields, reviewevent.FieldNewValue)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewEventMutation) ClearField(name string) error {
+	switch name {
+	case reviewevent.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case reviewevent.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case reviewevent.FieldEvent:
+		m.ClearEvent()
+		return nil
+	case reviewevent.FieldOldValue:
+		m.ClearOldValue()
+		return nil
+	case reviewevent.FieldNewValue:
+		m.ClearNewValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewEventMutation) ResetField(name string) error {
+	switch name {
+	case reviewevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case reviewevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case reviewevent.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case reviewevent.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case reviewevent.FieldReviewID:
+		m.ResetReviewID()
+		return nil
+	case reviewevent.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case reviewevent.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case reviewevent.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case reviewevent.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.review != nil {
+		edges = append(edges, reviewevent.EdgeReview)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reviewevent.EdgeReview:
+		if id := m.review; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreview {
+		edges = append(edges, reviewevent.EdgeReview)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reviewevent.EdgeReview:
+		return m.clearedreview
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewEventMutation) ClearEdge(name string) error {
+	switch name {
+	case reviewevent.EdgeReview:
+		m.ClearReview()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewEventMutation) ResetEdge(name string) error {
+	switch name {
+	case reviewevent.EdgeReview:
+		m.ResetReview()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewEvent edge %s", name)
+}
+
+// ReviewerPoolMutation represents an operation that mutates the ReviewerPool nodes in the graph.
+type ReviewerPoolMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *uint32
+	addcreated_at  *int32
+	updated_at     *uint32
+	addupdated_at  *int32
+	deleted_at     *uint32
+	adddeleted_at  *int32
+	app_id         *uuid.UUID
+	domain         *string
+	object_type    *string
+	strategy       *string
+	cursor         *uint32
+	addcursor      *int32
+	clearedFields  map[string]struct{}
+	members        map[uuid.UUID]struct{}
+	removedmembers map[uuid.UUID]struct{}
+	clearedmembers bool
+	done           bool
+	oldValue       func(context.Context) (*ReviewerPool, error)
+	predicates     []predicate.ReviewerPool
+}
+
+var _ ent.Mutation = (*ReviewerPoolMutation)(nil)
+
+// reviewerpoolOption allows management of the mutation configuration using functional options.
+type reviewerpoolOption func(*ReviewerPoolMutation)
+
+// newReviewerPoolMutation creates new mutation for the ReviewerPool entity.
+func newReviewerPoolMutation(c config, op Op, opts ...reviewerpoolOption) *ReviewerPoolMutation {
+	m := &ReviewerPoolMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewerPool,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewerPoolID sets the ID field of the mutation.
+func withReviewerPoolID(id uuid.UUID) reviewerpoolOption {
+	return func(m *ReviewerPoolMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewerPool
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewerPool, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewerPool.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewerPool sets the old ReviewerPool of the mutation.
+func withReviewerPool(node *ReviewerPool) reviewerpoolOption {
+	return func(m *ReviewerPoolMutation) {
+		m.oldValue = func(context.Context) (*ReviewerPool, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewerPoolMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewerPoolMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReviewerPool entities.
+func (m *ReviewerPoolMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewerPoolMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewerPoolMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewerPool.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewerPoolMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewerPoolMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *ReviewerPoolMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewerPoolMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewerPoolMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewerPoolMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewerPoolMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *ReviewerPoolMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ReviewerPoolMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewerPoolMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewerPoolMutation) SetDeletedAt(u uint32) {
+	m.deleted_at = &u
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewerPoolMutation) DeletedAt() (r uint32, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (m *ReviewerPoolMutation) AddDeletedAt(u int32) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += u
+	} else {
+		m.adddeleted_at = &u
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ReviewerPoolMutation) AddedDeletedAt() (r int32, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewerPoolMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *ReviewerPoolMutation) SetAppID(u uuid.UUID) {
+	m.app_id = &u
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *ReviewerPoolMutation) AppID() (r uuid.UUID, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *ReviewerPoolMutation) ResetAppID() {
+	m.app_id = nil
+}
+
+// SetDomain sets the "domain" field.
+func (m *ReviewerPoolMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *ReviewerPoolMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *ReviewerPoolMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// SetObjectType sets the "object_type" field.
+func (m *ReviewerPoolMutation) SetObjectType(s string) {
+	m.object_type = &s
+}
+
+// ObjectType returns the value of the "object_type" field in the mutation.
+func (m *ReviewerPoolMutation) ObjectType() (r string, exists bool) {
+	v := m.object_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectType returns the old "object_type" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldObjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectType: %w", err)
+	}
+	return oldValue.ObjectType, nil
+}
+
+// ResetObjectType resets all changes to the "object_type" field.
+func (m *ReviewerPoolMutation) ResetObjectType() {
+	m.object_type = nil
+}
+
+// SetStrategy sets the "strategy" field.
+func (m *ReviewerPoolMutation) SetStrategy(s string) {
+	m.strategy = &s
+}
+
+// Strategy returns the value of the "strategy" field in the mutation.
+func (m *ReviewerPoolMutation) Strategy() (r string, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategy returns the old "strategy" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldStrategy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategy: %w", err)
+	}
+	return oldValue.Strategy, nil
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (m *ReviewerPoolMutation) ClearStrategy() {
+	m.strategy = nil
+	m.clearedFields[reviewerpool.FieldStrategy] = struct{}{}
+}
+
+// StrategyCleared returns if the "strategy" field was cleared in this mutation.
+func (m *ReviewerPoolMutation) StrategyCleared() bool {
+	_, ok := m.clearedFields[reviewerpool.FieldStrategy]
+	return ok
+}
+
+// ResetStrategy resets all changes to the "strategy" field.
+func (m *ReviewerPoolMutation) ResetStrategy() {
+	m.strategy = nil
+	delete(m.clearedFields, reviewerpool.FieldStrategy)
+}
+
+// SetCursor sets the "cursor" field.
+func (m *ReviewerPoolMutation) SetCursor(u uint32) {
+	m.cursor = &u
+	m.addcursor = nil
+}
+
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *ReviewerPoolMutation) Cursor() (r uint32, exists bool) {
+	v := m.cursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursor returns the old "cursor" field's value of the ReviewerPool entity.
+// If the ReviewerPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMutation) OldCursor(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
+	}
+	return oldValue.Cursor, nil
+}
+
+// AddCursor adds u to the "cursor" field.
+func (m *ReviewerPoolMutation) AddCursor(u int32) {
+	if m.addcursor != nil {
+		*m.addcursor += u
+	} else {
+		m.addcursor = &u
+	}
+}
+
+// AddedCursor returns the value that was added to the "cursor" field in this mutation.
+func (m *ReviewerPoolMutation) AddedCursor() (r int32, exists bool) {
+	v := m.addcursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (m *ReviewerPoolMutation) ClearCursor() {
+	m.cursor = nil
+	m.addcursor = nil
+	m.clearedFields[reviewerpool.FieldCursor] = struct{}{}
+}
+
+// CursorCleared returns if the "cursor" field was cleared in this mutation.
+func (m *ReviewerPoolMutation) CursorCleared() bool {
+	_, ok := m.clearedFields[reviewerpool.FieldCursor]
+	return ok
+}
+
+// ResetCursor resets all changes to the "cursor" field.
+func (m *ReviewerPoolMutation) ResetCursor() {
+	m.cursor = nil
+	m.addcursor = nil
+	delete(m.clearedFields, reviewerpool.FieldCursor)
+}
+
+// AddMemberIDs adds the "members" edge to the ReviewerPoolMember entity by ids.
+func (m *ReviewerPoolMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the ReviewerPoolMember entity.
+func (m *ReviewerPoolMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the ReviewerPoolMember entity was cleared.
+func (m *ReviewerPoolMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the ReviewerPoolMember entity by IDs.
+func (m *ReviewerPoolMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the ReviewerPoolMember entity.
+func (m *ReviewerPoolMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *ReviewerPoolMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *ReviewerPoolMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// Where appends a list predicates to the ReviewerPoolMutation builder.
+func (m *ReviewerPoolMutation) Where(ps ...predicate.ReviewerPool) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReviewerPoolMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ReviewerPool).
+func (m *ReviewerPoolMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewerPoolMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, reviewerpool.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, reviewerpool.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, reviewerpool.FieldDeletedAt)
+	}
+	if m.app_id != nil {
+		fields = append(fields, reviewerpool.FieldAppID)
+	}
+	if m.domain != nil {
+		fields = append(fields, reviewerpool.FieldDomain)
+	}
+	if m.object_type != nil {
+		fields = append(fields, reviewerpool.FieldObjectType)
+	}
+	if m.strategy != nil {
+		fields = append(fields, reviewerpool.FieldStrategy)
+	}
+	if m.cursor != nil {
+		fields = append(fields, reviewerpool.FieldCursor)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewerPoolMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		return m.CreatedAt()
+	case reviewerpool.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case reviewerpool.FieldDeletedAt:
+		return m.DeletedAt()
+	case reviewerpool.FieldAppID:
+		return m.AppID()
+	case reviewerpool.FieldDomain:
+		return m.Domain()
+	case reviewerpool.FieldObjectType:
+		return m.ObjectType()
+	case reviewerpool.FieldStrategy:
+		return m.Strategy()
+	case reviewerpool.FieldCursor:
+		return m.Cursor()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewerPoolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reviewerpool.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case reviewerpool.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case reviewerpool.FieldAppID:
+		return m.OldAppID(ctx)
+	case reviewerpool.FieldDomain:
+		return m.OldDomain(ctx)
+	case reviewerpool.FieldObjectType:
+		return m.OldObjectType(ctx)
+	case reviewerpool.FieldStrategy:
+		return m.OldStrategy(ctx)
+	case reviewerpool.FieldCursor:
+		return m.OldCursor(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReviewerPool field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewerPoolMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case reviewerpool.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case reviewerpool.FieldDeletedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case reviewerpool.FieldAppID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case reviewerpool.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case reviewerpool.FieldObjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectType(v)
+		return nil
+	case reviewerpool.FieldStrategy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategy(v)
+		return nil
+	case reviewerpool.FieldCursor:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPool field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewerPoolMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, reviewerpool.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, reviewerpool.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, reviewerpool.FieldDeletedAt)
+	}
+	if m.addcursor != nil {
+		fields = append(fields, reviewerpool.FieldCursor)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewerPoolMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case reviewerpool.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case reviewerpool.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	case reviewerpool.FieldCursor:
+		return m.AddedCursor()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewerPoolMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case reviewerpool.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case reviewerpool.FieldDeletedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	case reviewerpool.FieldCursor:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCursor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPool numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewerPoolMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reviewerpool.FieldStrategy) {
+		fields = append(fields, reviewerpool.FieldStrategy)
+	}
+	if m.FieldCleared(reviewerpool.FieldCursor) {
+		fields = append(fields, reviewerpool.FieldCursor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewerPoolMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewerPoolMutation) ClearField(name string) error {
+	switch name {
+	case reviewerpool.FieldStrategy:
+		m.ClearStrategy()
+		return nil
+	case reviewerpool.FieldCursor:
+		m.ClearCursor()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPool nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewerPoolMutation) ResetField(name string) error {
+	switch name {
+	case reviewerpool.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case reviewerpool.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case reviewerpool.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case reviewerpool.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case reviewerpool.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case reviewerpool.FieldObjectType:
+		m.ResetObjectType()
+		return nil
+	case reviewerpool.FieldStrategy:
+		m.ResetStrategy()
+		return nil
+	case reviewerpool.FieldCursor:
+		m.ResetCursor()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPool field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewerPoolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.members != nil {
+		edges = append(edges, reviewerpool.EdgeMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewerPoolMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reviewerpool.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewerPoolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmembers != nil {
+		edges = append(edges, reviewerpool.EdgeMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewerPoolMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case reviewerpool.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewerPoolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmembers {
+		edges = append(edges, reviewerpool.EdgeMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewerPoolMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reviewerpool.EdgeMembers:
+		return m.clearedmembers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewerPoolMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ReviewerPool unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewerPoolMutation) ResetEdge(name string) error {
+	switch name {
+	case reviewerpool.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPool edge %s", name)
+}
+
+// ReviewerPoolMemberMutation represents an operation that mutates the ReviewerPoolMember nodes in the graph.
+type ReviewerPoolMemberMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *uint32
+	addcreated_at *int32
+	updated_at    *uint32
+	addupdated_at *int32
+	deleted_at    *uint32
+	adddeleted_at *int32
+	reviewer_id   *uuid.UUID
+	weight        *uint32
+	addweight     *int32
+	on_shift      *bool
+	clearedFields map[string]struct{}
+	pool          *uuid.UUID
+	clearedpool   bool
+	done          bool
+	oldValue      func(context.Context) (*ReviewerPoolMember, error)
+	predicates    []predicate.ReviewerPoolMember
+}
+
+var _ ent.Mutation = (*ReviewerPoolMemberMutation)(nil)
+
+// reviewerpoolmemberOption allows management of the mutation configuration using functional options.
+type reviewerpoolmemberOption func(*ReviewerPoolMemberMutation)
+
+// newReviewerPoolMemberMutation creates new mutation for the ReviewerPoolMember entity.
+func newReviewerPoolMemberMutation(c config, op Op, opts ...reviewerpoolmemberOption) *ReviewerPoolMemberMutation {
+	m := &ReviewerPoolMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewerPoolMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewerPoolMemberID sets the ID field of the mutation.
+func withReviewerPoolMemberID(id uuid.UUID) reviewerpoolmemberOption {
+	return func(m *ReviewerPoolMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewerPoolMember
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewerPoolMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewerPoolMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewerPoolMember sets the old ReviewerPoolMember of the mutation.
+func withReviewerPoolMember(node *ReviewerPoolMember) reviewerpoolmemberOption {
+	return func(m *ReviewerPoolMemberMutation) {
+		m.oldValue = func(context.Context) (*ReviewerPoolMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewerPoolMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewerPoolMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReviewerPoolMember entities.
+func (m *ReviewerPoolMemberMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewerPoolMemberMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewerPoolMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewerPoolMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewerPoolMemberMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewerPoolMemberMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *ReviewerPoolMemberMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearState clears the value of the "state" field.
-func (m *ReviewMutation) ClearState() {
-	m.state = nil
-	m.clearedFields[review.FieldState] = struct{}{}
+// AddCreatedAt adds u to the "created_at" field.
+func (m *ReviewerPoolMemberMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
 }
 
-// StateCleared returns if the "state" field was cleared in this mutation.
-func (m *ReviewMutation) StateCleared() bool {
-	_, ok := m.clearedFields[review.FieldState]
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewerPoolMemberMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewerPoolMemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewerPoolMemberMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewerPoolMemberMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMemberMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *ReviewerPoolMemberMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ReviewerPoolMemberMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewerPoolMemberMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewerPoolMemberMutation) SetDeletedAt(u uint32) {
+	m.deleted_at = &u
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewerPoolMemberMutation) DeletedAt() (r uint32, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMemberMutation) OldDeletedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (m *ReviewerPoolMemberMutation) AddDeletedAt(u int32) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += u
+	} else {
+		m.adddeleted_at = &u
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ReviewerPoolMemberMutation) AddedDeletedAt() (r int32, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewerPoolMemberMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetPoolID sets the "pool_id" field.
+func (m *ReviewerPoolMemberMutation) SetPoolID(u uuid.UUID) {
+	m.pool = &u
+}
+
+// PoolID returns the value of the "pool_id" field in the mutation.
+func (m *ReviewerPoolMemberMutation) PoolID() (r uuid.UUID, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoolID returns the old "pool_id" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMemberMutation) OldPoolID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoolID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoolID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoolID: %w", err)
+	}
+	return oldValue.PoolID, nil
+}
+
+// ResetPoolID resets all changes to the "pool_id" field.
+func (m *ReviewerPoolMemberMutation) ResetPoolID() {
+	m.pool = nil
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (m *ReviewerPoolMemberMutation) SetReviewerID(u uuid.UUID) {
+	m.reviewer_id = &u
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *ReviewerPoolMemberMutation) ReviewerID() (r uuid.UUID, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerID returns the old "reviewer_id" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMemberMutation) OldReviewerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *ReviewerPoolMemberMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *ReviewerPoolMemberMutation) SetWeight(u uint32) {
+	m.weight = &u
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ReviewerPoolMemberMutation) Weight() (r uint32, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerPoolMemberMutation) OldWeight(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds u to the "weight" field.
+func (m *ReviewerPoolMemberMutation) AddWeight(u int32) {
+	if m.addweight != nil {
+		*m.addweight += u
+	} else {
+		m.addweight = &u
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ReviewerPoolMemberMutation) AddedWeight() (r int32, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (m *ReviewerPoolMemberMutation) ClearWeight() {
+	m.weight = nil
+	m.addweight = nil
+	m.clearedFields[reviewerpoolmember.FieldWeight] = struct{}{}
+}
+
+// WeightCleared returns if the "weight" field was cleared in this mutation.
+func (m *ReviewerPoolMemberMutation) WeightCleared() bool {
+	_, ok := m.clearedFields[reviewerpoolmember.FieldWeight]
 	return ok
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *ReviewMutation) ResetState() {
-	m.state = nil
-	delete(m.clearedFields, review.FieldState)
+// ResetWeight resets all changes to the "weight" field.
+func (m *ReviewerPoolMemberMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+	delete(m.clearedFields, reviewerpoolmember.FieldWeight)
 }
 
-// SetMessage sets the "message" field.
-func (m *ReviewMutation) SetMessage(s string) {
-	m.message = &s
+// SetOnShift sets the "on_shift" field.
+func (m *ReviewerPoolMemberMutation) SetOnShift(b bool) {
+	m.on_shift = &b
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *ReviewMutation) Message() (r string, exists bool) {
-	v := m.message
+// OnShift returns the value of the "on_shift" field in the mutation.
+func (m *ReviewerPoolMemberMutation) OnShift() (r bool, exists bool) {
+	v := m.on_shift
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldOnShift returns the old "on_shift" field's value of the ReviewerPoolMember entity.
+// If the ReviewerPoolMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *ReviewerPoolMemberMutation) OldOnShift(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldOnShift is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldOnShift requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldOnShift: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.OnShift, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *ReviewMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[review.FieldMessage] = struct{}{}
+// ClearOnShift clears the value of the "on_shift" field.
+func (m *ReviewerPoolMemberMutation) ClearOnShift() {
+	m.on_shift = nil
+	m.clearedFields[reviewerpoolmember.FieldOnShift] = struct{}{}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *ReviewMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[review.FieldMessage]
+// OnShiftCleared returns if the "on_shift" field was cleared in this mutation.
+func (m *ReviewerPoolMemberMutation) OnShiftCleared() bool {
+	_, ok := m.clearedFields[reviewerpoolmember.FieldOnShift]
 	return ok
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *ReviewMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, review.FieldMessage)
+// ResetOnShift resets all changes to the "on_shift" field.
+func (m *ReviewerPoolMemberMutation) ResetOnShift() {
+	m.on_shift = nil
+	delete(m.clearedFields, reviewerpoolmember.FieldOnShift)
 }
 
-// Where appends a list predicates to the ReviewMutation builder.
-func (m *ReviewMutation) Where(ps ...predicate.Review) {
+// ClearPool clears the "pool" edge to the ReviewerPool entity.
+func (m *ReviewerPoolMemberMutation) ClearPool() {
+	m.clearedpool = true
+}
+
+// PoolCleared reports if the "pool" edge to the ReviewerPool entity was cleared.
+func (m *ReviewerPoolMemberMutation) PoolCleared() bool {
+	return m.clearedpool
+}
+
+// PoolIDs returns the "pool" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PoolID instead. It exists only for internal usage by the builders.
+func (m *ReviewerPoolMemberMutation) PoolIDs() (ids []uuid.UUID) {
+	if id := m.pool; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPool resets all changes to the "pool" edge.
+func (m *ReviewerPoolMemberMutation) ResetPool() {
+	m.pool = nil
+	m.clearedpool = false
+}
+
+// Where appends a list predicates to the ReviewerPoolMemberMutation builder.
+func (m *ReviewerPoolMemberMutation) Where(ps ...predicate.ReviewerPoolMember) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ReviewMutation) Op() Op {
+func (m *ReviewerPoolMemberMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Review).
-func (m *ReviewMutation) Type() string {
+// Type returns the node type of this mutation (ReviewerPoolMember).
+func (m *ReviewerPoolMemberMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReviewMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ReviewerPoolMemberMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, review.FieldCreatedAt)
+		fields = append(fields, reviewerpoolmember.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, review.FieldUpdatedAt)
+		fields = append(fields, reviewerpoolmember.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, review.FieldDeletedAt)
+		fields = append(fields, reviewerpoolmember.FieldDeletedAt)
 	}
-	if m.app_id != nil {
-		fields = append(fields, review.FieldAppID)
+	if m.pool != nil {
+		fields = append(fields, reviewerpoolmember.FieldPoolID)
 	}
 	if m.reviewer_id != nil {
-		fields = append(fields, review.FieldReviewerID)
-	}
-	if m.domain != nil {
-		fields = append(fields, review.FieldDomain)
-	}
-	if m.object_id != nil {
-		fields = append(fields, review.FieldObjectID)
-	}
-	if m.trigger != nil {
-		fields = append(fields, review.FieldTrigger)
+		fields = append(fields, reviewerpoolmember.FieldReviewerID)
 	}
-	if m.object_type != nil {
-		fields = append(fields, review.FieldObjectType)
-	}
-	if m.state != nil {
-		fields = append(fields, review.FieldState)
+	if m.weight != nil {
+		fields = append(fields, reviewerpoolmember.FieldWeight)
 	}
-	if m.message != nil {
-		fields = append(fields, review.FieldMessage)
+	if m.on_shift != nil {
+		fields = append(fields, reviewerpoolmember.FieldOnShift)
 	}
 	return fields
 }
@@ -776,30 +6539,22 @@ func (m *ReviewMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+func (m *ReviewerPoolMemberMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		return m.CreatedAt()
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		return m.DeletedAt()
-	case review.FieldAppID:
-		return m.AppID()
-	case review.FieldReviewerID:
+	case reviewerpoolmember.FieldPoolID:
+		return m.PoolID()
+	case reviewerpoolmember.FieldReviewerID:
 		return m.ReviewerID()
-	case review.FieldDomain:
-		return m.Domain()
-	case review.FieldObjectID:
-		return m.ObjectID()
-	case review.FieldTrigger:
-		return m.Trigger()
-	case review.FieldObjectType:
-		return m.ObjectType()
-	case review.FieldState:
-		return m.State()
-	case review.FieldMessage:
-		return m.Message()
+	case reviewerpoolmember.FieldWeight:
+		return m.Weight()
+	case reviewerpoolmember.FieldOnShift:
+		return m.OnShift()
 	}
 	return nil, false
 }
@@ -807,132 +6562,99 @@ func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReviewerPoolMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case review.FieldAppID:
-		return m.OldAppID(ctx)
-	case review.FieldReviewerID:
+	case reviewerpoolmember.FieldPoolID:
+		return m.OldPoolID(ctx)
+	case reviewerpoolmember.FieldReviewerID:
 		return m.OldReviewerID(ctx)
-	case review.FieldDomain:
-		return m.OldDomain(ctx)
-	case review.FieldObjectID:
-		return m.OldObjectID(ctx)
-	case review.FieldTrigger:
-		return m.OldTrigger(ctx)
-	case review.FieldObjectType:
-		return m.OldObjectType(ctx)
-	case review.FieldState:
-		return m.OldState(ctx)
-	case review.FieldMessage:
-		return m.OldMessage(ctx)
+	case reviewerpoolmember.FieldWeight:
+		return m.OldWeight(ctx)
+	case reviewerpoolmember.FieldOnShift:
+		return m.OldOnShift(ctx)
 	}
-	return nil, fmt.Errorf("unknown Review field %s", name)
+	return nil, fmt.Errorf("unknown ReviewerPoolMember field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+func (m *ReviewerPoolMemberMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case review.FieldAppID:
+	case reviewerpoolmember.FieldPoolID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppID(v)
+		m.SetPoolID(v)
 		return nil
-	case review.FieldReviewerID:
+	case reviewerpoolmember.FieldReviewerID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetReviewerID(v)
 		return nil
-	case review.FieldDomain:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDomain(v)
-		return nil
-	case review.FieldObjectID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetObjectID(v)
-		return nil
-	case review.FieldTrigger:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTrigger(v)
-		return nil
-	case review.FieldObjectType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetObjectType(v)
-		return nil
-	case review.FieldState:
-		v, ok := value.(string)
+	case reviewerpoolmember.FieldWeight:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.SetWeight(v)
 		return nil
-	case review.FieldMessage:
-		v, ok := value.(string)
+	case reviewerpoolmember.FieldOnShift:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetOnShift(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown ReviewerPoolMember field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReviewMutation) AddedFields() []string {
+func (m *ReviewerPoolMemberMutation) AddedFields() []string {
 	var fields []string
 	if m.addcreated_at != nil {
-		fields = append(fields, review.FieldCreatedAt)
+		fields = append(fields, reviewerpoolmember.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, review.FieldUpdatedAt)
+		fields = append(fields, reviewerpoolmember.FieldUpdatedAt)
 	}
 	if m.adddeleted_at != nil {
-		fields = append(fields, review.FieldDeletedAt)
+		fields = append(fields, reviewerpoolmember.FieldDeletedAt)
+	}
+	if m.addweight != nil {
+		fields = append(fields, reviewerpoolmember.FieldWeight)
 	}
 	return fields
 }
@@ -940,14 +6662,16 @@ func (m *ReviewMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReviewerPoolMemberMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		return m.AddedDeletedAt()
+	case reviewerpoolmember.FieldWeight:
+		return m.AddedWeight()
 	}
 	return nil, false
 }
@@ -955,188 +6679,175 @@ func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+func (m *ReviewerPoolMemberMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUpdatedAt(v)
 		return nil
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddDeletedAt(v)
 		return nil
+	case reviewerpoolmember.FieldWeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Review numeric field %s", name)
+	return fmt.Errorf("unknown ReviewerPoolMember numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReviewMutation) ClearedFields() []string {
+func (m *ReviewerPoolMemberMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(review.FieldAppID) {
-		fields = append(fields, review.FieldAppID)
-	}
-	if m.FieldCleared(review.FieldReviewerID) {
-		fields = append(fields, review.FieldReviewerID)
-	}
-	if m.FieldCleared(review.FieldDomain) {
-		fields = append(fields, review.FieldDomain)
-	}
-	if m.FieldCleared(review.FieldObjectID) {
-		fields = append(fields, review.FieldObjectID)
-	}
-	if m.FieldCleared(review.FieldTrigger) {
-		fields = append(fields, review.FieldTrigger)
-	}
-	if m.FieldCleared(review.FieldObjectType) {
-		fields = append(fields, review.FieldObjectType)
-	}
-	if m.FieldCleared(review.FieldState) {
-		fields = append(fields, review.FieldState)
+	if m.FieldCleared(reviewerpoolmember.FieldWeight) {
+		fields = append(fields, reviewerpoolmember.FieldWeight)
 	}
-	if m.FieldCleared(review.FieldMessage) {
-		fields = append(fields, review.FieldMessage)
+	if m.FieldCleared(reviewerpoolmember.FieldOnShift) {
+		fields = append(fields, reviewerpoolmember.FieldOnShift)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReviewMutation) FieldCleared(name string) bool {
+func (m *ReviewerPoolMemberMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReviewMutation) ClearField(name string) error {
+func (m *ReviewerPoolMemberMutation) ClearField(name string) error {
 	switch name {
-	case review.FieldAppID:
-		m.ClearAppID()
-		return nil
-	case review.FieldReviewerID:
-		m.ClearReviewerID()
-		return nil
-	case review.FieldDomain:
-		m.ClearDomain()
-		return nil
-	case review.FieldObjectID:
-		m.ClearObjectID()
+	case reviewerpoolmember.FieldWeight:
+		m.ClearWeight()
 		return nil
-	case review.FieldTrigger:
-		m.ClearTrigger()
-		return nil
-	case review.FieldObjectType:
-		m.ClearObjectType()
-		return nil
-	case review.FieldState:
-		m.ClearState()
-		return nil
-	case review.FieldMessage:
-		m.ClearMessage()
+	case reviewerpoolmember.FieldOnShift:
+		m.ClearOnShift()
 		return nil
 	}
-	return fmt.Errorf("unknown Review nullable field %s", name)
+	return fmt.Errorf("unknown ReviewerPoolMember nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReviewMutation) ResetField(name string) error {
+func (m *ReviewerPoolMemberMutation) ResetField(name string) error {
 	switch name {
-	case review.FieldCreatedAt:
+	case reviewerpoolmember.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case review.FieldUpdatedAt:
+	case reviewerpoolmember.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case review.FieldDeletedAt:
+	case reviewerpoolmember.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case review.FieldAppID:
-		m.ResetAppID()
+	case reviewerpoolmember.FieldPoolID:
+		m.ResetPoolID()
 		return nil
-	case review.FieldReviewerID:
+	case reviewerpoolmember.FieldReviewerID:
 		m.ResetReviewerID()
 		return nil
-	case review.FieldDomain:
-		m.ResetDomain()
-		return nil
-	case review.FieldObjectID:
-		m.ResetObjectID()
-		return nil
-	case review.FieldTrigger:
-		m.ResetTrigger()
-		return nil
-	case review.FieldObjectType:
-		m.ResetObjectType()
-		return nil
-	case review.FieldState:
-		m.ResetState()
+	case reviewerpoolmember.FieldWeight:
+		m.ResetWeight()
 		return nil
-	case review.FieldMessage:
-		m.ResetMessage()
+	case reviewerpoolmember.FieldOnShift:
+		m.ResetOnShift()
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown ReviewerPoolMember field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReviewMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ReviewerPoolMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.pool != nil {
+		edges = append(edges, reviewerpoolmember.EdgePool)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
+func (m *ReviewerPoolMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reviewerpoolmember.EdgePool:
+		if id := m.pool; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReviewMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ReviewerPoolMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReviewerPoolMemberMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReviewMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ReviewerPoolMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpool {
+		edges = append(edges, reviewerpoolmember.EdgePool)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReviewMutation) EdgeCleared(name string) bool {
+func (m *ReviewerPoolMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reviewerpoolmember.EdgePool:
+		return m.clearedpool
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReviewMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Review unique edge %s", name)
+func (m *ReviewerPoolMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case reviewerpoolmember.EdgePool:
+		m.ClearPool()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPoolMember unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReviewMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Review edge %s", name)
+func (m *ReviewerPoolMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case reviewerpoolmember.EdgePool:
+		m.ResetPool()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewerPoolMember edge %s", name)
 }
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	"github.com/google/uuid"
+)
+
+// Outbox is the model entity for the Outbox schema.
+type Outbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt uint32 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt uint32 `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt uint32 `json:"deleted_at,omitempty"`
+	// RoutingKey holds the value of the "routing_key" field.
+	RoutingKey string `json:"routing_key,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts uint32 `json:"attempts,omitempty"`
+	// NextRetryAt holds the value of the "next_retry_at" field.
+	NextRetryAt uint32 `json:"next_retry_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt uint32 `json:"delivered_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Outbox) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outbox.FieldCreatedAt, outbox.FieldUpdatedAt, outbox.FieldDeletedAt, outbox.FieldAttempts, outbox.FieldNextRetryAt, outbox.FieldDeliveredAt:
+			values[i] = new(sql.NullInt64)
+		case outbox.FieldRoutingKey, outbox.FieldPayload, outbox.FieldState, outbox.FieldLastError:
+			values[i] = new(sql.NullString)
+		case outbox.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Outbox", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Outbox fields.
+func (o *Outbox) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outbox.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				o.ID = *value
+			}
+		case outbox.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = uint32(value.Int64)
+			}
+		case outbox.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				o.UpdatedAt = uint32(value.Int64)
+			}
+		case outbox.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				o.DeletedAt = uint32(value.Int64)
+			}
+		case outbox.FieldRoutingKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field routing_key", values[i])
+			} else if value.Valid {
+				o.RoutingKey = value.String
+			}
+		case outbox.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				o.Payload = value.String
+			}
+		case outbox.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				o.State = value.String
+			}
+		case outbox.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				o.Attempts = uint32(value.Int64)
+			}
+		case outbox.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				o.NextRetryAt = uint32(value.Int64)
+			}
+		case outbox.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				o.DeliveredAt = uint32(value.Int64)
+			}
+		case outbox.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				o.LastError = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Outbox.
+// Note that you need to call Outbox.Unwrap() before calling this method if this Outbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Outbox) Update() *OutboxUpdateOne {
+	return (&OutboxClient{config: o.config}).UpdateOne(o)
+}
+
+// Unwrap unwraps the Outbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Outbox) Unwrap() *Outbox {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Outbox is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Outbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("Outbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", o.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", o.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", o.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("routing_key=")
+	builder.WriteString(o.RoutingKey)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(o.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(o.State)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", o.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_retry_at=")
+	builder.WriteString(fmt.Sprintf("%v", o.NextRetryAt))
+	builder.WriteString(", ")
+	builder.WriteString("delivered_at=")
+	builder.WriteString(fmt.Sprintf("%v", o.DeliveredAt))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(o.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Outboxes is a parsable slice of Outbox.
+type Outboxes []*Outbox
+
+func (o Outboxes) config(cfg config) {
+	for _i := range o {
+		o[_i].config = cfg
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outbox
+
+import (
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the outbox type in the database.
+	Label = "outbox"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldRoutingKey holds the string denoting the routing_key field in the database.
+	FieldRoutingKey = "routing_key"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextRetryAt holds the string denoting the next_retry_at field in the database.
+	FieldNextRetryAt = "next_retry_at"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the outbox in the database.
+	Table = "outboxes"
+)
+
+// Columns holds all SQL columns for outbox fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldRoutingKey,
+	FieldPayload,
+	FieldState,
+	FieldAttempts,
+	FieldNextRetryAt,
+	FieldDeliveredAt,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/NpoolPlatform/review-manager/pkg/db/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() uint32
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() uint32
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() uint32
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt func() uint32
+	// DefaultRoutingKey holds the default value on creation for the "routing_key" field.
+	DefaultRoutingKey string
+	// DefaultPayload holds the default value on creation for the "payload" field.
+	DefaultPayload string
+	// DefaultState holds the default value on creation for the "state" field.
+	DefaultState string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts uint32
+	// DefaultNextRetryAt holds the default value on creation for the "next_retry_at" field.
+	DefaultNextRetryAt uint32
+	// DefaultDeliveredAt holds the default value on creation for the "delivered_at" field.
+	DefaultDeliveredAt uint32
+	// DefaultLastError holds the default value on creation for the "last_error" field.
+	DefaultLastError string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
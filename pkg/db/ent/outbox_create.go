@@ -0,0 +1,1436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	"github.com/google/uuid"
+)
+
+// OutboxCreate is the builder for creating a Outbox entity.
+type OutboxCreate struct {
+	config
+	mutation *OutboxMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OutboxCreate) SetCreatedAt(u uint32) *OutboxCreate {
+	oc.mutation.SetCreatedAt(u)
+	return oc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableCreatedAt(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetCreatedAt(*u)
+	}
+	return oc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oc *OutboxCreate) SetUpdatedAt(u uint32) *OutboxCreate {
+	oc.mutation.SetUpdatedAt(u)
+	return oc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableUpdatedAt(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetUpdatedAt(*u)
+	}
+	return oc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (oc *OutboxCreate) SetDeletedAt(u uint32) *OutboxCreate {
+	oc.mutation.SetDeletedAt(u)
+	return oc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableDeletedAt(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetDeletedAt(*u)
+	}
+	return oc
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (oc *OutboxCreate) SetRoutingKey(s string) *OutboxCreate {
+	oc.mutation.SetRoutingKey(s)
+	return oc
+}
+
+// SetNillableRoutingKey sets the "routing_key" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableRoutingKey(s *string) *OutboxCreate {
+	if s != nil {
+		oc.SetRoutingKey(*s)
+	}
+	return oc
+}
+
+// SetPayload sets the "payload" field.
+func (oc *OutboxCreate) SetPayload(s string) *OutboxCreate {
+	oc.mutation.SetPayload(s)
+	return oc
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillablePayload(s *string) *OutboxCreate {
+	if s != nil {
+		oc.SetPayload(*s)
+	}
+	return oc
+}
+
+// SetState sets the "state" field.
+func (oc *OutboxCreate) SetState(s string) *OutboxCreate {
+	oc.mutation.SetState(s)
+	return oc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableState(s *string) *OutboxCreate {
+	if s != nil {
+		oc.SetState(*s)
+	}
+	return oc
+}
+
+// SetAttempts sets the "attempts" field.
+func (oc *OutboxCreate) SetAttempts(u uint32) *OutboxCreate {
+	oc.mutation.SetAttempts(u)
+	return oc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableAttempts(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetAttempts(*u)
+	}
+	return oc
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (oc *OutboxCreate) SetNextRetryAt(u uint32) *OutboxCreate {
+	oc.mutation.SetNextRetryAt(u)
+	return oc
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableNextRetryAt(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetNextRetryAt(*u)
+	}
+	return oc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (oc *OutboxCreate) SetDeliveredAt(u uint32) *OutboxCreate {
+	oc.mutation.SetDeliveredAt(u)
+	return oc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableDeliveredAt(u *uint32) *OutboxCreate {
+	if u != nil {
+		oc.SetDeliveredAt(*u)
+	}
+	return oc
+}
+
+// SetLastError sets the "last_error" field.
+func (oc *OutboxCreate) SetLastError(s string) *OutboxCreate {
+	oc.mutation.SetLastError(s)
+	return oc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableLastError(s *string) *OutboxCreate {
+	if s != nil {
+		oc.SetLastError(*s)
+	}
+	return oc
+}
+
+// SetID sets the "id" field.
+func (oc *OutboxCreate) SetID(u uuid.UUID) *OutboxCreate {
+	oc.mutation.SetID(u)
+	return oc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableID(u *uuid.UUID) *OutboxCreate {
+	if u != nil {
+		oc.SetID(*u)
+	}
+	return oc
+}
+
+// Mutation returns the OutboxMutation object of the builder.
+func (oc *OutboxCreate) Mutation() *OutboxMutation {
+	return oc.mutation
+}
+
+// Save creates the Outbox in the database.
+func (oc *OutboxCreate) Save(ctx context.Context) (*Outbox, error) {
+	var (
+		err  error
+		node *Outbox
+	)
+	if err := oc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(oc.hooks) == 0 {
+		if err = oc.check(); err != nil {
+			return nil, err
+		}
+		node, err = oc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OutboxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = oc.check(); err != nil {
+				return nil, err
+			}
+			oc.mutation = mutation
+			if node, err = oc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(oc.hooks) - 1; i >= 0; i-- {
+			if oc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = oc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, oc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Outbox)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OutboxMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OutboxCreate) SaveX(ctx context.Context) *Outbox {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OutboxCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OutboxCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oc *OutboxCreate) defaults() error {
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		if outbox.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized outbox.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := outbox.DefaultCreatedAt()
+		oc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		if outbox.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized outbox.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := outbox.DefaultUpdatedAt()
+		oc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oc.mutation.DeletedAt(); !ok {
+		if outbox.DefaultDeletedAt == nil {
+			return fmt.Errorf("ent: uninitialized outbox.DefaultDeletedAt (forgotten import ent/runtime?)")
+		}
+		v := outbox.DefaultDeletedAt()
+		oc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := oc.mutation.RoutingKey(); !ok {
+		v := outbox.DefaultRoutingKey
+		oc.mutation.SetRoutingKey(v)
+	}
+	if _, ok := oc.mutation.Payload(); !ok {
+		v := outbox.DefaultPayload
+		oc.mutation.SetPayload(v)
+	}
+	if _, ok := oc.mutation.State(); !ok {
+		v := outbox.DefaultState
+		oc.mutation.SetState(v)
+	}
+	if _, ok := oc.mutation.Attempts(); !ok {
+		v := outbox.DefaultAttempts
+		oc.mutation.SetAttempts(v)
+	}
+	if _, ok := oc.mutation.NextRetryAt(); !ok {
+		v := outbox.DefaultNextRetryAt
+		oc.mutation.SetNextRetryAt(v)
+	}
+	if _, ok := oc.mutation.DeliveredAt(); !ok {
+		v := outbox.DefaultDeliveredAt
+		oc.mutation.SetDeliveredAt(v)
+	}
+	if _, ok := oc.mutation.LastError(); !ok {
+		v := outbox.DefaultLastError
+		oc.mutation.SetLastError(v)
+	}
+	if _, ok := oc.mutation.ID(); !ok {
+		if outbox.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized outbox.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := outbox.DefaultID()
+		oc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OutboxCreate) check() error {
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Outbox.created_at"`)}
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Outbox.updated_at"`)}
+	}
+	if _, ok := oc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Outbox.deleted_at"`)}
+	}
+	return nil
+}
+
+func (oc *OutboxCreate) sqlSave(ctx context.Context) (*Outbox, error) {
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (oc *OutboxCreate) createSpec() (*Outbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Outbox{config: oc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: outbox.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: outbox.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = oc.conflict
+	if id, ok := oc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := oc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := oc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := oc.mutation.RoutingKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldRoutingKey,
+		})
+		_node.RoutingKey = value
+	}
+	if value, ok := oc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := oc.mutation.State(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldState,
+		})
+		_node.State = value
+	}
+	if value, ok := oc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := oc.mutation.NextRetryAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldNextRetryAt,
+		})
+		_node.NextRetryAt = value
+	}
+	if value, ok := oc.mutation.DeliveredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeliveredAt,
+		})
+		_node.DeliveredAt = value
+	}
+	if value, ok := oc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldLastError,
+		})
+		_node.LastError = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Outbox.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (oc *OutboxCreate) OnConflict(opts ...sql.ConflictOption) *OutboxUpsertOne {
+	oc.conflict = opts
+	return &OutboxUpsertOne{
+		create: oc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oc *OutboxCreate) OnConflictColumns(columns ...string) *OutboxUpsertOne {
+	oc.conflict = append(oc.conflict, sql.ConflictColumns(columns...))
+	return &OutboxUpsertOne{
+		create: oc,
+	}
+}
+
+type (
+	// OutboxUpsertOne is the builder for "upsert"-ing
+	//  one Outbox node.
+	OutboxUpsertOne struct {
+		create *OutboxCreate
+	}
+
+	// OutboxUpsert is the "OnConflict" setter.
+	OutboxUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OutboxUpsert) SetCreatedAt(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateCreatedAt() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *OutboxUpsert) AddCreatedAt(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxUpsert) SetUpdatedAt(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateUpdatedAt() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *OutboxUpsert) AddUpdatedAt(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldUpdatedAt, v)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OutboxUpsert) SetDeletedAt(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateDeletedAt() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *OutboxUpsert) AddDeletedAt(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldDeletedAt, v)
+	return u
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (u *OutboxUpsert) SetRoutingKey(v string) *OutboxUpsert {
+	u.Set(outbox.FieldRoutingKey, v)
+	return u
+}
+
+// UpdateRoutingKey sets the "routing_key" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateRoutingKey() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldRoutingKey)
+	return u
+}
+
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (u *OutboxUpsert) ClearRoutingKey() *OutboxUpsert {
+	u.SetNull(outbox.FieldRoutingKey)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *OutboxUpsert) SetPayload(v string) *OutboxUpsert {
+	u.Set(outbox.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdatePayload() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldPayload)
+	return u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *OutboxUpsert) ClearPayload() *OutboxUpsert {
+	u.SetNull(outbox.FieldPayload)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *OutboxUpsert) SetState(v string) *OutboxUpsert {
+	u.Set(outbox.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateState() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldState)
+	return u
+}
+
+// ClearState clears the value of the "state" field.
+func (u *OutboxUpsert) ClearState() *OutboxUpsert {
+	u.SetNull(outbox.FieldState)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxUpsert) SetAttempts(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateAttempts() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxUpsert) AddAttempts(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldAttempts, v)
+	return u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *OutboxUpsert) ClearAttempts() *OutboxUpsert {
+	u.SetNull(outbox.FieldAttempts)
+	return u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *OutboxUpsert) SetNextRetryAt(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldNextRetryAt, v)
+	return u
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateNextRetryAt() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldNextRetryAt)
+	return u
+}
+
+// AddNextRetryAt adds v to the "next_retry_at" field.
+func (u *OutboxUpsert) AddNextRetryAt(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldNextRetryAt, v)
+	return u
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *OutboxUpsert) ClearNextRetryAt() *OutboxUpsert {
+	u.SetNull(outbox.FieldNextRetryAt)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *OutboxUpsert) SetDeliveredAt(v uint32) *OutboxUpsert {
+	u.Set(outbox.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateDeliveredAt() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldDeliveredAt)
+	return u
+}
+
+// AddDeliveredAt adds v to the "delivered_at" field.
+func (u *OutboxUpsert) AddDeliveredAt(v uint32) *OutboxUpsert {
+	u.Add(outbox.FieldDeliveredAt, v)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *OutboxUpsert) ClearDeliveredAt() *OutboxUpsert {
+	u.SetNull(outbox.FieldDeliveredAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxUpsert) SetLastError(v string) *OutboxUpsert {
+	u.Set(outbox.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxUpsert) UpdateLastError() *OutboxUpsert {
+	u.SetExcluded(outbox.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxUpsert) ClearLastError() *OutboxUpsert {
+	u.SetNull(outbox.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outbox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxUpsertOne) UpdateNewValues() *OutboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(outbox.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OutboxUpsertOne) Ignore() *OutboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxUpsertOne) DoNothing() *OutboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxCreate.OnConflict
+// documentation for more info.
+func (u *OutboxUpsertOne) Update(set func(*OutboxUpsert)) *OutboxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OutboxUpsertOne) SetCreatedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *OutboxUpsertOne) AddCreatedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateCreatedAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxUpsertOne) SetUpdatedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *OutboxUpsertOne) AddUpdatedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateUpdatedAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OutboxUpsertOne) SetDeletedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *OutboxUpsertOne) AddDeletedAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateDeletedAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (u *OutboxUpsertOne) SetRoutingKey(v string) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetRoutingKey(v)
+	})
+}
+
+// UpdateRoutingKey sets the "routing_key" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateRoutingKey() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateRoutingKey()
+	})
+}
+
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (u *OutboxUpsertOne) ClearRoutingKey() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearRoutingKey()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *OutboxUpsertOne) SetPayload(v string) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdatePayload() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *OutboxUpsertOne) ClearPayload() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *OutboxUpsertOne) SetState(v string) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateState() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *OutboxUpsertOne) ClearState() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxUpsertOne) SetAttempts(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxUpsertOne) AddAttempts(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateAttempts() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *OutboxUpsertOne) ClearAttempts() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *OutboxUpsertOne) SetNextRetryAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// AddNextRetryAt adds v to the "next_retry_at" field.
+func (u *OutboxUpsertOne) AddNextRetryAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateNextRetryAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *OutboxUpsertOne) ClearNextRetryAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearNextRetryAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *OutboxUpsertOne) SetDeliveredAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// AddDeliveredAt adds v to the "delivered_at" field.
+func (u *OutboxUpsertOne) AddDeliveredAt(v uint32) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateDeliveredAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *OutboxUpsertOne) ClearDeliveredAt() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxUpsertOne) SetLastError(v string) *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxUpsertOne) UpdateLastError() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxUpsertOne) ClearLastError() *OutboxUpsertOne {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *OutboxUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OutboxUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OutboxUpsertOne.ID is not supported by MySQL driver. Use OutboxUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OutboxUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OutboxCreateBulk is the builder for creating many Outbox entities in bulk.
+type OutboxCreateBulk struct {
+	config
+	builders []*OutboxCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Outbox entities in the database.
+func (ocb *OutboxCreateBulk) Save(ctx context.Context) ([]*Outbox, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Outbox, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ocb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OutboxCreateBulk) SaveX(ctx context.Context) []*Outbox {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Outbox.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ocb *OutboxCreateBulk) OnConflict(opts ...sql.ConflictOption) *OutboxUpsertBulk {
+	ocb.conflict = opts
+	return &OutboxUpsertBulk{
+		create: ocb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ocb *OutboxCreateBulk) OnConflictColumns(columns ...string) *OutboxUpsertBulk {
+	ocb.conflict = append(ocb.conflict, sql.ConflictColumns(columns...))
+	return &OutboxUpsertBulk{
+		create: ocb,
+	}
+}
+
+// OutboxUpsertBulk is the builder for "upsert"-ing
+// a bulk of Outbox nodes.
+type OutboxUpsertBulk struct {
+	create *OutboxCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outbox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxUpsertBulk) UpdateNewValues() *OutboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(outbox.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Outbox.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OutboxUpsertBulk) Ignore() *OutboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxUpsertBulk) DoNothing() *OutboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxCreateBulk.OnConflict
+// documentation for more info.
+func (u *OutboxUpsertBulk) Update(set func(*OutboxUpsert)) *OutboxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OutboxUpsertBulk) SetCreatedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *OutboxUpsertBulk) AddCreatedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateCreatedAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxUpsertBulk) SetUpdatedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *OutboxUpsertBulk) AddUpdatedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateUpdatedAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OutboxUpsertBulk) SetDeletedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *OutboxUpsertBulk) AddDeletedAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateDeletedAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (u *OutboxUpsertBulk) SetRoutingKey(v string) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetRoutingKey(v)
+	})
+}
+
+// UpdateRoutingKey sets the "routing_key" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateRoutingKey() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateRoutingKey()
+	})
+}
+
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (u *OutboxUpsertBulk) ClearRoutingKey() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearRoutingKey()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *OutboxUpsertBulk) SetPayload(v string) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdatePayload() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *OutboxUpsertBulk) ClearPayload() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *OutboxUpsertBulk) SetState(v string) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateState() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *OutboxUpsertBulk) ClearState() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxUpsertBulk) SetAttempts(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxUpsertBulk) AddAttempts(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateAttempts() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *OutboxUpsertBulk) ClearAttempts() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *OutboxUpsertBulk) SetNextRetryAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// AddNextRetryAt adds v to the "next_retry_at" field.
+func (u *OutboxUpsertBulk) AddNextRetryAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateNextRetryAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *OutboxUpsertBulk) ClearNextRetryAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearNextRetryAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *OutboxUpsertBulk) SetDeliveredAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// AddDeliveredAt adds v to the "delivered_at" field.
+func (u *OutboxUpsertBulk) AddDeliveredAt(v uint32) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.AddDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateDeliveredAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *OutboxUpsertBulk) ClearDeliveredAt() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxUpsertBulk) SetLastError(v string) *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxUpsertBulk) UpdateLastError() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxUpsertBulk) ClearLastError() *OutboxUpsertBulk {
+	return u.Update(func(s *OutboxUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *OutboxUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OutboxCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+)
+
+// OutboxUpdate is the builder for updating Outbox entities.
+type OutboxUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OutboxUpdate builder.
+func (ou *OutboxUpdate) Where(ps ...predicate.Outbox) *OutboxUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ou *OutboxUpdate) SetCreatedAt(u uint32) *OutboxUpdate {
+	ou.mutation.ResetCreatedAt()
+	ou.mutation.SetCreatedAt(u)
+	return ou
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableCreatedAt(u *uint32) *OutboxUpdate {
+	if u != nil {
+		ou.SetCreatedAt(*u)
+	}
+	return ou
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (ou *OutboxUpdate) AddCreatedAt(u int32) *OutboxUpdate {
+	ou.mutation.AddCreatedAt(u)
+	return ou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ou *OutboxUpdate) SetUpdatedAt(u uint32) *OutboxUpdate {
+	ou.mutation.ResetUpdatedAt()
+	ou.mutation.SetUpdatedAt(u)
+	return ou
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (ou *OutboxUpdate) AddUpdatedAt(u int32) *OutboxUpdate {
+	ou.mutation.AddUpdatedAt(u)
+	return ou
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ou *OutboxUpdate) SetDeletedAt(u uint32) *OutboxUpdate {
+	ou.mutation.ResetDeletedAt()
+	ou.mutation.SetDeletedAt(u)
+	return ou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableDeletedAt(u *uint32) *OutboxUpdate {
+	if u != nil {
+		ou.SetDeletedAt(*u)
+	}
+	return ou
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (ou *OutboxUpdate) AddDeletedAt(u int32) *OutboxUpdate {
+	ou.mutation.AddDeletedAt(u)
+	return ou
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (ou *OutboxUpdate) SetRoutingKey(s string) *OutboxUpdate {
+	ou.mutation.SetRoutingKey(s)
+	return ou
+}
+
+// SetNillableRoutingKey sets the "routing_key" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableRoutingKey(s *string) *OutboxUpdate {
+	if s != nil {
+		ou.SetRoutingKey(*s)
+	}
+	return ou
+}
+
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (ou *OutboxUpdate) ClearRoutingKey() *OutboxUpdate {
+	ou.mutation.ClearRoutingKey()
+	return ou
+}
+
+// SetPayload sets the "payload" field.
+func (ou *OutboxUpdate) SetPayload(s string) *OutboxUpdate {
+	ou.mutation.SetPayload(s)
+	return ou
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillablePayload(s *string) *OutboxUpdate {
+	if s != nil {
+		ou.SetPayload(*s)
+	}
+	return ou
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (ou *OutboxUpdate) ClearPayload() *OutboxUpdate {
+	ou.mutation.ClearPayload()
+	return ou
+}
+
+// SetState sets the "state" field.
+func (ou *OutboxUpdate) SetState(s string) *OutboxUpdate {
+	ou.mutation.SetState(s)
+	return ou
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableState(s *string) *OutboxUpdate {
+	if s != nil {
+		ou.SetState(*s)
+	}
+	return ou
+}
+
+// ClearState clears the value of the "state" field.
+func (ou *OutboxUpdate) ClearState() *OutboxUpdate {
+	ou.mutation.ClearState()
+	return ou
+}
+
+// SetAttempts sets the "attempts" field.
+func (ou *OutboxUpdate) SetAttempts(u uint32) *OutboxUpdate {
+	ou.mutation.ResetAttempts()
+	ou.mutation.SetAttempts(u)
+	return ou
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableAttempts(u *uint32) *OutboxUpdate {
+	if u != nil {
+		ou.SetAttempts(*u)
+	}
+	return ou
+}
+
+// AddAttempts adds u to the "attempts" field.
+func (ou *OutboxUpdate) AddAttempts(u int32) *OutboxUpdate {
+	ou.mutation.AddAttempts(u)
+	return ou
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (ou *OutboxUpdate) ClearAttempts() *OutboxUpdate {
+	ou.mutation.ClearAttempts()
+	return ou
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (ou *OutboxUpdate) SetNextRetryAt(u uint32) *OutboxUpdate {
+	ou.mutation.ResetNextRetryAt()
+	ou.mutation.SetNextRetryAt(u)
+	return ou
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableNextRetryAt(u *uint32) *OutboxUpdate {
+	if u != nil {
+		ou.SetNextRetryAt(*u)
+	}
+	return ou
+}
+
+// AddNextRetryAt adds u to the "next_retry_at" field.
+func (ou *OutboxUpdate) AddNextRetryAt(u int32) *OutboxUpdate {
+	ou.mutation.AddNextRetryAt(u)
+	return ou
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (ou *OutboxUpdate) ClearNextRetryAt() *OutboxUpdate {
+	ou.mutation.ClearNextRetryAt()
+	return ou
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (ou *OutboxUpdate) SetDeliveredAt(u uint32) *OutboxUpdate {
+	ou.mutation.ResetDeliveredAt()
+	ou.mutation.SetDeliveredAt(u)
+	return ou
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableDeliveredAt(u *uint32) *OutboxUpdate {
+	if u != nil {
+		ou.SetDeliveredAt(*u)
+	}
+	return ou
+}
+
+// AddDeliveredAt adds u to the "delivered_at" field.
+func (ou *OutboxUpdate) AddDeliveredAt(u int32) *OutboxUpdate {
+	ou.mutation.AddDeliveredAt(u)
+	return ou
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (ou *OutboxUpdate) ClearDeliveredAt() *OutboxUpdate {
+	ou.mutation.ClearDeliveredAt()
+	return ou
+}
+
+// SetLastError sets the "last_error" field.
+func (ou *OutboxUpdate) SetLastError(s string) *OutboxUpdate {
+	ou.mutation.SetLastError(s)
+	return ou
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ou *OutboxUpdate) SetNillableLastError(s *string) *OutboxUpdate {
+	if s != nil {
+		ou.SetLastError(*s)
+	}
+	return ou
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ou *OutboxUpdate) ClearLastError() *OutboxUpdate {
+	ou.mutation.ClearLastError()
+	return ou
+}
+
+// Mutation returns the OutboxMutation object of the builder.
+func (ou *OutboxUpdate) Mutation() *OutboxMutation {
+	return ou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OutboxUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := ou.defaults(); err != nil {
+		return 0, err
+	}
+	if len(ou.hooks) == 0 {
+		affected, err = ou.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OutboxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ou.mutation = mutation
+			affected, err = ou.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ou.hooks) - 1; i >= 0; i-- {
+			if ou.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ou.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ou.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OutboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OutboxUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OutboxUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ou *OutboxUpdate) defaults() error {
+	if _, ok := ou.mutation.UpdatedAt(); !ok {
+		if outbox.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized outbox.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := outbox.UpdateDefaultUpdatedAt()
+		ou.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ou *OutboxUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxUpdate {
+	ou.modifiers = append(ou.modifiers, modifiers...)
+	return ou
+}
+
+func (ou *OutboxUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   outbox.Table,
+			Columns: outbox.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: outbox.FieldID,
+			},
+		},
+	}
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldCreatedAt,
+		})
+	}
+	if value, ok := ou.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldCreatedAt,
+		})
+	}
+	if value, ok := ou.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldUpdatedAt,
+		})
+	}
+	if value, ok := ou.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldUpdatedAt,
+		})
+	}
+	if value, ok := ou.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeletedAt,
+		})
+	}
+	if value, ok := ou.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeletedAt,
+		})
+	}
+	if value, ok := ou.mutation.RoutingKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldRoutingKey,
+		})
+	}
+	if ou.mutation.RoutingKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldRoutingKey,
+		})
+	}
+	if value, ok := ou.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldPayload,
+		})
+	}
+	if ou.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldPayload,
+		})
+	}
+	if value, ok := ou.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldState,
+		})
+	}
+	if ou.mutation.StateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldState,
+		})
+	}
+	if value, ok := ou.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if value, ok := ou.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if ou.mutation.AttemptsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if value, ok := ou.mutation.NextRetryAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if value, ok := ou.mutation.AddedNextRetryAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if ou.mutation.NextRetryAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if value, ok := ou.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if value, ok := ou.mutation.AddedDeliveredAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if ou.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if value, ok := ou.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldLastError,
+		})
+	}
+	if ou.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldLastError,
+		})
+	}
+	_spec.Modifiers = ou.modifiers
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OutboxUpdateOne is the builder for updating a single Outbox entity.
+type OutboxUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ouo *OutboxUpdateOne) SetCreatedAt(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetCreatedAt()
+	ouo.mutation.SetCreatedAt(u)
+	return ouo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableCreatedAt(u *uint32) *OutboxUpdateOne {
+	if u != nil {
+		ouo.SetCreatedAt(*u)
+	}
+	return ouo
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (ouo *OutboxUpdateOne) AddCreatedAt(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddCreatedAt(u)
+	return ouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ouo *OutboxUpdateOne) SetUpdatedAt(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetUpdatedAt()
+	ouo.mutation.SetUpdatedAt(u)
+	return ouo
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (ouo *OutboxUpdateOne) AddUpdatedAt(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddUpdatedAt(u)
+	return ouo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ouo *OutboxUpdateOne) SetDeletedAt(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetDeletedAt()
+	ouo.mutation.SetDeletedAt(u)
+	return ouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableDeletedAt(u *uint32) *OutboxUpdateOne {
+	if u != nil {
+		ouo.SetDeletedAt(*u)
+	}
+	return ouo
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (ouo *OutboxUpdateOne) AddDeletedAt(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddDeletedAt(u)
+	return ouo
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (ouo *OutboxUpdateOne) SetRoutingKey(s string) *OutboxUpdateOne {
+	ouo.mutation.SetRoutingKey(s)
+	return ouo
+}
+
+// SetNillableRoutingKey sets the "routing_key" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableRoutingKey(s *string) *OutboxUpdateOne {
+	if s != nil {
+		ouo.SetRoutingKey(*s)
+	}
+	return ouo
+}
+
+// ClearRoutingKey clears the value of the "routing_key" field.
+func (ouo *OutboxUpdateOne) ClearRoutingKey() *OutboxUpdateOne {
+	ouo.mutation.ClearRoutingKey()
+	return ouo
+}
+
+// SetPayload sets the "payload" field.
+func (ouo *OutboxUpdateOne) SetPayload(s string) *OutboxUpdateOne {
+	ouo.mutation.SetPayload(s)
+	return ouo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillablePayload(s *string) *OutboxUpdateOne {
+	if s != nil {
+		ouo.SetPayload(*s)
+	}
+	return ouo
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (ouo *OutboxUpdateOne) ClearPayload() *OutboxUpdateOne {
+	ouo.mutation.ClearPayload()
+	return ouo
+}
+
+// SetState sets the "state" field.
+func (ouo *OutboxUpdateOne) SetState(s string) *OutboxUpdateOne {
+	ouo.mutation.SetState(s)
+	return ouo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableState(s *string) *OutboxUpdateOne {
+	if s != nil {
+		ouo.SetState(*s)
+	}
+	return ouo
+}
+
+// ClearState clears the value of the "state" field.
+func (ouo *OutboxUpdateOne) ClearState() *OutboxUpdateOne {
+	ouo.mutation.ClearState()
+	return ouo
+}
+
+// SetAttempts sets the "attempts" field.
+func (ouo *OutboxUpdateOne) SetAttempts(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetAttempts()
+	ouo.mutation.SetAttempts(u)
+	return ouo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableAttempts(u *uint32) *OutboxUpdateOne {
+	if u != nil {
+		ouo.SetAttempts(*u)
+	}
+	return ouo
+}
+
+// AddAttempts adds u to the "attempts" field.
+func (ouo *OutboxUpdateOne) AddAttempts(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddAttempts(u)
+	return ouo
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (ouo *OutboxUpdateOne) ClearAttempts() *OutboxUpdateOne {
+	ouo.mutation.ClearAttempts()
+	return ouo
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (ouo *OutboxUpdateOne) SetNextRetryAt(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetNextRetryAt()
+	ouo.mutation.SetNextRetryAt(u)
+	return ouo
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableNextRetryAt(u *uint32) *OutboxUpdateOne {
+	if u != nil {
+		ouo.SetNextRetryAt(*u)
+	}
+	return ouo
+}
+
+// AddNextRetryAt adds u to the "next_retry_at" field.
+func (ouo *OutboxUpdateOne) AddNextRetryAt(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddNextRetryAt(u)
+	return ouo
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (ouo *OutboxUpdateOne) ClearNextRetryAt() *OutboxUpdateOne {
+	ouo.mutation.ClearNextRetryAt()
+	return ouo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (ouo *OutboxUpdateOne) SetDeliveredAt(u uint32) *OutboxUpdateOne {
+	ouo.mutation.ResetDeliveredAt()
+	ouo.mutation.SetDeliveredAt(u)
+	return ouo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableDeliveredAt(u *uint32) *OutboxUpdateOne {
+	if u != nil {
+		ouo.SetDeliveredAt(*u)
+	}
+	return ouo
+}
+
+// AddDeliveredAt adds u to the "delivered_at" field.
+func (ouo *OutboxUpdateOne) AddDeliveredAt(u int32) *OutboxUpdateOne {
+	ouo.mutation.AddDeliveredAt(u)
+	return ouo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (ouo *OutboxUpdateOne) ClearDeliveredAt() *OutboxUpdateOne {
+	ouo.mutation.ClearDeliveredAt()
+	return ouo
+}
+
+// SetLastError sets the "last_error" field.
+func (ouo *OutboxUpdateOne) SetLastError(s string) *OutboxUpdateOne {
+	ouo.mutation.SetLastError(s)
+	return ouo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ouo *OutboxUpdateOne) SetNillableLastError(s *string) *OutboxUpdateOne {
+	if s != nil {
+		ouo.SetLastError(*s)
+	}
+	return ouo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ouo *OutboxUpdateOne) ClearLastError() *OutboxUpdateOne {
+	ouo.mutation.ClearLastError()
+	return ouo
+}
+
+// Mutation returns the OutboxMutation object of the builder.
+func (ouo *OutboxUpdateOne) Mutation() *OutboxMutation {
+	return ouo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OutboxUpdateOne) Select(field string, fields ...string) *OutboxUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Outbox entity.
+func (ouo *OutboxUpdateOne) Save(ctx context.Context) (*Outbox, error) {
+	var (
+		err  error
+		node *Outbox
+	)
+	if err := ouo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(ouo.hooks) == 0 {
+		node, err = ouo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OutboxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ouo.mutation = mutation
+			node, err = ouo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ouo.hooks) - 1; i >= 0; i-- {
+			if ouo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ouo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ouo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Outbox)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from OutboxMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OutboxUpdateOne) SaveX(ctx context.Context) *Outbox {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OutboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OutboxUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ouo *OutboxUpdateOne) defaults() error {
+	if _, ok := ouo.mutation.UpdatedAt(); !ok {
+		if outbox.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized outbox.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := outbox.UpdateDefaultUpdatedAt()
+		ouo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ouo *OutboxUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxUpdateOne {
+	ouo.modifiers = append(ouo.modifiers, modifiers...)
+	return ouo
+}
+
+func (ouo *OutboxUpdateOne) sqlSave(ctx context.Context) (_node *Outbox, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   outbox.Table,
+			Columns: outbox.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: outbox.FieldID,
+			},
+		},
+	}
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Outbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outbox.FieldID)
+		for _, f := range fields {
+			if !outbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldCreatedAt,
+		})
+	}
+	if value, ok := ouo.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldCreatedAt,
+		})
+	}
+	if value, ok := ouo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldUpdatedAt,
+		})
+	}
+	if value, ok := ouo.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldUpdatedAt,
+		})
+	}
+	if value, ok := ouo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeletedAt,
+		})
+	}
+	if value, ok := ouo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeletedAt,
+		})
+	}
+	if value, ok := ouo.mutation.RoutingKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldRoutingKey,
+		})
+	}
+	if ouo.mutation.RoutingKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldRoutingKey,
+		})
+	}
+	if value, ok := ouo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldPayload,
+		})
+	}
+	if ouo.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldPayload,
+		})
+	}
+	if value, ok := ouo.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldState,
+		})
+	}
+	if ouo.mutation.StateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldState,
+		})
+	}
+	if value, ok := ouo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if value, ok := ouo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if ouo.mutation.AttemptsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldAttempts,
+		})
+	}
+	if value, ok := ouo.mutation.NextRetryAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if value, ok := ouo.mutation.AddedNextRetryAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if ouo.mutation.NextRetryAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldNextRetryAt,
+		})
+	}
+	if value, ok := ouo.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if value, ok := ouo.mutation.AddedDeliveredAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if ouo.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: outbox.FieldDeliveredAt,
+		})
+	}
+	if value, ok := ouo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: outbox.FieldLastError,
+		})
+	}
+	if ouo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: outbox.FieldLastError,
+		})
+	}
+	_spec.Modifiers = ouo.modifiers
+	_node = &Outbox{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
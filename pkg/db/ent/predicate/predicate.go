@@ -6,5 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Outbox is the predicate function for outbox builders.
+type Outbox func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
+
+// ReviewDecision is the predicate function for reviewdecision builders.
+type ReviewDecision func(*sql.Selector)
+
+// ReviewEvent is the predicate function for reviewevent builders.
+type ReviewEvent func(*sql.Selector)
+
+// ReviewerPool is the predicate function for reviewerpool builders.
+type ReviewerPool func(*sql.Selector)
+
+// ReviewerPoolMember is the predicate function for reviewerpoolmember builders.
+type ReviewerPoolMember func(*sql.Selector)
@@ -150,6 +150,30 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The OutboxQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OutboxQueryRuleFunc func(context.Context, *ent.OutboxQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OutboxQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OutboxQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OutboxQuery", q)
+}
+
+// The OutboxMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OutboxMutationRuleFunc func(context.Context, *ent.OutboxMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OutboxMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OutboxMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OutboxMutation", m)
+}
+
 // The ReviewQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ReviewQueryRuleFunc func(context.Context, *ent.ReviewQuery) error
@@ -174,6 +198,102 @@ func (f ReviewMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReviewMutation", m)
 }
 
+// The ReviewDecisionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReviewDecisionQueryRuleFunc func(context.Context, *ent.ReviewDecisionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReviewDecisionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReviewDecisionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReviewDecisionQuery", q)
+}
+
+// The ReviewDecisionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReviewDecisionMutationRuleFunc func(context.Context, *ent.ReviewDecisionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReviewDecisionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReviewDecisionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReviewDecisionMutation", m)
+}
+
+// The ReviewEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReviewEventQueryRuleFunc func(context.Context, *ent.ReviewEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReviewEventQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReviewEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReviewEventQuery", q)
+}
+
+// The ReviewEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReviewEventMutationRuleFunc func(context.Context, *ent.ReviewEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReviewEventMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReviewEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReviewEventMutation", m)
+}
+
+// The ReviewerPoolQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReviewerPoolQueryRuleFunc func(context.Context, *ent.ReviewerPoolQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReviewerPoolQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReviewerPoolQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReviewerPoolQuery", q)
+}
+
+// The ReviewerPoolMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReviewerPoolMutationRuleFunc func(context.Context, *ent.ReviewerPoolMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReviewerPoolMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReviewerPoolMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReviewerPoolMutation", m)
+}
+
+// The ReviewerPoolMemberQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReviewerPoolMemberQueryRuleFunc func(context.Context, *ent.ReviewerPoolMemberQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReviewerPoolMemberQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReviewerPoolMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReviewerPoolMemberQuery", q)
+}
+
+// The ReviewerPoolMemberMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReviewerPoolMemberMutationRuleFunc func(context.Context, *ent.ReviewerPoolMemberMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReviewerPoolMemberMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReviewerPoolMemberMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReviewerPoolMemberMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -209,8 +329,18 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q ent.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *ent.OutboxQuery:
+		return q.Filter(), nil
 	case *ent.ReviewQuery:
 		return q.Filter(), nil
+	case *ent.ReviewDecisionQuery:
+		return q.Filter(), nil
+	case *ent.ReviewEventQuery:
+		return q.Filter(), nil
+	case *ent.ReviewerPoolQuery:
+		return q.Filter(), nil
+	case *ent.ReviewerPoolMemberQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected query type %T for query filter", q)
 	}
@@ -218,8 +348,18 @@ func queryFilter(q ent.Query) (Filter, error) {
 
 func mutationFilter(m ent.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *ent.OutboxMutation:
+		return m.Filter(), nil
 	case *ent.ReviewMutation:
 		return m.Filter(), nil
+	case *ent.ReviewDecisionMutation:
+		return m.Filter(), nil
+	case *ent.ReviewEventMutation:
+		return m.Filter(), nil
+	case *ent.ReviewerPoolMutation:
+		return m.Filter(), nil
+	case *ent.ReviewerPoolMemberMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
 	}
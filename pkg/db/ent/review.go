@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -38,6 +39,50 @@ type Review struct {
 	State string `json:"state,omitempty"`
 	// Message holds the value of the "message" field.
 	Message string `json:"message,omitempty"`
+	// Reasons holds the value of the "reasons" field.
+	Reasons []string `json:"reasons,omitempty"`
+	// LeaseExpiresAt holds the value of the "lease_expires_at" field.
+	LeaseExpiresAt uint32 `json:"lease_expires_at,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt uint32 `json:"due_at,omitempty"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt uint32 `json:"escalated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version uint32 `json:"version,omitempty"`
+	// OpenKey holds the value of the "open_key" field.
+	OpenKey *string `json:"open_key,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewQuery when eager-loading is set.
+	Edges ReviewEdges `json:"edges"`
+}
+
+// ReviewEdges holds the relations/edges for other nodes in the graph.
+type ReviewEdges struct {
+	// Events holds the value of the events edge.
+	Events []*ReviewEvent `json:"events,omitempty"`
+	// Decisions holds the value of the decisions edge.
+	Decisions []*ReviewDecision `json:"decisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReviewEdges) EventsOrErr() ([]*ReviewEvent, error) {
+	if e.loadedTypes[0] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// DecisionsOrErr returns the Decisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReviewEdges) DecisionsOrErr() ([]*ReviewDecision, error) {
+	if e.loadedTypes[1] {
+		return e.Decisions, nil
+	}
+	return nil, &NotLoadedError{edge: "decisions"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -45,9 +90,11 @@ func (*Review) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case review.FieldCreatedAt, review.FieldUpdatedAt, review.FieldDeletedAt:
+		case review.FieldReasons:
+			values[i] = new([]byte)
+		case review.FieldCreatedAt, review.FieldUpdatedAt, review.FieldDeletedAt, review.FieldLeaseExpiresAt, review.FieldDueAt, review.FieldEscalatedAt, review.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case review.FieldDomain, review.FieldTrigger, review.FieldObjectType, review.FieldState, review.FieldMessage:
+		case review.FieldDomain, review.FieldTrigger, review.FieldObjectType, review.FieldState, review.FieldMessage, review.FieldOpenKey:
 			values[i] = new(sql.NullString)
 		case review.FieldID, review.FieldAppID, review.FieldReviewerID, review.FieldObjectID:
 			values[i] = new(uuid.UUID)
@@ -138,11 +185,60 @@ func (r *Review) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				r.Message = value.String
 			}
+		case review.FieldReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Reasons); err != nil {
+					return fmt.Errorf("unmarshal field reasons: %w", err)
+				}
+			}
+		case review.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				r.LeaseExpiresAt = uint32(value.Int64)
+			}
+		case review.FieldDueAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				r.DueAt = uint32(value.Int64)
+			}
+		case review.FieldEscalatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalated_at", values[i])
+			} else if value.Valid {
+				r.EscalatedAt = uint32(value.Int64)
+			}
+		case review.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				r.Version = uint32(value.Int64)
+			}
+		case review.FieldOpenKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open_key", values[i])
+			} else if value.Valid {
+				r.OpenKey = new(string)
+				*r.OpenKey = value.String
+			}
 		}
 	}
 	return nil
 }
 
+// QueryEvents queries the "events" edge of the Review entity.
+func (r *Review) QueryEvents() *ReviewEventQuery {
+	return (&ReviewClient{config: r.config}).QueryEvents(r)
+}
+
+// QueryDecisions queries the "decisions" edge of the Review entity.
+func (r *Review) QueryDecisions() *ReviewDecisionQuery {
+	return (&ReviewClient{config: r.config}).QueryDecisions(r)
+}
+
 // Update returns a builder for updating this Review.
 // Note that you need to call Review.Unwrap() before calling this method if this Review
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +294,26 @@ func (r *Review) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("message=")
 	builder.WriteString(r.Message)
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(fmt.Sprintf("%v", r.Reasons))
+	builder.WriteString(", ")
+	builder.WriteString("lease_expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.LeaseExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.DueAt))
+	builder.WriteString(", ")
+	builder.WriteString("escalated_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.EscalatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", r.Version))
+	builder.WriteString(", ")
+	if v := r.OpenKey; v != nil {
+		builder.WriteString("open_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
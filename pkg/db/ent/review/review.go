@@ -34,8 +34,38 @@ const (
 	FieldState = "state"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
+	// FieldReasons holds the string denoting the reasons field in the database.
+	FieldReasons = "reasons"
+	// FieldLeaseExpiresAt holds the string denoting the lease_expires_at field in the database.
+	FieldLeaseExpiresAt = "lease_expires_at"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldOpenKey holds the string denoting the open_key field in the database.
+	FieldOpenKey = "open_key"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeDecisions holds the string denoting the decisions edge name in mutations.
+	EdgeDecisions = "decisions"
 	// Table holds the table name of the review in the database.
 	Table = "reviews"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "review_events"
+	// EventsInverseTable is the table name for the ReviewEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewevent" package.
+	EventsInverseTable = "review_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "review_id"
+	// DecisionsTable is the table that holds the decisions relation/edge.
+	DecisionsTable = "review_decisions"
+	// DecisionsInverseTable is the table name for the ReviewDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewdecision" package.
+	DecisionsInverseTable = "review_decisions"
+	// DecisionsColumn is the table column denoting the decisions relation/edge.
+	DecisionsColumn = "review_id"
 )
 
 // Columns holds all SQL columns for review fields.
@@ -52,6 +82,12 @@ var Columns = []string{
 	FieldObjectType,
 	FieldState,
 	FieldMessage,
+	FieldReasons,
+	FieldLeaseExpiresAt,
+	FieldDueAt,
+	FieldEscalatedAt,
+	FieldVersion,
+	FieldOpenKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,9 +105,8 @@ func ValidColumn(column string) bool {
 // it should be imported in the main as follows:
 //
 //	import _ "github.com/NpoolPlatform/review-manager/pkg/db/ent/runtime"
-//
 var (
-	Hooks  [1]ent.Hook
+	Hooks  [2]ent.Hook
 	Policy ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() uint32
@@ -97,6 +132,14 @@ var (
 	DefaultState string
 	// DefaultMessage holds the default value on creation for the "message" field.
 	DefaultMessage string
+	// DefaultLeaseExpiresAt holds the default value on creation for the "lease_expires_at" field.
+	DefaultLeaseExpiresAt uint32
+	// DefaultDueAt holds the default value on creation for the "due_at" field.
+	DefaultDueAt uint32
+	// DefaultEscalatedAt holds the default value on creation for the "escalated_at" field.
+	DefaultEscalatedAt uint32
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion uint32
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 	"github.com/google/uuid"
 )
 
@@ -177,6 +179,82 @@ func (rc *ReviewCreate) SetNillableMessage(s *string) *ReviewCreate {
 	return rc
 }
 
+// SetReasons sets the "reasons" field.
+func (rc *ReviewCreate) SetReasons(s []string) *ReviewCreate {
+	rc.mutation.SetReasons(s)
+	return rc
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (rc *ReviewCreate) SetLeaseExpiresAt(u uint32) *ReviewCreate {
+	rc.mutation.SetLeaseExpiresAt(u)
+	return rc
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableLeaseExpiresAt(u *uint32) *ReviewCreate {
+	if u != nil {
+		rc.SetLeaseExpiresAt(*u)
+	}
+	return rc
+}
+
+// SetDueAt sets the "due_at" field.
+func (rc *ReviewCreate) SetDueAt(u uint32) *ReviewCreate {
+	rc.mutation.SetDueAt(u)
+	return rc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableDueAt(u *uint32) *ReviewCreate {
+	if u != nil {
+		rc.SetDueAt(*u)
+	}
+	return rc
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (rc *ReviewCreate) SetEscalatedAt(u uint32) *ReviewCreate {
+	rc.mutation.SetEscalatedAt(u)
+	return rc
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableEscalatedAt(u *uint32) *ReviewCreate {
+	if u != nil {
+		rc.SetEscalatedAt(*u)
+	}
+	return rc
+}
+
+// SetVersion sets the "version" field.
+func (rc *ReviewCreate) SetVersion(u uint32) *ReviewCreate {
+	rc.mutation.SetVersion(u)
+	return rc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableVersion(u *uint32) *ReviewCreate {
+	if u != nil {
+		rc.SetVersion(*u)
+	}
+	return rc
+}
+
+// SetOpenKey sets the "open_key" field.
+func (rc *ReviewCreate) SetOpenKey(s string) *ReviewCreate {
+	rc.mutation.SetOpenKey(s)
+	return rc
+}
+
+// SetNillableOpenKey sets the "open_key" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableOpenKey(s *string) *ReviewCreate {
+	if s != nil {
+		rc.SetOpenKey(*s)
+	}
+	return rc
+}
+
 // SetID sets the "id" field.
 func (rc *ReviewCreate) SetID(u uuid.UUID) *ReviewCreate {
 	rc.mutation.SetID(u)
@@ -191,6 +269,36 @@ func (rc *ReviewCreate) SetNillableID(u *uuid.UUID) *ReviewCreate {
 	return rc
 }
 
+// AddEventIDs adds the "events" edge to the ReviewEvent entity by IDs.
+func (rc *ReviewCreate) AddEventIDs(ids ...uuid.UUID) *ReviewCreate {
+	rc.mutation.AddEventIDs(ids...)
+	return rc
+}
+
+// AddEvents adds the "events" edges to the ReviewEvent entity.
+func (rc *ReviewCreate) AddEvents(r ...*ReviewEvent) *ReviewCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddEventIDs(ids...)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ReviewDecision entity by IDs.
+func (rc *ReviewCreate) AddDecisionIDs(ids ...uuid.UUID) *ReviewCreate {
+	rc.mutation.AddDecisionIDs(ids...)
+	return rc
+}
+
+// AddDecisions adds the "decisions" edges to the ReviewDecision entity.
+func (rc *ReviewCreate) AddDecisions(r ...*ReviewDecision) *ReviewCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddDecisionIDs(ids...)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (rc *ReviewCreate) Mutation() *ReviewMutation {
 	return rc.mutation
@@ -332,6 +440,22 @@ func (rc *ReviewCreate) defaults() error {
 		v := review.DefaultMessage
 		rc.mutation.SetMessage(v)
 	}
+	if _, ok := rc.mutation.LeaseExpiresAt(); !ok {
+		v := review.DefaultLeaseExpiresAt
+		rc.mutation.SetLeaseExpiresAt(v)
+	}
+	if _, ok := rc.mutation.DueAt(); !ok {
+		v := review.DefaultDueAt
+		rc.mutation.SetDueAt(v)
+	}
+	if _, ok := rc.mutation.EscalatedAt(); !ok {
+		v := review.DefaultEscalatedAt
+		rc.mutation.SetEscalatedAt(v)
+	}
+	if _, ok := rc.mutation.Version(); !ok {
+		v := review.DefaultVersion
+		rc.mutation.SetVersion(v)
+	}
 	if _, ok := rc.mutation.ID(); !ok {
 		if review.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized review.DefaultID (forgotten import ent/runtime?)")
@@ -478,6 +602,92 @@ func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		})
 		_node.Message = value
 	}
+	if value, ok := rc.mutation.Reasons(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: review.FieldReasons,
+		})
+		_node.Reasons = value
+	}
+	if value, ok := rc.mutation.LeaseExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldLeaseExpiresAt,
+		})
+		_node.LeaseExpiresAt = value
+	}
+	if value, ok := rc.mutation.DueAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldDueAt,
+		})
+		_node.DueAt = value
+	}
+	if value, ok := rc.mutation.EscalatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldEscalatedAt,
+		})
+		_node.EscalatedAt = value
+	}
+	if value, ok := rc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := rc.mutation.OpenKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: review.FieldOpenKey,
+		})
+		_node.OpenKey = &value
+	}
+	if nodes := rc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -497,7 +707,6 @@ func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 //			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
-//
 func (rc *ReviewCreate) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertOne {
 	rc.conflict = opts
 	return &ReviewUpsertOne{
@@ -511,7 +720,6 @@ func (rc *ReviewCreate) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertOne
 //	client.Review.Create().
 //		OnConflict(sql.ConflictColumns(columns...)).
 //		Exec(ctx)
-//
 func (rc *ReviewCreate) OnConflictColumns(columns ...string) *ReviewUpsertOne {
 	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
 	return &ReviewUpsertOne{
@@ -730,6 +938,138 @@ func (u *ReviewUpsert) ClearMessage() *ReviewUpsert {
 	return u
 }
 
+// SetReasons sets the "reasons" field.
+func (u *ReviewUpsert) SetReasons(v []string) *ReviewUpsert {
+	u.Set(review.FieldReasons, v)
+	return u
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateReasons() *ReviewUpsert {
+	u.SetExcluded(review.FieldReasons)
+	return u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewUpsert) ClearReasons() *ReviewUpsert {
+	u.SetNull(review.FieldReasons)
+	return u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ReviewUpsert) SetLeaseExpiresAt(v uint32) *ReviewUpsert {
+	u.Set(review.FieldLeaseExpiresAt, v)
+	return u
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateLeaseExpiresAt() *ReviewUpsert {
+	u.SetExcluded(review.FieldLeaseExpiresAt)
+	return u
+}
+
+// AddLeaseExpiresAt adds v to the "lease_expires_at" field.
+func (u *ReviewUpsert) AddLeaseExpiresAt(v uint32) *ReviewUpsert {
+	u.Add(review.FieldLeaseExpiresAt, v)
+	return u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ReviewUpsert) ClearLeaseExpiresAt() *ReviewUpsert {
+	u.SetNull(review.FieldLeaseExpiresAt)
+	return u
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *ReviewUpsert) SetDueAt(v uint32) *ReviewUpsert {
+	u.Set(review.FieldDueAt, v)
+	return u
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateDueAt() *ReviewUpsert {
+	u.SetExcluded(review.FieldDueAt)
+	return u
+}
+
+// AddDueAt adds v to the "due_at" field.
+func (u *ReviewUpsert) AddDueAt(v uint32) *ReviewUpsert {
+	u.Add(review.FieldDueAt, v)
+	return u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *ReviewUpsert) ClearDueAt() *ReviewUpsert {
+	u.SetNull(review.FieldDueAt)
+	return u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *ReviewUpsert) SetEscalatedAt(v uint32) *ReviewUpsert {
+	u.Set(review.FieldEscalatedAt, v)
+	return u
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateEscalatedAt() *ReviewUpsert {
+	u.SetExcluded(review.FieldEscalatedAt)
+	return u
+}
+
+// AddEscalatedAt adds v to the "escalated_at" field.
+func (u *ReviewUpsert) AddEscalatedAt(v uint32) *ReviewUpsert {
+	u.Add(review.FieldEscalatedAt, v)
+	return u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *ReviewUpsert) ClearEscalatedAt() *ReviewUpsert {
+	u.SetNull(review.FieldEscalatedAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ReviewUpsert) SetVersion(v uint32) *ReviewUpsert {
+	u.Set(review.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateVersion() *ReviewUpsert {
+	u.SetExcluded(review.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ReviewUpsert) AddVersion(v uint32) *ReviewUpsert {
+	u.Add(review.FieldVersion, v)
+	return u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *ReviewUpsert) ClearVersion() *ReviewUpsert {
+	u.SetNull(review.FieldVersion)
+	return u
+}
+
+// SetOpenKey sets the "open_key" field.
+func (u *ReviewUpsert) SetOpenKey(v string) *ReviewUpsert {
+	u.Set(review.FieldOpenKey, v)
+	return u
+}
+
+// UpdateOpenKey sets the "open_key" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateOpenKey() *ReviewUpsert {
+	u.SetExcluded(review.FieldOpenKey)
+	return u
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (u *ReviewUpsert) ClearOpenKey() *ReviewUpsert {
+	u.SetNull(review.FieldOpenKey)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -741,7 +1081,6 @@ func (u *ReviewUpsert) ClearMessage() *ReviewUpsert {
 //			}),
 //		).
 //		Exec(ctx)
-//
 func (u *ReviewUpsertOne) UpdateNewValues() *ReviewUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
 	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
@@ -755,10 +1094,9 @@ func (u *ReviewUpsertOne) UpdateNewValues() *ReviewUpsertOne {
 // Ignore sets each column to itself in case of conflict.
 // Using this option is equivalent to using:
 //
-//  client.Review.Create().
-//      OnConflict(sql.ResolveWithIgnore()).
-//      Exec(ctx)
-//
+//	client.Review.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
 func (u *ReviewUpsertOne) Ignore() *ReviewUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
 	return u
@@ -1011,6 +1349,160 @@ func (u *ReviewUpsertOne) ClearMessage() *ReviewUpsertOne {
 	})
 }
 
+// SetReasons sets the "reasons" field.
+func (u *ReviewUpsertOne) SetReasons(v []string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateReasons() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewUpsertOne) ClearReasons() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ReviewUpsertOne) SetLeaseExpiresAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// AddLeaseExpiresAt adds v to the "lease_expires_at" field.
+func (u *ReviewUpsertOne) AddLeaseExpiresAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateLeaseExpiresAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ReviewUpsertOne) ClearLeaseExpiresAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *ReviewUpsertOne) SetDueAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// AddDueAt adds v to the "due_at" field.
+func (u *ReviewUpsertOne) AddDueAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateDueAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *ReviewUpsertOne) ClearDueAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *ReviewUpsertOne) SetEscalatedAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// AddEscalatedAt adds v to the "escalated_at" field.
+func (u *ReviewUpsertOne) AddEscalatedAt(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateEscalatedAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *ReviewUpsertOne) ClearEscalatedAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ReviewUpsertOne) SetVersion(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ReviewUpsertOne) AddVersion(v uint32) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateVersion() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *ReviewUpsertOne) ClearVersion() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetOpenKey sets the "open_key" field.
+func (u *ReviewUpsertOne) SetOpenKey(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetOpenKey(v)
+	})
+}
+
+// UpdateOpenKey sets the "open_key" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateOpenKey() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateOpenKey()
+	})
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (u *ReviewUpsertOne) ClearOpenKey() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearOpenKey()
+	})
+}
+
 // Exec executes the query.
 func (u *ReviewUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1146,7 +1638,6 @@ func (rcb *ReviewCreateBulk) ExecX(ctx context.Context) {
 //			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
-//
 func (rcb *ReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertBulk {
 	rcb.conflict = opts
 	return &ReviewUpsertBulk{
@@ -1160,7 +1651,6 @@ func (rcb *ReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewUpser
 //	client.Review.Create().
 //		OnConflict(sql.ConflictColumns(columns...)).
 //		Exec(ctx)
-//
 func (rcb *ReviewCreateBulk) OnConflictColumns(columns ...string) *ReviewUpsertBulk {
 	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
 	return &ReviewUpsertBulk{
@@ -1185,7 +1675,6 @@ type ReviewUpsertBulk struct {
 //			}),
 //		).
 //		Exec(ctx)
-//
 func (u *ReviewUpsertBulk) UpdateNewValues() *ReviewUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
 	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
@@ -1205,7 +1694,6 @@ func (u *ReviewUpsertBulk) UpdateNewValues() *ReviewUpsertBulk {
 //	client.Review.Create().
 //		OnConflict(sql.ResolveWithIgnore()).
 //		Exec(ctx)
-//
 func (u *ReviewUpsertBulk) Ignore() *ReviewUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
 	return u
@@ -1458,6 +1946,160 @@ func (u *ReviewUpsertBulk) ClearMessage() *ReviewUpsertBulk {
 	})
 }
 
+// SetReasons sets the "reasons" field.
+func (u *ReviewUpsertBulk) SetReasons(v []string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateReasons() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewUpsertBulk) ClearReasons() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ReviewUpsertBulk) SetLeaseExpiresAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// AddLeaseExpiresAt adds v to the "lease_expires_at" field.
+func (u *ReviewUpsertBulk) AddLeaseExpiresAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateLeaseExpiresAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ReviewUpsertBulk) ClearLeaseExpiresAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *ReviewUpsertBulk) SetDueAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// AddDueAt adds v to the "due_at" field.
+func (u *ReviewUpsertBulk) AddDueAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateDueAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *ReviewUpsertBulk) ClearDueAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *ReviewUpsertBulk) SetEscalatedAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// AddEscalatedAt adds v to the "escalated_at" field.
+func (u *ReviewUpsertBulk) AddEscalatedAt(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateEscalatedAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *ReviewUpsertBulk) ClearEscalatedAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ReviewUpsertBulk) SetVersion(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ReviewUpsertBulk) AddVersion(v uint32) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateVersion() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *ReviewUpsertBulk) ClearVersion() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetOpenKey sets the "open_key" field.
+func (u *ReviewUpsertBulk) SetOpenKey(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetOpenKey(v)
+	})
+}
+
+// UpdateOpenKey sets the "open_key" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateOpenKey() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateOpenKey()
+	})
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (u *ReviewUpsertBulk) ClearOpenKey() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearOpenKey()
+	})
+}
+
 // Exec executes the query.
 func (u *ReviewUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
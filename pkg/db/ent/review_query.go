@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -14,19 +15,23 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 	"github.com/google/uuid"
 )
 
 // ReviewQuery is the builder for querying Review entities.
 type ReviewQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Review
-	modifiers  []func(*sql.Selector)
+	limit         *int
+	offset        *int
+	unique        *bool
+	order         []OrderFunc
+	fields        []string
+	predicates    []predicate.Review
+	withEvents    *ReviewEventQuery
+	withDecisions *ReviewDecisionQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -63,6 +68,50 @@ func (rq *ReviewQuery) Order(o ...OrderFunc) *ReviewQuery {
 	return rq
 }
 
+// QueryEvents chains the current query on the "events" edge.
+func (rq *ReviewQuery) QueryEvents() *ReviewEventQuery {
+	query := &ReviewEventQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, selector),
+			sqlgraph.To(reviewevent.Table, reviewevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, review.EventsTable, review.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDecisions chains the current query on the "decisions" edge.
+func (rq *ReviewQuery) QueryDecisions() *ReviewDecisionQuery {
+	query := &ReviewDecisionQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, selector),
+			sqlgraph.To(reviewdecision.Table, reviewdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, review.DecisionsTable, review.DecisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Review entity from the query.
 // Returns a *NotFoundError when no Review was found.
 func (rq *ReviewQuery) First(ctx context.Context) (*Review, error) {
@@ -239,11 +288,13 @@ func (rq *ReviewQuery) Clone() *ReviewQuery {
 		return nil
 	}
 	return &ReviewQuery{
-		config:     rq.config,
-		limit:      rq.limit,
-		offset:     rq.offset,
-		order:      append([]OrderFunc{}, rq.order...),
-		predicates: append([]predicate.Review{}, rq.predicates...),
+		config:        rq.config,
+		limit:         rq.limit,
+		offset:        rq.offset,
+		order:         append([]OrderFunc{}, rq.order...),
+		predicates:    append([]predicate.Review{}, rq.predicates...),
+		withEvents:    rq.withEvents.Clone(),
+		withDecisions: rq.withDecisions.Clone(),
 		// clone intermediate query.
 		sql:    rq.sql.Clone(),
 		path:   rq.path,
@@ -251,6 +302,28 @@ func (rq *ReviewQuery) Clone() *ReviewQuery {
 	}
 }
 
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ReviewQuery) WithEvents(opts ...func(*ReviewEventQuery)) *ReviewQuery {
+	query := &ReviewEventQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withEvents = query
+	return rq
+}
+
+// WithDecisions tells the query-builder to eager-load the nodes that are connected to
+// the "decisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ReviewQuery) WithDecisions(opts ...func(*ReviewDecisionQuery)) *ReviewQuery {
+	query := &ReviewDecisionQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withDecisions = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -265,7 +338,6 @@ func (rq *ReviewQuery) Clone() *ReviewQuery {
 //		GroupBy(review.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (rq *ReviewQuery) GroupBy(field string, fields ...string) *ReviewGroupBy {
 	grbuild := &ReviewGroupBy{config: rq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -292,7 +364,6 @@ func (rq *ReviewQuery) GroupBy(field string, fields ...string) *ReviewGroupBy {
 //	client.Review.Query().
 //		Select(review.FieldCreatedAt).
 //		Scan(ctx, &v)
-//
 func (rq *ReviewQuery) Select(fields ...string) *ReviewSelect {
 	rq.fields = append(rq.fields, fields...)
 	selbuild := &ReviewSelect{ReviewQuery: rq}
@@ -325,8 +396,12 @@ func (rq *ReviewQuery) prepareQuery(ctx context.Context) error {
 
 func (rq *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Review, error) {
 	var (
-		nodes = []*Review{}
-		_spec = rq.querySpec()
+		nodes       = []*Review{}
+		_spec       = rq.querySpec()
+		loadedTypes = [2]bool{
+			rq.withEvents != nil,
+			rq.withDecisions != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Review).scanValues(nil, columns)
@@ -334,6 +409,7 @@ func (rq *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revie
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Review{config: rq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(rq.modifiers) > 0 {
@@ -348,9 +424,78 @@ func (rq *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revie
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := rq.withEvents; query != nil {
+		if err := rq.loadEvents(ctx, query, nodes,
+			func(n *Review) { n.Edges.Events = []*ReviewEvent{} },
+			func(n *Review, e *ReviewEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withDecisions; query != nil {
+		if err := rq.loadDecisions(ctx, query, nodes,
+			func(n *Review) { n.Edges.Decisions = []*ReviewDecision{} },
+			func(n *Review, e *ReviewDecision) { n.Edges.Decisions = append(n.Edges.Decisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (rq *ReviewQuery) loadEvents(ctx context.Context, query *ReviewEventQuery, nodes []*Review, init func(*Review), assign func(*Review, *ReviewEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Review)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(review.EventsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReviewID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "review_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ReviewQuery) loadDecisions(ctx context.Context, query *ReviewDecisionQuery, nodes []*Review, init func(*Review), assign func(*Review, *ReviewDecision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Review)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ReviewDecision(func(s *sql.Selector) {
+		s.Where(sql.InValues(review.DecisionsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReviewID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "review_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (rq *ReviewQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
 	if len(rq.modifiers) > 0 {
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 	"github.com/google/uuid"
 )
 
@@ -244,11 +246,223 @@ func (ru *ReviewUpdate) ClearMessage() *ReviewUpdate {
 	return ru
 }
 
+// SetReasons sets the "reasons" field.
+func (ru *ReviewUpdate) SetReasons(s []string) *ReviewUpdate {
+	ru.mutation.SetReasons(s)
+	return ru
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (ru *ReviewUpdate) ClearReasons() *ReviewUpdate {
+	ru.mutation.ClearReasons()
+	return ru
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (ru *ReviewUpdate) SetLeaseExpiresAt(u uint32) *ReviewUpdate {
+	ru.mutation.ResetLeaseExpiresAt()
+	ru.mutation.SetLeaseExpiresAt(u)
+	return ru
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableLeaseExpiresAt(u *uint32) *ReviewUpdate {
+	if u != nil {
+		ru.SetLeaseExpiresAt(*u)
+	}
+	return ru
+}
+
+// AddLeaseExpiresAt adds u to the "lease_expires_at" field.
+func (ru *ReviewUpdate) AddLeaseExpiresAt(u int32) *ReviewUpdate {
+	ru.mutation.AddLeaseExpiresAt(u)
+	return ru
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (ru *ReviewUpdate) ClearLeaseExpiresAt() *ReviewUpdate {
+	ru.mutation.ClearLeaseExpiresAt()
+	return ru
+}
+
+// SetDueAt sets the "due_at" field.
+func (ru *ReviewUpdate) SetDueAt(u uint32) *ReviewUpdate {
+	ru.mutation.ResetDueAt()
+	ru.mutation.SetDueAt(u)
+	return ru
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableDueAt(u *uint32) *ReviewUpdate {
+	if u != nil {
+		ru.SetDueAt(*u)
+	}
+	return ru
+}
+
+// AddDueAt adds u to the "due_at" field.
+func (ru *ReviewUpdate) AddDueAt(u int32) *ReviewUpdate {
+	ru.mutation.AddDueAt(u)
+	return ru
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (ru *ReviewUpdate) ClearDueAt() *ReviewUpdate {
+	ru.mutation.ClearDueAt()
+	return ru
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (ru *ReviewUpdate) SetEscalatedAt(u uint32) *ReviewUpdate {
+	ru.mutation.ResetEscalatedAt()
+	ru.mutation.SetEscalatedAt(u)
+	return ru
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableEscalatedAt(u *uint32) *ReviewUpdate {
+	if u != nil {
+		ru.SetEscalatedAt(*u)
+	}
+	return ru
+}
+
+// AddEscalatedAt adds u to the "escalated_at" field.
+func (ru *ReviewUpdate) AddEscalatedAt(u int32) *ReviewUpdate {
+	ru.mutation.AddEscalatedAt(u)
+	return ru
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (ru *ReviewUpdate) ClearEscalatedAt() *ReviewUpdate {
+	ru.mutation.ClearEscalatedAt()
+	return ru
+}
+
+// SetVersion sets the "version" field.
+func (ru *ReviewUpdate) SetVersion(u uint32) *ReviewUpdate {
+	ru.mutation.ResetVersion()
+	ru.mutation.SetVersion(u)
+	return ru
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableVersion(u *uint32) *ReviewUpdate {
+	if u != nil {
+		ru.SetVersion(*u)
+	}
+	return ru
+}
+
+// AddVersion adds u to the "version" field.
+func (ru *ReviewUpdate) AddVersion(u int32) *ReviewUpdate {
+	ru.mutation.AddVersion(u)
+	return ru
+}
+
+// ClearVersion clears the value of the "version" field.
+func (ru *ReviewUpdate) ClearVersion() *ReviewUpdate {
+	ru.mutation.ClearVersion()
+	return ru
+}
+
+// SetOpenKey sets the "open_key" field.
+func (ru *ReviewUpdate) SetOpenKey(s string) *ReviewUpdate {
+	ru.mutation.SetOpenKey(s)
+	return ru
+}
+
+// SetNillableOpenKey sets the "open_key" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableOpenKey(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetOpenKey(*s)
+	}
+	return ru
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (ru *ReviewUpdate) ClearOpenKey() *ReviewUpdate {
+	ru.mutation.ClearOpenKey()
+	return ru
+}
+
+// AddEventIDs adds the "events" edge to the ReviewEvent entity by IDs.
+func (ru *ReviewUpdate) AddEventIDs(ids ...uuid.UUID) *ReviewUpdate {
+	ru.mutation.AddEventIDs(ids...)
+	return ru
+}
+
+// AddEvents adds the "events" edges to the ReviewEvent entity.
+func (ru *ReviewUpdate) AddEvents(r ...*ReviewEvent) *ReviewUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddEventIDs(ids...)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ReviewDecision entity by IDs.
+func (ru *ReviewUpdate) AddDecisionIDs(ids ...uuid.UUID) *ReviewUpdate {
+	ru.mutation.AddDecisionIDs(ids...)
+	return ru
+}
+
+// AddDecisions adds the "decisions" edges to the ReviewDecision entity.
+func (ru *ReviewUpdate) AddDecisions(r ...*ReviewDecision) *ReviewUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddDecisionIDs(ids...)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (ru *ReviewUpdate) Mutation() *ReviewMutation {
 	return ru.mutation
 }
 
+// ClearEvents clears all "events" edges to the ReviewEvent entity.
+func (ru *ReviewUpdate) ClearEvents() *ReviewUpdate {
+	ru.mutation.ClearEvents()
+	return ru
+}
+
+// RemoveEventIDs removes the "events" edge to ReviewEvent entities by IDs.
+func (ru *ReviewUpdate) RemoveEventIDs(ids ...uuid.UUID) *ReviewUpdate {
+	ru.mutation.RemoveEventIDs(ids...)
+	return ru
+}
+
+// RemoveEvents removes "events" edges to ReviewEvent entities.
+func (ru *ReviewUpdate) RemoveEvents(r ...*ReviewEvent) *ReviewUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveEventIDs(ids...)
+}
+
+// ClearDecisions clears all "decisions" edges to the ReviewDecision entity.
+func (ru *ReviewUpdate) ClearDecisions() *ReviewUpdate {
+	ru.mutation.ClearDecisions()
+	return ru
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to ReviewDecision entities by IDs.
+func (ru *ReviewUpdate) RemoveDecisionIDs(ids ...uuid.UUID) *ReviewUpdate {
+	ru.mutation.RemoveDecisionIDs(ids...)
+	return ru
+}
+
+// RemoveDecisions removes "decisions" edges to ReviewDecision entities.
+func (ru *ReviewUpdate) RemoveDecisions(r ...*ReviewDecision) *ReviewUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveDecisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *ReviewUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -488,6 +702,220 @@ func (ru *ReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: review.FieldMessage,
 		})
 	}
+	if value, ok := ru.mutation.Reasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: review.FieldReasons,
+		})
+	}
+	if ru.mutation.ReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: review.FieldReasons,
+		})
+	}
+	if value, ok := ru.mutation.LeaseExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if value, ok := ru.mutation.AddedLeaseExpiresAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if ru.mutation.LeaseExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if value, ok := ru.mutation.DueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldDueAt,
+		})
+	}
+	if value, ok := ru.mutation.AddedDueAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldDueAt,
+		})
+	}
+	if ru.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldDueAt,
+		})
+	}
+	if value, ok := ru.mutation.EscalatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if value, ok := ru.mutation.AddedEscalatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if ru.mutation.EscalatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if value, ok := ru.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldVersion,
+		})
+	}
+	if value, ok := ru.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldVersion,
+		})
+	}
+	if ru.mutation.VersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldVersion,
+		})
+	}
+	if value, ok := ru.mutation.OpenKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: review.FieldOpenKey,
+		})
+	}
+	if ru.mutation.OpenKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: review.FieldOpenKey,
+		})
+	}
+	if ru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !ru.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Modifiers = ru.modifiers
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -724,11 +1152,223 @@ func (ruo *ReviewUpdateOne) ClearMessage() *ReviewUpdateOne {
 	return ruo
 }
 
+// SetReasons sets the "reasons" field.
+func (ruo *ReviewUpdateOne) SetReasons(s []string) *ReviewUpdateOne {
+	ruo.mutation.SetReasons(s)
+	return ruo
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (ruo *ReviewUpdateOne) ClearReasons() *ReviewUpdateOne {
+	ruo.mutation.ClearReasons()
+	return ruo
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (ruo *ReviewUpdateOne) SetLeaseExpiresAt(u uint32) *ReviewUpdateOne {
+	ruo.mutation.ResetLeaseExpiresAt()
+	ruo.mutation.SetLeaseExpiresAt(u)
+	return ruo
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableLeaseExpiresAt(u *uint32) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetLeaseExpiresAt(*u)
+	}
+	return ruo
+}
+
+// AddLeaseExpiresAt adds u to the "lease_expires_at" field.
+func (ruo *ReviewUpdateOne) AddLeaseExpiresAt(u int32) *ReviewUpdateOne {
+	ruo.mutation.AddLeaseExpiresAt(u)
+	return ruo
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (ruo *ReviewUpdateOne) ClearLeaseExpiresAt() *ReviewUpdateOne {
+	ruo.mutation.ClearLeaseExpiresAt()
+	return ruo
+}
+
+// SetDueAt sets the "due_at" field.
+func (ruo *ReviewUpdateOne) SetDueAt(u uint32) *ReviewUpdateOne {
+	ruo.mutation.ResetDueAt()
+	ruo.mutation.SetDueAt(u)
+	return ruo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableDueAt(u *uint32) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetDueAt(*u)
+	}
+	return ruo
+}
+
+// AddDueAt adds u to the "due_at" field.
+func (ruo *ReviewUpdateOne) AddDueAt(u int32) *ReviewUpdateOne {
+	ruo.mutation.AddDueAt(u)
+	return ruo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (ruo *ReviewUpdateOne) ClearDueAt() *ReviewUpdateOne {
+	ruo.mutation.ClearDueAt()
+	return ruo
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (ruo *ReviewUpdateOne) SetEscalatedAt(u uint32) *ReviewUpdateOne {
+	ruo.mutation.ResetEscalatedAt()
+	ruo.mutation.SetEscalatedAt(u)
+	return ruo
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableEscalatedAt(u *uint32) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetEscalatedAt(*u)
+	}
+	return ruo
+}
+
+// AddEscalatedAt adds u to the "escalated_at" field.
+func (ruo *ReviewUpdateOne) AddEscalatedAt(u int32) *ReviewUpdateOne {
+	ruo.mutation.AddEscalatedAt(u)
+	return ruo
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (ruo *ReviewUpdateOne) ClearEscalatedAt() *ReviewUpdateOne {
+	ruo.mutation.ClearEscalatedAt()
+	return ruo
+}
+
+// SetVersion sets the "version" field.
+func (ruo *ReviewUpdateOne) SetVersion(u uint32) *ReviewUpdateOne {
+	ruo.mutation.ResetVersion()
+	ruo.mutation.SetVersion(u)
+	return ruo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableVersion(u *uint32) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetVersion(*u)
+	}
+	return ruo
+}
+
+// AddVersion adds u to the "version" field.
+func (ruo *ReviewUpdateOne) AddVersion(u int32) *ReviewUpdateOne {
+	ruo.mutation.AddVersion(u)
+	return ruo
+}
+
+// ClearVersion clears the value of the "version" field.
+func (ruo *ReviewUpdateOne) ClearVersion() *ReviewUpdateOne {
+	ruo.mutation.ClearVersion()
+	return ruo
+}
+
+// SetOpenKey sets the "open_key" field.
+func (ruo *ReviewUpdateOne) SetOpenKey(s string) *ReviewUpdateOne {
+	ruo.mutation.SetOpenKey(s)
+	return ruo
+}
+
+// SetNillableOpenKey sets the "open_key" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableOpenKey(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetOpenKey(*s)
+	}
+	return ruo
+}
+
+// ClearOpenKey clears the value of the "open_key" field.
+func (ruo *ReviewUpdateOne) ClearOpenKey() *ReviewUpdateOne {
+	ruo.mutation.ClearOpenKey()
+	return ruo
+}
+
+// AddEventIDs adds the "events" edge to the ReviewEvent entity by IDs.
+func (ruo *ReviewUpdateOne) AddEventIDs(ids ...uuid.UUID) *ReviewUpdateOne {
+	ruo.mutation.AddEventIDs(ids...)
+	return ruo
+}
+
+// AddEvents adds the "events" edges to the ReviewEvent entity.
+func (ruo *ReviewUpdateOne) AddEvents(r ...*ReviewEvent) *ReviewUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddEventIDs(ids...)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ReviewDecision entity by IDs.
+func (ruo *ReviewUpdateOne) AddDecisionIDs(ids ...uuid.UUID) *ReviewUpdateOne {
+	ruo.mutation.AddDecisionIDs(ids...)
+	return ruo
+}
+
+// AddDecisions adds the "decisions" edges to the ReviewDecision entity.
+func (ruo *ReviewUpdateOne) AddDecisions(r ...*ReviewDecision) *ReviewUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddDecisionIDs(ids...)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (ruo *ReviewUpdateOne) Mutation() *ReviewMutation {
 	return ruo.mutation
 }
 
+// ClearEvents clears all "events" edges to the ReviewEvent entity.
+func (ruo *ReviewUpdateOne) ClearEvents() *ReviewUpdateOne {
+	ruo.mutation.ClearEvents()
+	return ruo
+}
+
+// RemoveEventIDs removes the "events" edge to ReviewEvent entities by IDs.
+func (ruo *ReviewUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *ReviewUpdateOne {
+	ruo.mutation.RemoveEventIDs(ids...)
+	return ruo
+}
+
+// RemoveEvents removes "events" edges to ReviewEvent entities.
+func (ruo *ReviewUpdateOne) RemoveEvents(r ...*ReviewEvent) *ReviewUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveEventIDs(ids...)
+}
+
+// ClearDecisions clears all "decisions" edges to the ReviewDecision entity.
+func (ruo *ReviewUpdateOne) ClearDecisions() *ReviewUpdateOne {
+	ruo.mutation.ClearDecisions()
+	return ruo
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to ReviewDecision entities by IDs.
+func (ruo *ReviewUpdateOne) RemoveDecisionIDs(ids ...uuid.UUID) *ReviewUpdateOne {
+	ruo.mutation.RemoveDecisionIDs(ids...)
+	return ruo
+}
+
+// RemoveDecisions removes "decisions" edges to ReviewDecision entities.
+func (ruo *ReviewUpdateOne) RemoveDecisions(r ...*ReviewDecision) *ReviewUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveDecisionIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (ruo *ReviewUpdateOne) Select(field string, fields ...string) *ReviewUpdateOne {
@@ -998,6 +1638,220 @@ func (ruo *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err err
 			Column: review.FieldMessage,
 		})
 	}
+	if value, ok := ruo.mutation.Reasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: review.FieldReasons,
+		})
+	}
+	if ruo.mutation.ReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: review.FieldReasons,
+		})
+	}
+	if value, ok := ruo.mutation.LeaseExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if value, ok := ruo.mutation.AddedLeaseExpiresAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if ruo.mutation.LeaseExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldLeaseExpiresAt,
+		})
+	}
+	if value, ok := ruo.mutation.DueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldDueAt,
+		})
+	}
+	if value, ok := ruo.mutation.AddedDueAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldDueAt,
+		})
+	}
+	if ruo.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldDueAt,
+		})
+	}
+	if value, ok := ruo.mutation.EscalatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if value, ok := ruo.mutation.AddedEscalatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if ruo.mutation.EscalatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldEscalatedAt,
+		})
+	}
+	if value, ok := ruo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldVersion,
+		})
+	}
+	if value, ok := ruo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: review.FieldVersion,
+		})
+	}
+	if ruo.mutation.VersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: review.FieldVersion,
+		})
+	}
+	if value, ok := ruo.mutation.OpenKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: review.FieldOpenKey,
+		})
+	}
+	if ruo.mutation.OpenKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: review.FieldOpenKey,
+		})
+	}
+	if ruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.EventsTable,
+			Columns: []string{review.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !ruo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   review.DecisionsTable,
+			Columns: []string{review.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewdecision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Modifiers = ruo.modifiers
 	_node = &Review{config: ruo.config}
 	_spec.Assign = _node.assignValues
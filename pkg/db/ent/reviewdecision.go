@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/google/uuid"
+)
+
+// ReviewDecision is the model entity for the ReviewDecision schema.
+type ReviewDecision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt uint32 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt uint32 `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt uint32 `json:"deleted_at,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID uuid.UUID `json:"app_id,omitempty"`
+	// ReviewID holds the value of the "review_id" field.
+	ReviewID uuid.UUID `json:"review_id,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID uuid.UUID `json:"reviewer_id,omitempty"`
+	// Decision holds the value of the "decision" field.
+	Decision string `json:"decision,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Reasons holds the value of the "reasons" field.
+	Reasons []string `json:"reasons,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewDecisionQuery when eager-loading is set.
+	Edges ReviewDecisionEdges `json:"edges"`
+}
+
+// ReviewDecisionEdges holds the relations/edges for other nodes in the graph.
+type ReviewDecisionEdges struct {
+	// Review holds the value of the review edge.
+	Review *Review `json:"review,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReviewOrErr returns the Review value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewDecisionEdges) ReviewOrErr() (*Review, error) {
+	if e.loadedTypes[0] {
+		if e.Review == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: review.Label}
+		}
+		return e.Review, nil
+	}
+	return nil, &NotLoadedError{edge: "review"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReviewDecision) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewdecision.FieldReasons:
+			values[i] = new([]byte)
+		case reviewdecision.FieldCreatedAt, reviewdecision.FieldUpdatedAt, reviewdecision.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case reviewdecision.FieldDecision, reviewdecision.FieldMessage:
+			values[i] = new(sql.NullString)
+		case reviewdecision.FieldID, reviewdecision.FieldAppID, reviewdecision.FieldReviewID, reviewdecision.FieldReviewerID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ReviewDecision", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReviewDecision fields.
+func (rd *ReviewDecision) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewdecision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rd.ID = *value
+			}
+		case reviewdecision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rd.CreatedAt = uint32(value.Int64)
+			}
+		case reviewdecision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rd.UpdatedAt = uint32(value.Int64)
+			}
+		case reviewdecision.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rd.DeletedAt = uint32(value.Int64)
+			}
+		case reviewdecision.FieldAppID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value != nil {
+				rd.AppID = *value
+			}
+		case reviewdecision.FieldReviewID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field review_id", values[i])
+			} else if value != nil {
+				rd.ReviewID = *value
+			}
+		case reviewdecision.FieldReviewerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value != nil {
+				rd.ReviewerID = *value
+			}
+		case reviewdecision.FieldDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision", values[i])
+			} else if value.Valid {
+				rd.Decision = value.String
+			}
+		case reviewdecision.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				rd.Message = value.String
+			}
+		case reviewdecision.FieldReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rd.Reasons); err != nil {
+					return fmt.Errorf("unmarshal field reasons: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// QueryReview queries the "review" edge of the ReviewDecision entity.
+func (rd *ReviewDecision) QueryReview() *ReviewQuery {
+	return (&ReviewDecisionClient{config: rd.config}).QueryReview(rd)
+}
+
+// Update returns a builder for updating this ReviewDecision.
+// Note that you need to call ReviewDecision.Unwrap() before calling this method if this ReviewDecision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rd *ReviewDecision) Update() *ReviewDecisionUpdateOne {
+	return (&ReviewDecisionClient{config: rd.config}).UpdateOne(rd)
+}
+
+// Unwrap unwraps the ReviewDecision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rd *ReviewDecision) Unwrap() *ReviewDecision {
+	_tx, ok := rd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReviewDecision is not a transactional entity")
+	}
+	rd.config.driver = _tx.drv
+	return rd
+}
+
+// String implements the fmt.Stringer.
+func (rd *ReviewDecision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReviewDecision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", rd.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", rd.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", rd.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(fmt.Sprintf("%v", rd.AppID))
+	builder.WriteString(", ")
+	builder.WriteString("review_id=")
+	builder.WriteString(fmt.Sprintf("%v", rd.ReviewID))
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_id=")
+	builder.WriteString(fmt.Sprintf("%v", rd.ReviewerID))
+	builder.WriteString(", ")
+	builder.WriteString("decision=")
+	builder.WriteString(rd.Decision)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(rd.Message)
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Reasons))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReviewDecisions is a parsable slice of ReviewDecision.
+type ReviewDecisions []*ReviewDecision
+
+func (rd ReviewDecisions) config(cfg config) {
+	for _i := range rd {
+		rd[_i].config = cfg
+	}
+}
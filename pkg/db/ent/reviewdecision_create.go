@@ -0,0 +1,1215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/google/uuid"
+)
+
+// ReviewDecisionCreate is the builder for creating a ReviewDecision entity.
+type ReviewDecisionCreate struct {
+	config
+	mutation *ReviewDecisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rdc *ReviewDecisionCreate) SetCreatedAt(u uint32) *ReviewDecisionCreate {
+	rdc.mutation.SetCreatedAt(u)
+	return rdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableCreatedAt(u *uint32) *ReviewDecisionCreate {
+	if u != nil {
+		rdc.SetCreatedAt(*u)
+	}
+	return rdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rdc *ReviewDecisionCreate) SetUpdatedAt(u uint32) *ReviewDecisionCreate {
+	rdc.mutation.SetUpdatedAt(u)
+	return rdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableUpdatedAt(u *uint32) *ReviewDecisionCreate {
+	if u != nil {
+		rdc.SetUpdatedAt(*u)
+	}
+	return rdc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rdc *ReviewDecisionCreate) SetDeletedAt(u uint32) *ReviewDecisionCreate {
+	rdc.mutation.SetDeletedAt(u)
+	return rdc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableDeletedAt(u *uint32) *ReviewDecisionCreate {
+	if u != nil {
+		rdc.SetDeletedAt(*u)
+	}
+	return rdc
+}
+
+// SetAppID sets the "app_id" field.
+func (rdc *ReviewDecisionCreate) SetAppID(u uuid.UUID) *ReviewDecisionCreate {
+	rdc.mutation.SetAppID(u)
+	return rdc
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableAppID(u *uuid.UUID) *ReviewDecisionCreate {
+	if u != nil {
+		rdc.SetAppID(*u)
+	}
+	return rdc
+}
+
+// SetReviewID sets the "review_id" field.
+func (rdc *ReviewDecisionCreate) SetReviewID(u uuid.UUID) *ReviewDecisionCreate {
+	rdc.mutation.SetReviewID(u)
+	return rdc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rdc *ReviewDecisionCreate) SetReviewerID(u uuid.UUID) *ReviewDecisionCreate {
+	rdc.mutation.SetReviewerID(u)
+	return rdc
+}
+
+// SetDecision sets the "decision" field.
+func (rdc *ReviewDecisionCreate) SetDecision(s string) *ReviewDecisionCreate {
+	rdc.mutation.SetDecision(s)
+	return rdc
+}
+
+// SetMessage sets the "message" field.
+func (rdc *ReviewDecisionCreate) SetMessage(s string) *ReviewDecisionCreate {
+	rdc.mutation.SetMessage(s)
+	return rdc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableMessage(s *string) *ReviewDecisionCreate {
+	if s != nil {
+		rdc.SetMessage(*s)
+	}
+	return rdc
+}
+
+// SetReasons sets the "reasons" field.
+func (rdc *ReviewDecisionCreate) SetReasons(s []string) *ReviewDecisionCreate {
+	rdc.mutation.SetReasons(s)
+	return rdc
+}
+
+// SetID sets the "id" field.
+func (rdc *ReviewDecisionCreate) SetID(u uuid.UUID) *ReviewDecisionCreate {
+	rdc.mutation.SetID(u)
+	return rdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rdc *ReviewDecisionCreate) SetNillableID(u *uuid.UUID) *ReviewDecisionCreate {
+	if u != nil {
+		rdc.SetID(*u)
+	}
+	return rdc
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (rdc *ReviewDecisionCreate) SetReview(r *Review) *ReviewDecisionCreate {
+	return rdc.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewDecisionMutation object of the builder.
+func (rdc *ReviewDecisionCreate) Mutation() *ReviewDecisionMutation {
+	return rdc.mutation
+}
+
+// Save creates the ReviewDecision in the database.
+func (rdc *ReviewDecisionCreate) Save(ctx context.Context) (*ReviewDecision, error) {
+	var (
+		err  error
+		node *ReviewDecision
+	)
+	if err := rdc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rdc.hooks) == 0 {
+		if err = rdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewDecisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rdc.check(); err != nil {
+				return nil, err
+			}
+			rdc.mutation = mutation
+			if node, err = rdc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rdc.hooks) - 1; i >= 0; i-- {
+			if rdc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rdc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rdc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewDecision)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewDecisionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rdc *ReviewDecisionCreate) SaveX(ctx context.Context) *ReviewDecision {
+	v, err := rdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdc *ReviewDecisionCreate) Exec(ctx context.Context) error {
+	_, err := rdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdc *ReviewDecisionCreate) ExecX(ctx context.Context) {
+	if err := rdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdc *ReviewDecisionCreate) defaults() error {
+	if _, ok := rdc.mutation.CreatedAt(); !ok {
+		if reviewdecision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.DefaultCreatedAt()
+		rdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rdc.mutation.UpdatedAt(); !ok {
+		if reviewdecision.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.DefaultUpdatedAt()
+		rdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rdc.mutation.DeletedAt(); !ok {
+		if reviewdecision.DefaultDeletedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.DefaultDeletedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.DefaultDeletedAt()
+		rdc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rdc.mutation.AppID(); !ok {
+		if reviewdecision.DefaultAppID == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.DefaultAppID (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.DefaultAppID()
+		rdc.mutation.SetAppID(v)
+	}
+	if _, ok := rdc.mutation.Message(); !ok {
+		v := reviewdecision.DefaultMessage
+		rdc.mutation.SetMessage(v)
+	}
+	if _, ok := rdc.mutation.ID(); !ok {
+		if reviewdecision.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.DefaultID()
+		rdc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdc *ReviewDecisionCreate) check() error {
+	if _, ok := rdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReviewDecision.created_at"`)}
+	}
+	if _, ok := rdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReviewDecision.updated_at"`)}
+	}
+	if _, ok := rdc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "ReviewDecision.deleted_at"`)}
+	}
+	if _, ok := rdc.mutation.ReviewID(); !ok {
+		return &ValidationError{Name: "review_id", err: errors.New(`ent: missing required field "ReviewDecision.review_id"`)}
+	}
+	if _, ok := rdc.mutation.ReviewerID(); !ok {
+		return &ValidationError{Name: "reviewer_id", err: errors.New(`ent: missing required field "ReviewDecision.reviewer_id"`)}
+	}
+	if _, ok := rdc.mutation.Decision(); !ok {
+		return &ValidationError{Name: "decision", err: errors.New(`ent: missing required field "ReviewDecision.decision"`)}
+	}
+	if _, ok := rdc.mutation.ReviewID(); !ok {
+		return &ValidationError{Name: "review", err: errors.New(`ent: missing required edge "ReviewDecision.review"`)}
+	}
+	return nil
+}
+
+func (rdc *ReviewDecisionCreate) sqlSave(ctx context.Context) (*ReviewDecision, error) {
+	_node, _spec := rdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rdc *ReviewDecisionCreate) createSpec() (*ReviewDecision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewDecision{config: rdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reviewdecision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = rdc.conflict
+	if id, ok := rdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rdc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := rdc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := rdc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := rdc.mutation.ReviewerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldReviewerID,
+		})
+		_node.ReviewerID = value
+	}
+	if value, ok := rdc.mutation.Decision(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldDecision,
+		})
+		_node.Decision = value
+	}
+	if value, ok := rdc.mutation.Message(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldMessage,
+		})
+		_node.Message = value
+	}
+	if value, ok := rdc.mutation.Reasons(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: reviewdecision.FieldReasons,
+		})
+		_node.Reasons = value
+	}
+	if nodes := rdc.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewDecision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewDecisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rdc *ReviewDecisionCreate) OnConflict(opts ...sql.ConflictOption) *ReviewDecisionUpsertOne {
+	rdc.conflict = opts
+	return &ReviewDecisionUpsertOne{
+		create: rdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdc *ReviewDecisionCreate) OnConflictColumns(columns ...string) *ReviewDecisionUpsertOne {
+	rdc.conflict = append(rdc.conflict, sql.ConflictColumns(columns...))
+	return &ReviewDecisionUpsertOne{
+		create: rdc,
+	}
+}
+
+type (
+	// ReviewDecisionUpsertOne is the builder for "upsert"-ing
+	//  one ReviewDecision node.
+	ReviewDecisionUpsertOne struct {
+		create *ReviewDecisionCreate
+	}
+
+	// ReviewDecisionUpsert is the "OnConflict" setter.
+	ReviewDecisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewDecisionUpsert) SetCreatedAt(v uint32) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateCreatedAt() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewDecisionUpsert) AddCreatedAt(v uint32) *ReviewDecisionUpsert {
+	u.Add(reviewdecision.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewDecisionUpsert) SetUpdatedAt(v uint32) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateUpdatedAt() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewDecisionUpsert) AddUpdatedAt(v uint32) *ReviewDecisionUpsert {
+	u.Add(reviewdecision.FieldUpdatedAt, v)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewDecisionUpsert) SetDeletedAt(v uint32) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateDeletedAt() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewDecisionUpsert) AddDeletedAt(v uint32) *ReviewDecisionUpsert {
+	u.Add(reviewdecision.FieldDeletedAt, v)
+	return u
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewDecisionUpsert) SetAppID(v uuid.UUID) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldAppID, v)
+	return u
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateAppID() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldAppID)
+	return u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewDecisionUpsert) ClearAppID() *ReviewDecisionUpsert {
+	u.SetNull(reviewdecision.FieldAppID)
+	return u
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewDecisionUpsert) SetReviewID(v uuid.UUID) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldReviewID, v)
+	return u
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateReviewID() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldReviewID)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewDecisionUpsert) SetReviewerID(v uuid.UUID) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateReviewerID() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldReviewerID)
+	return u
+}
+
+// SetDecision sets the "decision" field.
+func (u *ReviewDecisionUpsert) SetDecision(v string) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldDecision, v)
+	return u
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateDecision() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldDecision)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewDecisionUpsert) SetMessage(v string) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateMessage() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewDecisionUpsert) ClearMessage() *ReviewDecisionUpsert {
+	u.SetNull(reviewdecision.FieldMessage)
+	return u
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReviewDecisionUpsert) SetReasons(v []string) *ReviewDecisionUpsert {
+	u.Set(reviewdecision.FieldReasons, v)
+	return u
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewDecisionUpsert) UpdateReasons() *ReviewDecisionUpsert {
+	u.SetExcluded(reviewdecision.FieldReasons)
+	return u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewDecisionUpsert) ClearReasons() *ReviewDecisionUpsert {
+	u.SetNull(reviewdecision.FieldReasons)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewdecision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewDecisionUpsertOne) UpdateNewValues() *ReviewDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reviewdecision.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewDecisionUpsertOne) Ignore() *ReviewDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewDecisionUpsertOne) DoNothing() *ReviewDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewDecisionCreate.OnConflict
+// documentation for more info.
+func (u *ReviewDecisionUpsertOne) Update(set func(*ReviewDecisionUpsert)) *ReviewDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewDecisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewDecisionUpsertOne) SetCreatedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewDecisionUpsertOne) AddCreatedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateCreatedAt() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewDecisionUpsertOne) SetUpdatedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewDecisionUpsertOne) AddUpdatedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateUpdatedAt() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewDecisionUpsertOne) SetDeletedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewDecisionUpsertOne) AddDeletedAt(v uint32) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateDeletedAt() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewDecisionUpsertOne) SetAppID(v uuid.UUID) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateAppID() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewDecisionUpsertOne) ClearAppID() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewDecisionUpsertOne) SetReviewID(v uuid.UUID) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReviewID(v)
+	})
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateReviewID() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReviewID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewDecisionUpsertOne) SetReviewerID(v uuid.UUID) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateReviewerID() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ReviewDecisionUpsertOne) SetDecision(v string) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateDecision() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewDecisionUpsertOne) SetMessage(v string) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateMessage() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewDecisionUpsertOne) ClearMessage() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReviewDecisionUpsertOne) SetReasons(v []string) *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertOne) UpdateReasons() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewDecisionUpsertOne) ClearReasons() *ReviewDecisionUpsertOne {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewDecisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewDecisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewDecisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewDecisionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewDecisionUpsertOne.ID is not supported by MySQL driver. Use ReviewDecisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewDecisionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewDecisionCreateBulk is the builder for creating many ReviewDecision entities in bulk.
+type ReviewDecisionCreateBulk struct {
+	config
+	builders []*ReviewDecisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReviewDecision entities in the database.
+func (rdcb *ReviewDecisionCreateBulk) Save(ctx context.Context) ([]*ReviewDecision, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rdcb.builders))
+	nodes := make([]*ReviewDecision, len(rdcb.builders))
+	mutators := make([]Mutator, len(rdcb.builders))
+	for i := range rdcb.builders {
+		func(i int, root context.Context) {
+			builder := rdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewDecisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdcb *ReviewDecisionCreateBulk) SaveX(ctx context.Context) []*ReviewDecision {
+	v, err := rdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdcb *ReviewDecisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdcb *ReviewDecisionCreateBulk) ExecX(ctx context.Context) {
+	if err := rdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewDecision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewDecisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rdcb *ReviewDecisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewDecisionUpsertBulk {
+	rdcb.conflict = opts
+	return &ReviewDecisionUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdcb *ReviewDecisionCreateBulk) OnConflictColumns(columns ...string) *ReviewDecisionUpsertBulk {
+	rdcb.conflict = append(rdcb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewDecisionUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// ReviewDecisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReviewDecision nodes.
+type ReviewDecisionUpsertBulk struct {
+	create *ReviewDecisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewdecision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewDecisionUpsertBulk) UpdateNewValues() *ReviewDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reviewdecision.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewDecision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewDecisionUpsertBulk) Ignore() *ReviewDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewDecisionUpsertBulk) DoNothing() *ReviewDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewDecisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewDecisionUpsertBulk) Update(set func(*ReviewDecisionUpsert)) *ReviewDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewDecisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewDecisionUpsertBulk) SetCreatedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewDecisionUpsertBulk) AddCreatedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateCreatedAt() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewDecisionUpsertBulk) SetUpdatedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewDecisionUpsertBulk) AddUpdatedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateUpdatedAt() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewDecisionUpsertBulk) SetDeletedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewDecisionUpsertBulk) AddDeletedAt(v uint32) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateDeletedAt() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewDecisionUpsertBulk) SetAppID(v uuid.UUID) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateAppID() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewDecisionUpsertBulk) ClearAppID() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewDecisionUpsertBulk) SetReviewID(v uuid.UUID) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReviewID(v)
+	})
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateReviewID() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReviewID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewDecisionUpsertBulk) SetReviewerID(v uuid.UUID) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateReviewerID() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ReviewDecisionUpsertBulk) SetDecision(v string) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateDecision() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewDecisionUpsertBulk) SetMessage(v string) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateMessage() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewDecisionUpsertBulk) ClearMessage() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReviewDecisionUpsertBulk) SetReasons(v []string) *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReviewDecisionUpsertBulk) UpdateReasons() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReviewDecisionUpsertBulk) ClearReasons() *ReviewDecisionUpsertBulk {
+	return u.Update(func(s *ReviewDecisionUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewDecisionUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewDecisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewDecisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewDecisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+)
+
+// ReviewDecisionDelete is the builder for deleting a ReviewDecision entity.
+type ReviewDecisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReviewDecisionMutation
+}
+
+// Where appends a list predicates to the ReviewDecisionDelete builder.
+func (rdd *ReviewDecisionDelete) Where(ps ...predicate.ReviewDecision) *ReviewDecisionDelete {
+	rdd.mutation.Where(ps...)
+	return rdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rdd *ReviewDecisionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rdd.hooks) == 0 {
+		affected, err = rdd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewDecisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rdd.mutation = mutation
+			affected, err = rdd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rdd.hooks) - 1; i >= 0; i-- {
+			if rdd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rdd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rdd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdd *ReviewDecisionDelete) ExecX(ctx context.Context) int {
+	n, err := rdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rdd *ReviewDecisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: reviewdecision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		},
+	}
+	if ps := rdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ReviewDecisionDeleteOne is the builder for deleting a single ReviewDecision entity.
+type ReviewDecisionDeleteOne struct {
+	rdd *ReviewDecisionDelete
+}
+
+// Exec executes the deletion query.
+func (rddo *ReviewDecisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rddo.rdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reviewdecision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rddo *ReviewDecisionDeleteOne) ExecX(ctx context.Context) {
+	rddo.rdd.ExecX(ctx)
+}
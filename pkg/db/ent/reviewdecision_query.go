@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/google/uuid"
+)
+
+// ReviewDecisionQuery is the builder for querying ReviewDecision entities.
+type ReviewDecisionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ReviewDecision
+	withReview *ReviewQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReviewDecisionQuery builder.
+func (rdq *ReviewDecisionQuery) Where(ps ...predicate.ReviewDecision) *ReviewDecisionQuery {
+	rdq.predicates = append(rdq.predicates, ps...)
+	return rdq
+}
+
+// Limit adds a limit step to the query.
+func (rdq *ReviewDecisionQuery) Limit(limit int) *ReviewDecisionQuery {
+	rdq.limit = &limit
+	return rdq
+}
+
+// Offset adds an offset step to the query.
+func (rdq *ReviewDecisionQuery) Offset(offset int) *ReviewDecisionQuery {
+	rdq.offset = &offset
+	return rdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rdq *ReviewDecisionQuery) Unique(unique bool) *ReviewDecisionQuery {
+	rdq.unique = &unique
+	return rdq
+}
+
+// Order adds an order step to the query.
+func (rdq *ReviewDecisionQuery) Order(o ...OrderFunc) *ReviewDecisionQuery {
+	rdq.order = append(rdq.order, o...)
+	return rdq
+}
+
+// QueryReview chains the current query on the "review" edge.
+func (rdq *ReviewDecisionQuery) QueryReview() *ReviewQuery {
+	query := &ReviewQuery{config: rdq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewdecision.Table, reviewdecision.FieldID, selector),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewdecision.ReviewTable, reviewdecision.ReviewColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReviewDecision entity from the query.
+// Returns a *NotFoundError when no ReviewDecision was found.
+func (rdq *ReviewDecisionQuery) First(ctx context.Context) (*ReviewDecision, error) {
+	nodes, err := rdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reviewdecision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) FirstX(ctx context.Context) *ReviewDecision {
+	node, err := rdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReviewDecision ID from the query.
+// Returns a *NotFoundError when no ReviewDecision ID was found.
+func (rdq *ReviewDecisionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reviewdecision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReviewDecision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReviewDecision entity is found.
+// Returns a *NotFoundError when no ReviewDecision entities are found.
+func (rdq *ReviewDecisionQuery) Only(ctx context.Context) (*ReviewDecision, error) {
+	nodes, err := rdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reviewdecision.Label}
+	default:
+		return nil, &NotSingularError{reviewdecision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) OnlyX(ctx context.Context) *ReviewDecision {
+	node, err := rdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReviewDecision ID in the query.
+// Returns a *NotSingularError when more than one ReviewDecision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rdq *ReviewDecisionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reviewdecision.Label}
+	default:
+		err = &NotSingularError{reviewdecision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReviewDecisions.
+func (rdq *ReviewDecisionQuery) All(ctx context.Context) ([]*ReviewDecision, error) {
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) AllX(ctx context.Context) []*ReviewDecision {
+	nodes, err := rdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReviewDecision IDs.
+func (rdq *ReviewDecisionQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := rdq.Select(reviewdecision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rdq *ReviewDecisionQuery) Count(ctx context.Context) (int, error) {
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) CountX(ctx context.Context) int {
+	count, err := rdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rdq *ReviewDecisionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rdq *ReviewDecisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := rdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReviewDecisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rdq *ReviewDecisionQuery) Clone() *ReviewDecisionQuery {
+	if rdq == nil {
+		return nil
+	}
+	return &ReviewDecisionQuery{
+		config:     rdq.config,
+		limit:      rdq.limit,
+		offset:     rdq.offset,
+		order:      append([]OrderFunc{}, rdq.order...),
+		predicates: append([]predicate.ReviewDecision{}, rdq.predicates...),
+		withReview: rdq.withReview.Clone(),
+		// clone intermediate query.
+		sql:    rdq.sql.Clone(),
+		path:   rdq.path,
+		unique: rdq.unique,
+	}
+}
+
+// WithReview tells the query-builder to eager-load the nodes that are connected to
+// the "review" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *ReviewDecisionQuery) WithReview(opts ...func(*ReviewQuery)) *ReviewDecisionQuery {
+	query := &ReviewQuery{config: rdq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withReview = query
+	return rdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReviewDecision.Query().
+//		GroupBy(reviewdecision.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rdq *ReviewDecisionQuery) GroupBy(field string, fields ...string) *ReviewDecisionGroupBy {
+	grbuild := &ReviewDecisionGroupBy{config: rdq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rdq.sqlQuery(ctx), nil
+	}
+	grbuild.label = reviewdecision.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReviewDecision.Query().
+//		Select(reviewdecision.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rdq *ReviewDecisionQuery) Select(fields ...string) *ReviewDecisionSelect {
+	rdq.fields = append(rdq.fields, fields...)
+	selbuild := &ReviewDecisionSelect{ReviewDecisionQuery: rdq}
+	selbuild.label = reviewdecision.Label
+	selbuild.flds, selbuild.scan = &rdq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rdq *ReviewDecisionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rdq.fields {
+		if !reviewdecision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rdq.path != nil {
+		prev, err := rdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rdq.sql = prev
+	}
+	if reviewdecision.Policy == nil {
+		return errors.New("ent: uninitialized reviewdecision.Policy (forgotten import ent/runtime?)")
+	}
+	if err := reviewdecision.Policy.EvalQuery(ctx, rdq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rdq *ReviewDecisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReviewDecision, error) {
+	var (
+		nodes       = []*ReviewDecision{}
+		_spec       = rdq.querySpec()
+		loadedTypes = [1]bool{
+			rdq.withReview != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ReviewDecision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ReviewDecision{config: rdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rdq.withReview; query != nil {
+		if err := rdq.loadReview(ctx, query, nodes, nil,
+			func(n *ReviewDecision, e *Review) { n.Edges.Review = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rdq *ReviewDecisionQuery) loadReview(ctx context.Context, query *ReviewQuery, nodes []*ReviewDecision, init func(*ReviewDecision), assign func(*ReviewDecision, *Review)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReviewDecision)
+	for i := range nodes {
+		fk := nodes[i].ReviewID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(review.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "review_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rdq *ReviewDecisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rdq.querySpec()
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	_spec.Node.Columns = rdq.fields
+	if len(rdq.fields) > 0 {
+		_spec.Unique = rdq.unique != nil && *rdq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rdq.driver, _spec)
+}
+
+func (rdq *ReviewDecisionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rdq *ReviewDecisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewdecision.Table,
+			Columns: reviewdecision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		},
+		From:   rdq.sql,
+		Unique: true,
+	}
+	if unique := rdq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewdecision.FieldID)
+		for i := range fields {
+			if fields[i] != reviewdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rdq *ReviewDecisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rdq.driver.Dialect())
+	t1 := builder.Table(reviewdecision.Table)
+	columns := rdq.fields
+	if len(columns) == 0 {
+		columns = reviewdecision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rdq.sql != nil {
+		selector = rdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rdq.unique != nil && *rdq.unique {
+		selector.Distinct()
+	}
+	for _, m := range rdq.modifiers {
+		m(selector)
+	}
+	for _, p := range rdq.predicates {
+		p(selector)
+	}
+	for _, p := range rdq.order {
+		p(selector)
+	}
+	if offset := rdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rdq *ReviewDecisionQuery) ForUpdate(opts ...sql.LockOption) *ReviewDecisionQuery {
+	if rdq.driver.Dialect() == dialect.Postgres {
+		rdq.Unique(false)
+	}
+	rdq.modifiers = append(rdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rdq *ReviewDecisionQuery) ForShare(opts ...sql.LockOption) *ReviewDecisionQuery {
+	if rdq.driver.Dialect() == dialect.Postgres {
+		rdq.Unique(false)
+	}
+	rdq.modifiers = append(rdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rdq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rdq *ReviewDecisionQuery) Modify(modifiers ...func(s *sql.Selector)) *ReviewDecisionSelect {
+	rdq.modifiers = append(rdq.modifiers, modifiers...)
+	return rdq.Select()
+}
+
+// ReviewDecisionGroupBy is the group-by builder for ReviewDecision entities.
+type ReviewDecisionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rdgb *ReviewDecisionGroupBy) Aggregate(fns ...AggregateFunc) *ReviewDecisionGroupBy {
+	rdgb.fns = append(rdgb.fns, fns...)
+	return rdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rdgb *ReviewDecisionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rdgb.sql = query
+	return rdgb.sqlScan(ctx, v)
+}
+
+func (rdgb *ReviewDecisionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rdgb.fields {
+		if !reviewdecision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rdgb *ReviewDecisionGroupBy) sqlQuery() *sql.Selector {
+	selector := rdgb.sql.Select()
+	aggregation := make([]string, 0, len(rdgb.fns))
+	for _, fn := range rdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rdgb.fields)+len(rdgb.fns))
+		for _, f := range rdgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rdgb.fields...)...)
+}
+
+// ReviewDecisionSelect is the builder for selecting fields of ReviewDecision entities.
+type ReviewDecisionSelect struct {
+	*ReviewDecisionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rds *ReviewDecisionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rds.sql = rds.ReviewDecisionQuery.sqlQuery(ctx)
+	return rds.sqlScan(ctx, v)
+}
+
+func (rds *ReviewDecisionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rds.sql.Query()
+	if err := rds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rds *ReviewDecisionSelect) Modify(modifiers ...func(s *sql.Selector)) *ReviewDecisionSelect {
+	rds.modifiers = append(rds.modifiers, modifiers...)
+	return rds
+}
@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/google/uuid"
+)
+
+// ReviewDecisionUpdate is the builder for updating ReviewDecision entities.
+type ReviewDecisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewDecisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewDecisionUpdate builder.
+func (rdu *ReviewDecisionUpdate) Where(ps ...predicate.ReviewDecision) *ReviewDecisionUpdate {
+	rdu.mutation.Where(ps...)
+	return rdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rdu *ReviewDecisionUpdate) SetCreatedAt(u uint32) *ReviewDecisionUpdate {
+	rdu.mutation.ResetCreatedAt()
+	rdu.mutation.SetCreatedAt(u)
+	return rdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rdu *ReviewDecisionUpdate) SetNillableCreatedAt(u *uint32) *ReviewDecisionUpdate {
+	if u != nil {
+		rdu.SetCreatedAt(*u)
+	}
+	return rdu
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rdu *ReviewDecisionUpdate) AddCreatedAt(u int32) *ReviewDecisionUpdate {
+	rdu.mutation.AddCreatedAt(u)
+	return rdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rdu *ReviewDecisionUpdate) SetUpdatedAt(u uint32) *ReviewDecisionUpdate {
+	rdu.mutation.ResetUpdatedAt()
+	rdu.mutation.SetUpdatedAt(u)
+	return rdu
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rdu *ReviewDecisionUpdate) AddUpdatedAt(u int32) *ReviewDecisionUpdate {
+	rdu.mutation.AddUpdatedAt(u)
+	return rdu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rdu *ReviewDecisionUpdate) SetDeletedAt(u uint32) *ReviewDecisionUpdate {
+	rdu.mutation.ResetDeletedAt()
+	rdu.mutation.SetDeletedAt(u)
+	return rdu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rdu *ReviewDecisionUpdate) SetNillableDeletedAt(u *uint32) *ReviewDecisionUpdate {
+	if u != nil {
+		rdu.SetDeletedAt(*u)
+	}
+	return rdu
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rdu *ReviewDecisionUpdate) AddDeletedAt(u int32) *ReviewDecisionUpdate {
+	rdu.mutation.AddDeletedAt(u)
+	return rdu
+}
+
+// SetAppID sets the "app_id" field.
+func (rdu *ReviewDecisionUpdate) SetAppID(u uuid.UUID) *ReviewDecisionUpdate {
+	rdu.mutation.SetAppID(u)
+	return rdu
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (rdu *ReviewDecisionUpdate) SetNillableAppID(u *uuid.UUID) *ReviewDecisionUpdate {
+	if u != nil {
+		rdu.SetAppID(*u)
+	}
+	return rdu
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (rdu *ReviewDecisionUpdate) ClearAppID() *ReviewDecisionUpdate {
+	rdu.mutation.ClearAppID()
+	return rdu
+}
+
+// SetReviewID sets the "review_id" field.
+func (rdu *ReviewDecisionUpdate) SetReviewID(u uuid.UUID) *ReviewDecisionUpdate {
+	rdu.mutation.SetReviewID(u)
+	return rdu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rdu *ReviewDecisionUpdate) SetReviewerID(u uuid.UUID) *ReviewDecisionUpdate {
+	rdu.mutation.SetReviewerID(u)
+	return rdu
+}
+
+// SetDecision sets the "decision" field.
+func (rdu *ReviewDecisionUpdate) SetDecision(s string) *ReviewDecisionUpdate {
+	rdu.mutation.SetDecision(s)
+	return rdu
+}
+
+// SetMessage sets the "message" field.
+func (rdu *ReviewDecisionUpdate) SetMessage(s string) *ReviewDecisionUpdate {
+	rdu.mutation.SetMessage(s)
+	return rdu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rdu *ReviewDecisionUpdate) SetNillableMessage(s *string) *ReviewDecisionUpdate {
+	if s != nil {
+		rdu.SetMessage(*s)
+	}
+	return rdu
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rdu *ReviewDecisionUpdate) ClearMessage() *ReviewDecisionUpdate {
+	rdu.mutation.ClearMessage()
+	return rdu
+}
+
+// SetReasons sets the "reasons" field.
+func (rdu *ReviewDecisionUpdate) SetReasons(s []string) *ReviewDecisionUpdate {
+	rdu.mutation.SetReasons(s)
+	return rdu
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (rdu *ReviewDecisionUpdate) ClearReasons() *ReviewDecisionUpdate {
+	rdu.mutation.ClearReasons()
+	return rdu
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (rdu *ReviewDecisionUpdate) SetReview(r *Review) *ReviewDecisionUpdate {
+	return rdu.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewDecisionMutation object of the builder.
+func (rdu *ReviewDecisionUpdate) Mutation() *ReviewDecisionMutation {
+	return rdu.mutation
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (rdu *ReviewDecisionUpdate) ClearReview() *ReviewDecisionUpdate {
+	rdu.mutation.ClearReview()
+	return rdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rdu *ReviewDecisionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := rdu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(rdu.hooks) == 0 {
+		if err = rdu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewDecisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rdu.check(); err != nil {
+				return 0, err
+			}
+			rdu.mutation = mutation
+			affected, err = rdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rdu.hooks) - 1; i >= 0; i-- {
+			if rdu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdu *ReviewDecisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rdu *ReviewDecisionUpdate) Exec(ctx context.Context) error {
+	_, err := rdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdu *ReviewDecisionUpdate) ExecX(ctx context.Context) {
+	if err := rdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdu *ReviewDecisionUpdate) defaults() error {
+	if _, ok := rdu.mutation.UpdatedAt(); !ok {
+		if reviewdecision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.UpdateDefaultUpdatedAt()
+		rdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdu *ReviewDecisionUpdate) check() error {
+	if _, ok := rdu.mutation.ReviewID(); rdu.mutation.ReviewCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewDecision.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rdu *ReviewDecisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewDecisionUpdate {
+	rdu.modifiers = append(rdu.modifiers, modifiers...)
+	return rdu
+}
+
+func (rdu *ReviewDecisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewdecision.Table,
+			Columns: reviewdecision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		},
+	}
+	if ps := rdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rdu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldCreatedAt,
+		})
+	}
+	if value, ok := rdu.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldCreatedAt,
+		})
+	}
+	if value, ok := rdu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rdu.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rdu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldDeletedAt,
+		})
+	}
+	if value, ok := rdu.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldDeletedAt,
+		})
+	}
+	if value, ok := rdu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldAppID,
+		})
+	}
+	if rdu.mutation.AppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewdecision.FieldAppID,
+		})
+	}
+	if value, ok := rdu.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldReviewerID,
+		})
+	}
+	if value, ok := rdu.mutation.Decision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldDecision,
+		})
+	}
+	if value, ok := rdu.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldMessage,
+		})
+	}
+	if rdu.mutation.MessageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewdecision.FieldMessage,
+		})
+	}
+	if value, ok := rdu.mutation.Reasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: reviewdecision.FieldReasons,
+		})
+	}
+	if rdu.mutation.ReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: reviewdecision.FieldReasons,
+		})
+	}
+	if rdu.mutation.ReviewCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rdu.modifiers
+	if n, err = sqlgraph.UpdateNodes(ctx, rdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReviewDecisionUpdateOne is the builder for updating a single ReviewDecision entity.
+type ReviewDecisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewDecisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rduo *ReviewDecisionUpdateOne) SetCreatedAt(u uint32) *ReviewDecisionUpdateOne {
+	rduo.mutation.ResetCreatedAt()
+	rduo.mutation.SetCreatedAt(u)
+	return rduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rduo *ReviewDecisionUpdateOne) SetNillableCreatedAt(u *uint32) *ReviewDecisionUpdateOne {
+	if u != nil {
+		rduo.SetCreatedAt(*u)
+	}
+	return rduo
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rduo *ReviewDecisionUpdateOne) AddCreatedAt(u int32) *ReviewDecisionUpdateOne {
+	rduo.mutation.AddCreatedAt(u)
+	return rduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rduo *ReviewDecisionUpdateOne) SetUpdatedAt(u uint32) *ReviewDecisionUpdateOne {
+	rduo.mutation.ResetUpdatedAt()
+	rduo.mutation.SetUpdatedAt(u)
+	return rduo
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rduo *ReviewDecisionUpdateOne) AddUpdatedAt(u int32) *ReviewDecisionUpdateOne {
+	rduo.mutation.AddUpdatedAt(u)
+	return rduo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rduo *ReviewDecisionUpdateOne) SetDeletedAt(u uint32) *ReviewDecisionUpdateOne {
+	rduo.mutation.ResetDeletedAt()
+	rduo.mutation.SetDeletedAt(u)
+	return rduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rduo *ReviewDecisionUpdateOne) SetNillableDeletedAt(u *uint32) *ReviewDecisionUpdateOne {
+	if u != nil {
+		rduo.SetDeletedAt(*u)
+	}
+	return rduo
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rduo *ReviewDecisionUpdateOne) AddDeletedAt(u int32) *ReviewDecisionUpdateOne {
+	rduo.mutation.AddDeletedAt(u)
+	return rduo
+}
+
+// SetAppID sets the "app_id" field.
+func (rduo *ReviewDecisionUpdateOne) SetAppID(u uuid.UUID) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetAppID(u)
+	return rduo
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (rduo *ReviewDecisionUpdateOne) SetNillableAppID(u *uuid.UUID) *ReviewDecisionUpdateOne {
+	if u != nil {
+		rduo.SetAppID(*u)
+	}
+	return rduo
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (rduo *ReviewDecisionUpdateOne) ClearAppID() *ReviewDecisionUpdateOne {
+	rduo.mutation.ClearAppID()
+	return rduo
+}
+
+// SetReviewID sets the "review_id" field.
+func (rduo *ReviewDecisionUpdateOne) SetReviewID(u uuid.UUID) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetReviewID(u)
+	return rduo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rduo *ReviewDecisionUpdateOne) SetReviewerID(u uuid.UUID) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetReviewerID(u)
+	return rduo
+}
+
+// SetDecision sets the "decision" field.
+func (rduo *ReviewDecisionUpdateOne) SetDecision(s string) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetDecision(s)
+	return rduo
+}
+
+// SetMessage sets the "message" field.
+func (rduo *ReviewDecisionUpdateOne) SetMessage(s string) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetMessage(s)
+	return rduo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rduo *ReviewDecisionUpdateOne) SetNillableMessage(s *string) *ReviewDecisionUpdateOne {
+	if s != nil {
+		rduo.SetMessage(*s)
+	}
+	return rduo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rduo *ReviewDecisionUpdateOne) ClearMessage() *ReviewDecisionUpdateOne {
+	rduo.mutation.ClearMessage()
+	return rduo
+}
+
+// SetReasons sets the "reasons" field.
+func (rduo *ReviewDecisionUpdateOne) SetReasons(s []string) *ReviewDecisionUpdateOne {
+	rduo.mutation.SetReasons(s)
+	return rduo
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (rduo *ReviewDecisionUpdateOne) ClearReasons() *ReviewDecisionUpdateOne {
+	rduo.mutation.ClearReasons()
+	return rduo
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (rduo *ReviewDecisionUpdateOne) SetReview(r *Review) *ReviewDecisionUpdateOne {
+	return rduo.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewDecisionMutation object of the builder.
+func (rduo *ReviewDecisionUpdateOne) Mutation() *ReviewDecisionMutation {
+	return rduo.mutation
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (rduo *ReviewDecisionUpdateOne) ClearReview() *ReviewDecisionUpdateOne {
+	rduo.mutation.ClearReview()
+	return rduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rduo *ReviewDecisionUpdateOne) Select(field string, fields ...string) *ReviewDecisionUpdateOne {
+	rduo.fields = append([]string{field}, fields...)
+	return rduo
+}
+
+// Save executes the query and returns the updated ReviewDecision entity.
+func (rduo *ReviewDecisionUpdateOne) Save(ctx context.Context) (*ReviewDecision, error) {
+	var (
+		err  error
+		node *ReviewDecision
+	)
+	if err := rduo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rduo.hooks) == 0 {
+		if err = rduo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewDecisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rduo.check(); err != nil {
+				return nil, err
+			}
+			rduo.mutation = mutation
+			node, err = rduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rduo.hooks) - 1; i >= 0; i-- {
+			if rduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rduo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rduo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewDecision)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewDecisionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rduo *ReviewDecisionUpdateOne) SaveX(ctx context.Context) *ReviewDecision {
+	node, err := rduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rduo *ReviewDecisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rduo *ReviewDecisionUpdateOne) ExecX(ctx context.Context) {
+	if err := rduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rduo *ReviewDecisionUpdateOne) defaults() error {
+	if _, ok := rduo.mutation.UpdatedAt(); !ok {
+		if reviewdecision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewdecision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewdecision.UpdateDefaultUpdatedAt()
+		rduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rduo *ReviewDecisionUpdateOne) check() error {
+	if _, ok := rduo.mutation.ReviewID(); rduo.mutation.ReviewCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewDecision.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rduo *ReviewDecisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewDecisionUpdateOne {
+	rduo.modifiers = append(rduo.modifiers, modifiers...)
+	return rduo
+}
+
+func (rduo *ReviewDecisionUpdateOne) sqlSave(ctx context.Context) (_node *ReviewDecision, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewdecision.Table,
+			Columns: reviewdecision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewdecision.FieldID,
+			},
+		},
+	}
+	id, ok := rduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewDecision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewdecision.FieldID)
+		for _, f := range fields {
+			if !reviewdecision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldCreatedAt,
+		})
+	}
+	if value, ok := rduo.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldCreatedAt,
+		})
+	}
+	if value, ok := rduo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rduo.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rduo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldDeletedAt,
+		})
+	}
+	if value, ok := rduo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewdecision.FieldDeletedAt,
+		})
+	}
+	if value, ok := rduo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldAppID,
+		})
+	}
+	if rduo.mutation.AppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewdecision.FieldAppID,
+		})
+	}
+	if value, ok := rduo.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewdecision.FieldReviewerID,
+		})
+	}
+	if value, ok := rduo.mutation.Decision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldDecision,
+		})
+	}
+	if value, ok := rduo.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewdecision.FieldMessage,
+		})
+	}
+	if rduo.mutation.MessageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewdecision.FieldMessage,
+		})
+	}
+	if value, ok := rduo.mutation.Reasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: reviewdecision.FieldReasons,
+		})
+	}
+	if rduo.mutation.ReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: reviewdecision.FieldReasons,
+		})
+	}
+	if rduo.mutation.ReviewCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewdecision.ReviewTable,
+			Columns: []string{reviewdecision.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rduo.modifiers
+	_node = &ReviewDecision{config: rduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
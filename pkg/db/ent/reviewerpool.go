@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/google/uuid"
+)
+
+// ReviewerPool is the model entity for the ReviewerPool schema.
+type ReviewerPool struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt uint32 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt uint32 `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt uint32 `json:"deleted_at,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID uuid.UUID `json:"app_id,omitempty"`
+	// Domain holds the value of the "domain" field.
+	Domain string `json:"domain,omitempty"`
+	// ObjectType holds the value of the "object_type" field.
+	ObjectType string `json:"object_type,omitempty"`
+	// Strategy holds the value of the "strategy" field.
+	Strategy string `json:"strategy,omitempty"`
+	// Cursor holds the value of the "cursor" field.
+	Cursor uint32 `json:"cursor,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewerPoolQuery when eager-loading is set.
+	Edges ReviewerPoolEdges `json:"edges"`
+}
+
+// ReviewerPoolEdges holds the relations/edges for other nodes in the graph.
+type ReviewerPoolEdges struct {
+	// Members holds the value of the members edge.
+	Members []*ReviewerPoolMember `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReviewerPoolEdges) MembersOrErr() ([]*ReviewerPoolMember, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReviewerPool) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewerpool.FieldCreatedAt, reviewerpool.FieldUpdatedAt, reviewerpool.FieldDeletedAt, reviewerpool.FieldCursor:
+			values[i] = new(sql.NullInt64)
+		case reviewerpool.FieldDomain, reviewerpool.FieldObjectType, reviewerpool.FieldStrategy:
+			values[i] = new(sql.NullString)
+		case reviewerpool.FieldID, reviewerpool.FieldAppID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ReviewerPool", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReviewerPool fields.
+func (rp *ReviewerPool) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewerpool.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rp.ID = *value
+			}
+		case reviewerpool.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = uint32(value.Int64)
+			}
+		case reviewerpool.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rp.UpdatedAt = uint32(value.Int64)
+			}
+		case reviewerpool.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rp.DeletedAt = uint32(value.Int64)
+			}
+		case reviewerpool.FieldAppID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value != nil {
+				rp.AppID = *value
+			}
+		case reviewerpool.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				rp.Domain = value.String
+			}
+		case reviewerpool.FieldObjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_type", values[i])
+			} else if value.Valid {
+				rp.ObjectType = value.String
+			}
+		case reviewerpool.FieldStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy", values[i])
+			} else if value.Valid {
+				rp.Strategy = value.String
+			}
+		case reviewerpool.FieldCursor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor", values[i])
+			} else if value.Valid {
+				rp.Cursor = uint32(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryMembers queries the "members" edge of the ReviewerPool entity.
+func (rp *ReviewerPool) QueryMembers() *ReviewerPoolMemberQuery {
+	return (&ReviewerPoolClient{config: rp.config}).QueryMembers(rp)
+}
+
+// Update returns a builder for updating this ReviewerPool.
+// Note that you need to call ReviewerPool.Unwrap() before calling this method if this ReviewerPool
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *ReviewerPool) Update() *ReviewerPoolUpdateOne {
+	return (&ReviewerPoolClient{config: rp.config}).UpdateOne(rp)
+}
+
+// Unwrap unwraps the ReviewerPool entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *ReviewerPool) Unwrap() *ReviewerPool {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReviewerPool is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *ReviewerPool) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReviewerPool(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", rp.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", rp.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", rp.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(fmt.Sprintf("%v", rp.AppID))
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(rp.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("object_type=")
+	builder.WriteString(rp.ObjectType)
+	builder.WriteString(", ")
+	builder.WriteString("strategy=")
+	builder.WriteString(rp.Strategy)
+	builder.WriteString(", ")
+	builder.WriteString("cursor=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Cursor))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReviewerPools is a parsable slice of ReviewerPool.
+type ReviewerPools []*ReviewerPool
+
+func (rp ReviewerPools) config(cfg config) {
+	for _i := range rp {
+		rp[_i].config = cfg
+	}
+}
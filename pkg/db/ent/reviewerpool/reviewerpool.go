@@ -0,0 +1,89 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewerpool
+
+import (
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reviewerpool type in the database.
+	Label = "reviewer_pool"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldObjectType holds the string denoting the object_type field in the database.
+	FieldObjectType = "object_type"
+	// FieldStrategy holds the string denoting the strategy field in the database.
+	FieldStrategy = "strategy"
+	// FieldCursor holds the string denoting the cursor field in the database.
+	FieldCursor = "cursor"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// Table holds the table name of the reviewerpool in the database.
+	Table = "reviewer_pools"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "reviewer_pool_members"
+	// MembersInverseTable is the table name for the ReviewerPoolMember entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewerpoolmember" package.
+	MembersInverseTable = "reviewer_pool_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "pool_id"
+)
+
+// Columns holds all SQL columns for reviewerpool fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldAppID,
+	FieldDomain,
+	FieldObjectType,
+	FieldStrategy,
+	FieldCursor,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/NpoolPlatform/review-manager/pkg/db/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() uint32
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() uint32
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() uint32
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt func() uint32
+	// DefaultStrategy holds the default value on creation for the "strategy" field.
+	DefaultStrategy string
+	// DefaultCursor holds the default value on creation for the "cursor" field.
+	DefaultCursor uint32
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
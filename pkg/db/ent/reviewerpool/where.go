@@ -0,0 +1,842 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewerpool
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDomain), v))
+	})
+}
+
+// ObjectType applies equality check predicate on the "object_type" field. It's identical to ObjectTypeEQ.
+func ObjectType(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldObjectType), v))
+	})
+}
+
+// Strategy applies equality check predicate on the "strategy" field. It's identical to StrategyEQ.
+func Strategy(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStrategy), v))
+	})
+}
+
+// Cursor applies equality check predicate on the "cursor" field. It's identical to CursorEQ.
+func Cursor(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCursor), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...uuid.UUID) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...uuid.UUID) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v uuid.UUID) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppID), v))
+	})
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDomain), v))
+	})
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDomain), v))
+	})
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDomain), v...))
+	})
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDomain), v...))
+	})
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDomain), v))
+	})
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDomain), v))
+	})
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDomain), v))
+	})
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDomain), v))
+	})
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDomain), v))
+	})
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDomain), v))
+	})
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDomain), v))
+	})
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDomain), v))
+	})
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDomain), v))
+	})
+}
+
+// ObjectTypeEQ applies the EQ predicate on the "object_type" field.
+func ObjectTypeEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeNEQ applies the NEQ predicate on the "object_type" field.
+func ObjectTypeNEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeIn applies the In predicate on the "object_type" field.
+func ObjectTypeIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldObjectType), v...))
+	})
+}
+
+// ObjectTypeNotIn applies the NotIn predicate on the "object_type" field.
+func ObjectTypeNotIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldObjectType), v...))
+	})
+}
+
+// ObjectTypeGT applies the GT predicate on the "object_type" field.
+func ObjectTypeGT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeGTE applies the GTE predicate on the "object_type" field.
+func ObjectTypeGTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeLT applies the LT predicate on the "object_type" field.
+func ObjectTypeLT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeLTE applies the LTE predicate on the "object_type" field.
+func ObjectTypeLTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeContains applies the Contains predicate on the "object_type" field.
+func ObjectTypeContains(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeHasPrefix applies the HasPrefix predicate on the "object_type" field.
+func ObjectTypeHasPrefix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeHasSuffix applies the HasSuffix predicate on the "object_type" field.
+func ObjectTypeHasSuffix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeEqualFold applies the EqualFold predicate on the "object_type" field.
+func ObjectTypeEqualFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldObjectType), v))
+	})
+}
+
+// ObjectTypeContainsFold applies the ContainsFold predicate on the "object_type" field.
+func ObjectTypeContainsFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldObjectType), v))
+	})
+}
+
+// StrategyEQ applies the EQ predicate on the "strategy" field.
+func StrategyEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyNEQ applies the NEQ predicate on the "strategy" field.
+func StrategyNEQ(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyIn applies the In predicate on the "strategy" field.
+func StrategyIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldStrategy), v...))
+	})
+}
+
+// StrategyNotIn applies the NotIn predicate on the "strategy" field.
+func StrategyNotIn(vs ...string) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldStrategy), v...))
+	})
+}
+
+// StrategyGT applies the GT predicate on the "strategy" field.
+func StrategyGT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyGTE applies the GTE predicate on the "strategy" field.
+func StrategyGTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyLT applies the LT predicate on the "strategy" field.
+func StrategyLT(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyLTE applies the LTE predicate on the "strategy" field.
+func StrategyLTE(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyContains applies the Contains predicate on the "strategy" field.
+func StrategyContains(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyHasPrefix applies the HasPrefix predicate on the "strategy" field.
+func StrategyHasPrefix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyHasSuffix applies the HasSuffix predicate on the "strategy" field.
+func StrategyHasSuffix(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyIsNil applies the IsNil predicate on the "strategy" field.
+func StrategyIsNil() predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStrategy)))
+	})
+}
+
+// StrategyNotNil applies the NotNil predicate on the "strategy" field.
+func StrategyNotNil() predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStrategy)))
+	})
+}
+
+// StrategyEqualFold applies the EqualFold predicate on the "strategy" field.
+func StrategyEqualFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStrategy), v))
+	})
+}
+
+// StrategyContainsFold applies the ContainsFold predicate on the "strategy" field.
+func StrategyContainsFold(v string) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStrategy), v))
+	})
+}
+
+// CursorEQ applies the EQ predicate on the "cursor" field.
+func CursorEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCursor), v))
+	})
+}
+
+// CursorNEQ applies the NEQ predicate on the "cursor" field.
+func CursorNEQ(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCursor), v))
+	})
+}
+
+// CursorIn applies the In predicate on the "cursor" field.
+func CursorIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCursor), v...))
+	})
+}
+
+// CursorNotIn applies the NotIn predicate on the "cursor" field.
+func CursorNotIn(vs ...uint32) predicate.ReviewerPool {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCursor), v...))
+	})
+}
+
+// CursorGT applies the GT predicate on the "cursor" field.
+func CursorGT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCursor), v))
+	})
+}
+
+// CursorGTE applies the GTE predicate on the "cursor" field.
+func CursorGTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCursor), v))
+	})
+}
+
+// CursorLT applies the LT predicate on the "cursor" field.
+func CursorLT(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCursor), v))
+	})
+}
+
+// CursorLTE applies the LTE predicate on the "cursor" field.
+func CursorLTE(v uint32) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCursor), v))
+	})
+}
+
+// CursorIsNil applies the IsNil predicate on the "cursor" field.
+func CursorIsNil() predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCursor)))
+	})
+}
+
+// CursorNotNil applies the NotNil predicate on the "cursor" field.
+func CursorNotNil() predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCursor)))
+	})
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MembersTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.ReviewerPoolMember) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MembersInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReviewerPool) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReviewerPool) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReviewerPool) predicate.ReviewerPool {
+	return predicate.ReviewerPool(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,1172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolCreate is the builder for creating a ReviewerPool entity.
+type ReviewerPoolCreate struct {
+	config
+	mutation *ReviewerPoolMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *ReviewerPoolCreate) SetCreatedAt(u uint32) *ReviewerPoolCreate {
+	rpc.mutation.SetCreatedAt(u)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableCreatedAt(u *uint32) *ReviewerPoolCreate {
+	if u != nil {
+		rpc.SetCreatedAt(*u)
+	}
+	return rpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpc *ReviewerPoolCreate) SetUpdatedAt(u uint32) *ReviewerPoolCreate {
+	rpc.mutation.SetUpdatedAt(u)
+	return rpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableUpdatedAt(u *uint32) *ReviewerPoolCreate {
+	if u != nil {
+		rpc.SetUpdatedAt(*u)
+	}
+	return rpc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpc *ReviewerPoolCreate) SetDeletedAt(u uint32) *ReviewerPoolCreate {
+	rpc.mutation.SetDeletedAt(u)
+	return rpc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableDeletedAt(u *uint32) *ReviewerPoolCreate {
+	if u != nil {
+		rpc.SetDeletedAt(*u)
+	}
+	return rpc
+}
+
+// SetAppID sets the "app_id" field.
+func (rpc *ReviewerPoolCreate) SetAppID(u uuid.UUID) *ReviewerPoolCreate {
+	rpc.mutation.SetAppID(u)
+	return rpc
+}
+
+// SetDomain sets the "domain" field.
+func (rpc *ReviewerPoolCreate) SetDomain(s string) *ReviewerPoolCreate {
+	rpc.mutation.SetDomain(s)
+	return rpc
+}
+
+// SetObjectType sets the "object_type" field.
+func (rpc *ReviewerPoolCreate) SetObjectType(s string) *ReviewerPoolCreate {
+	rpc.mutation.SetObjectType(s)
+	return rpc
+}
+
+// SetStrategy sets the "strategy" field.
+func (rpc *ReviewerPoolCreate) SetStrategy(s string) *ReviewerPoolCreate {
+	rpc.mutation.SetStrategy(s)
+	return rpc
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableStrategy(s *string) *ReviewerPoolCreate {
+	if s != nil {
+		rpc.SetStrategy(*s)
+	}
+	return rpc
+}
+
+// SetCursor sets the "cursor" field.
+func (rpc *ReviewerPoolCreate) SetCursor(u uint32) *ReviewerPoolCreate {
+	rpc.mutation.SetCursor(u)
+	return rpc
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableCursor(u *uint32) *ReviewerPoolCreate {
+	if u != nil {
+		rpc.SetCursor(*u)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *ReviewerPoolCreate) SetID(u uuid.UUID) *ReviewerPoolCreate {
+	rpc.mutation.SetID(u)
+	return rpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpc *ReviewerPoolCreate) SetNillableID(u *uuid.UUID) *ReviewerPoolCreate {
+	if u != nil {
+		rpc.SetID(*u)
+	}
+	return rpc
+}
+
+// AddMemberIDs adds the "members" edge to the ReviewerPoolMember entity by IDs.
+func (rpc *ReviewerPoolCreate) AddMemberIDs(ids ...uuid.UUID) *ReviewerPoolCreate {
+	rpc.mutation.AddMemberIDs(ids...)
+	return rpc
+}
+
+// AddMembers adds the "members" edges to the ReviewerPoolMember entity.
+func (rpc *ReviewerPoolCreate) AddMembers(r ...*ReviewerPoolMember) *ReviewerPoolCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpc.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ReviewerPoolMutation object of the builder.
+func (rpc *ReviewerPoolCreate) Mutation() *ReviewerPoolMutation {
+	return rpc.mutation
+}
+
+// Save creates the ReviewerPool in the database.
+func (rpc *ReviewerPoolCreate) Save(ctx context.Context) (*ReviewerPool, error) {
+	var (
+		err  error
+		node *ReviewerPool
+	)
+	if err := rpc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rpc.hooks) == 0 {
+		if err = rpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpc.check(); err != nil {
+				return nil, err
+			}
+			rpc.mutation = mutation
+			if node, err = rpc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpc.hooks) - 1; i >= 0; i-- {
+			if rpc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewerPool)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewerPoolMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *ReviewerPoolCreate) SaveX(ctx context.Context) *ReviewerPool {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *ReviewerPoolCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *ReviewerPoolCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *ReviewerPoolCreate) defaults() error {
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		if reviewerpool.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		if reviewerpool.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.DefaultUpdatedAt()
+		rpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rpc.mutation.DeletedAt(); !ok {
+		if reviewerpool.DefaultDeletedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.DefaultDeletedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.DefaultDeletedAt()
+		rpc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rpc.mutation.Strategy(); !ok {
+		v := reviewerpool.DefaultStrategy
+		rpc.mutation.SetStrategy(v)
+	}
+	if _, ok := rpc.mutation.Cursor(); !ok {
+		v := reviewerpool.DefaultCursor
+		rpc.mutation.SetCursor(v)
+	}
+	if _, ok := rpc.mutation.ID(); !ok {
+		if reviewerpool.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.DefaultID()
+		rpc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *ReviewerPoolCreate) check() error {
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReviewerPool.created_at"`)}
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReviewerPool.updated_at"`)}
+	}
+	if _, ok := rpc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "ReviewerPool.deleted_at"`)}
+	}
+	if _, ok := rpc.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "ReviewerPool.app_id"`)}
+	}
+	if _, ok := rpc.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "ReviewerPool.domain"`)}
+	}
+	if _, ok := rpc.mutation.ObjectType(); !ok {
+		return &ValidationError{Name: "object_type", err: errors.New(`ent: missing required field "ReviewerPool.object_type"`)}
+	}
+	return nil
+}
+
+func (rpc *ReviewerPoolCreate) sqlSave(ctx context.Context) (*ReviewerPool, error) {
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rpc *ReviewerPoolCreate) createSpec() (*ReviewerPool, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewerPool{config: rpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reviewerpool.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = rpc.conflict
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rpc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := rpc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := rpc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpool.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := rpc.mutation.Domain(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldDomain,
+		})
+		_node.Domain = value
+	}
+	if value, ok := rpc.mutation.ObjectType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldObjectType,
+		})
+		_node.ObjectType = value
+	}
+	if value, ok := rpc.mutation.Strategy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldStrategy,
+		})
+		_node.Strategy = value
+	}
+	if value, ok := rpc.mutation.Cursor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCursor,
+		})
+		_node.Cursor = value
+	}
+	if nodes := rpc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewerPool.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewerPoolUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpc *ReviewerPoolCreate) OnConflict(opts ...sql.ConflictOption) *ReviewerPoolUpsertOne {
+	rpc.conflict = opts
+	return &ReviewerPoolUpsertOne{
+		create: rpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpc *ReviewerPoolCreate) OnConflictColumns(columns ...string) *ReviewerPoolUpsertOne {
+	rpc.conflict = append(rpc.conflict, sql.ConflictColumns(columns...))
+	return &ReviewerPoolUpsertOne{
+		create: rpc,
+	}
+}
+
+type (
+	// ReviewerPoolUpsertOne is the builder for "upsert"-ing
+	//  one ReviewerPool node.
+	ReviewerPoolUpsertOne struct {
+		create *ReviewerPoolCreate
+	}
+
+	// ReviewerPoolUpsert is the "OnConflict" setter.
+	ReviewerPoolUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolUpsert) SetCreatedAt(v uint32) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateCreatedAt() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolUpsert) AddCreatedAt(v uint32) *ReviewerPoolUpsert {
+	u.Add(reviewerpool.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolUpsert) SetUpdatedAt(v uint32) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateUpdatedAt() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolUpsert) AddUpdatedAt(v uint32) *ReviewerPoolUpsert {
+	u.Add(reviewerpool.FieldUpdatedAt, v)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolUpsert) SetDeletedAt(v uint32) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateDeletedAt() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolUpsert) AddDeletedAt(v uint32) *ReviewerPoolUpsert {
+	u.Add(reviewerpool.FieldDeletedAt, v)
+	return u
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewerPoolUpsert) SetAppID(v uuid.UUID) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldAppID, v)
+	return u
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateAppID() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldAppID)
+	return u
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReviewerPoolUpsert) SetDomain(v string) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldDomain, v)
+	return u
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateDomain() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldDomain)
+	return u
+}
+
+// SetObjectType sets the "object_type" field.
+func (u *ReviewerPoolUpsert) SetObjectType(v string) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldObjectType, v)
+	return u
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateObjectType() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldObjectType)
+	return u
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ReviewerPoolUpsert) SetStrategy(v string) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldStrategy, v)
+	return u
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateStrategy() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldStrategy)
+	return u
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ReviewerPoolUpsert) ClearStrategy() *ReviewerPoolUpsert {
+	u.SetNull(reviewerpool.FieldStrategy)
+	return u
+}
+
+// SetCursor sets the "cursor" field.
+func (u *ReviewerPoolUpsert) SetCursor(v uint32) *ReviewerPoolUpsert {
+	u.Set(reviewerpool.FieldCursor, v)
+	return u
+}
+
+// UpdateCursor sets the "cursor" field to the value that was provided on create.
+func (u *ReviewerPoolUpsert) UpdateCursor() *ReviewerPoolUpsert {
+	u.SetExcluded(reviewerpool.FieldCursor)
+	return u
+}
+
+// AddCursor adds v to the "cursor" field.
+func (u *ReviewerPoolUpsert) AddCursor(v uint32) *ReviewerPoolUpsert {
+	u.Add(reviewerpool.FieldCursor, v)
+	return u
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (u *ReviewerPoolUpsert) ClearCursor() *ReviewerPoolUpsert {
+	u.SetNull(reviewerpool.FieldCursor)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewerpool.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewerPoolUpsertOne) UpdateNewValues() *ReviewerPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reviewerpool.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewerPoolUpsertOne) Ignore() *ReviewerPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewerPoolUpsertOne) DoNothing() *ReviewerPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewerPoolCreate.OnConflict
+// documentation for more info.
+func (u *ReviewerPoolUpsertOne) Update(set func(*ReviewerPoolUpsert)) *ReviewerPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewerPoolUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolUpsertOne) SetCreatedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolUpsertOne) AddCreatedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateCreatedAt() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolUpsertOne) SetUpdatedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolUpsertOne) AddUpdatedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateUpdatedAt() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolUpsertOne) SetDeletedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolUpsertOne) AddDeletedAt(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateDeletedAt() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewerPoolUpsertOne) SetAppID(v uuid.UUID) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateAppID() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReviewerPoolUpsertOne) SetDomain(v string) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateDomain() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// SetObjectType sets the "object_type" field.
+func (u *ReviewerPoolUpsertOne) SetObjectType(v string) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetObjectType(v)
+	})
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateObjectType() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateObjectType()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ReviewerPoolUpsertOne) SetStrategy(v string) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateStrategy() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ReviewerPoolUpsertOne) ClearStrategy() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.ClearStrategy()
+	})
+}
+
+// SetCursor sets the "cursor" field.
+func (u *ReviewerPoolUpsertOne) SetCursor(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetCursor(v)
+	})
+}
+
+// AddCursor adds v to the "cursor" field.
+func (u *ReviewerPoolUpsertOne) AddCursor(v uint32) *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddCursor(v)
+	})
+}
+
+// UpdateCursor sets the "cursor" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertOne) UpdateCursor() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateCursor()
+	})
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (u *ReviewerPoolUpsertOne) ClearCursor() *ReviewerPoolUpsertOne {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.ClearCursor()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewerPoolUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewerPoolCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewerPoolUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewerPoolUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewerPoolUpsertOne.ID is not supported by MySQL driver. Use ReviewerPoolUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewerPoolUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewerPoolCreateBulk is the builder for creating many ReviewerPool entities in bulk.
+type ReviewerPoolCreateBulk struct {
+	config
+	builders []*ReviewerPoolCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReviewerPool entities in the database.
+func (rpcb *ReviewerPoolCreateBulk) Save(ctx context.Context) ([]*ReviewerPool, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*ReviewerPool, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewerPoolMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *ReviewerPoolCreateBulk) SaveX(ctx context.Context) []*ReviewerPool {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *ReviewerPoolCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *ReviewerPoolCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewerPool.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewerPoolUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpcb *ReviewerPoolCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewerPoolUpsertBulk {
+	rpcb.conflict = opts
+	return &ReviewerPoolUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpcb *ReviewerPoolCreateBulk) OnConflictColumns(columns ...string) *ReviewerPoolUpsertBulk {
+	rpcb.conflict = append(rpcb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewerPoolUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// ReviewerPoolUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReviewerPool nodes.
+type ReviewerPoolUpsertBulk struct {
+	create *ReviewerPoolCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewerpool.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewerPoolUpsertBulk) UpdateNewValues() *ReviewerPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reviewerpool.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPool.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewerPoolUpsertBulk) Ignore() *ReviewerPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewerPoolUpsertBulk) DoNothing() *ReviewerPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewerPoolCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewerPoolUpsertBulk) Update(set func(*ReviewerPoolUpsert)) *ReviewerPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewerPoolUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolUpsertBulk) SetCreatedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolUpsertBulk) AddCreatedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateCreatedAt() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolUpsertBulk) SetUpdatedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolUpsertBulk) AddUpdatedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateUpdatedAt() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolUpsertBulk) SetDeletedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolUpsertBulk) AddDeletedAt(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateDeletedAt() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewerPoolUpsertBulk) SetAppID(v uuid.UUID) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateAppID() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReviewerPoolUpsertBulk) SetDomain(v string) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateDomain() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// SetObjectType sets the "object_type" field.
+func (u *ReviewerPoolUpsertBulk) SetObjectType(v string) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetObjectType(v)
+	})
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateObjectType() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateObjectType()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ReviewerPoolUpsertBulk) SetStrategy(v string) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateStrategy() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ReviewerPoolUpsertBulk) ClearStrategy() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.ClearStrategy()
+	})
+}
+
+// SetCursor sets the "cursor" field.
+func (u *ReviewerPoolUpsertBulk) SetCursor(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.SetCursor(v)
+	})
+}
+
+// AddCursor adds v to the "cursor" field.
+func (u *ReviewerPoolUpsertBulk) AddCursor(v uint32) *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.AddCursor(v)
+	})
+}
+
+// UpdateCursor sets the "cursor" field to the value that was provided on create.
+func (u *ReviewerPoolUpsertBulk) UpdateCursor() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.UpdateCursor()
+	})
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (u *ReviewerPoolUpsertBulk) ClearCursor() *ReviewerPoolUpsertBulk {
+	return u.Update(func(s *ReviewerPoolUpsert) {
+		s.ClearCursor()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewerPoolUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewerPoolCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewerPoolCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewerPoolUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+)
+
+// ReviewerPoolDelete is the builder for deleting a ReviewerPool entity.
+type ReviewerPoolDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReviewerPoolMutation
+}
+
+// Where appends a list predicates to the ReviewerPoolDelete builder.
+func (rpd *ReviewerPoolDelete) Where(ps ...predicate.ReviewerPool) *ReviewerPoolDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *ReviewerPoolDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpd.hooks) == 0 {
+		affected, err = rpd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpd.mutation = mutation
+			affected, err = rpd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpd.hooks) - 1; i >= 0; i-- {
+			if rpd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *ReviewerPoolDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *ReviewerPoolDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: reviewerpool.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		},
+	}
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ReviewerPoolDeleteOne is the builder for deleting a single ReviewerPool entity.
+type ReviewerPoolDeleteOne struct {
+	rpd *ReviewerPoolDelete
+}
+
+// Exec executes the deletion query.
+func (rpdo *ReviewerPoolDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reviewerpool.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *ReviewerPoolDeleteOne) ExecX(ctx context.Context) {
+	rpdo.rpd.ExecX(ctx)
+}
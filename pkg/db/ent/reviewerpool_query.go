@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolQuery is the builder for querying ReviewerPool entities.
+type ReviewerPoolQuery struct {
+	config
+	limit       *int
+	offset      *int
+	unique      *bool
+	order       []OrderFunc
+	fields      []string
+	predicates  []predicate.ReviewerPool
+	withMembers *ReviewerPoolMemberQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReviewerPoolQuery builder.
+func (rpq *ReviewerPoolQuery) Where(ps ...predicate.ReviewerPool) *ReviewerPoolQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit adds a limit step to the query.
+func (rpq *ReviewerPoolQuery) Limit(limit int) *ReviewerPoolQuery {
+	rpq.limit = &limit
+	return rpq
+}
+
+// Offset adds an offset step to the query.
+func (rpq *ReviewerPoolQuery) Offset(offset int) *ReviewerPoolQuery {
+	rpq.offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *ReviewerPoolQuery) Unique(unique bool) *ReviewerPoolQuery {
+	rpq.unique = &unique
+	return rpq
+}
+
+// Order adds an order step to the query.
+func (rpq *ReviewerPoolQuery) Order(o ...OrderFunc) *ReviewerPoolQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// QueryMembers chains the current query on the "members" edge.
+func (rpq *ReviewerPoolQuery) QueryMembers() *ReviewerPoolMemberQuery {
+	query := &ReviewerPoolMemberQuery{config: rpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewerpool.Table, reviewerpool.FieldID, selector),
+			sqlgraph.To(reviewerpoolmember.Table, reviewerpoolmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reviewerpool.MembersTable, reviewerpool.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReviewerPool entity from the query.
+// Returns a *NotFoundError when no ReviewerPool was found.
+func (rpq *ReviewerPoolQuery) First(ctx context.Context) (*ReviewerPool, error) {
+	nodes, err := rpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reviewerpool.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) FirstX(ctx context.Context) *ReviewerPool {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReviewerPool ID from the query.
+// Returns a *NotFoundError when no ReviewerPool ID was found.
+func (rpq *ReviewerPoolQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reviewerpool.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReviewerPool entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReviewerPool entity is found.
+// Returns a *NotFoundError when no ReviewerPool entities are found.
+func (rpq *ReviewerPoolQuery) Only(ctx context.Context) (*ReviewerPool, error) {
+	nodes, err := rpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reviewerpool.Label}
+	default:
+		return nil, &NotSingularError{reviewerpool.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) OnlyX(ctx context.Context) *ReviewerPool {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReviewerPool ID in the query.
+// Returns a *NotSingularError when more than one ReviewerPool ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *ReviewerPoolQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reviewerpool.Label}
+	default:
+		err = &NotSingularError{reviewerpool.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReviewerPools.
+func (rpq *ReviewerPoolQuery) All(ctx context.Context) ([]*ReviewerPool, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) AllX(ctx context.Context) []*ReviewerPool {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReviewerPool IDs.
+func (rpq *ReviewerPoolQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := rpq.Select(reviewerpool.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *ReviewerPoolQuery) Count(ctx context.Context) (int, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *ReviewerPoolQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *ReviewerPoolQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReviewerPoolQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *ReviewerPoolQuery) Clone() *ReviewerPoolQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &ReviewerPoolQuery{
+		config:      rpq.config,
+		limit:       rpq.limit,
+		offset:      rpq.offset,
+		order:       append([]OrderFunc{}, rpq.order...),
+		predicates:  append([]predicate.ReviewerPool{}, rpq.predicates...),
+		withMembers: rpq.withMembers.Clone(),
+		// clone intermediate query.
+		sql:    rpq.sql.Clone(),
+		path:   rpq.path,
+		unique: rpq.unique,
+	}
+}
+
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *ReviewerPoolQuery) WithMembers(opts ...func(*ReviewerPoolMemberQuery)) *ReviewerPoolQuery {
+	query := &ReviewerPoolMemberQuery{config: rpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withMembers = query
+	return rpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReviewerPool.Query().
+//		GroupBy(reviewerpool.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *ReviewerPoolQuery) GroupBy(field string, fields ...string) *ReviewerPoolGroupBy {
+	grbuild := &ReviewerPoolGroupBy{config: rpq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rpq.sqlQuery(ctx), nil
+	}
+	grbuild.label = reviewerpool.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReviewerPool.Query().
+//		Select(reviewerpool.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rpq *ReviewerPoolQuery) Select(fields ...string) *ReviewerPoolSelect {
+	rpq.fields = append(rpq.fields, fields...)
+	selbuild := &ReviewerPoolSelect{ReviewerPoolQuery: rpq}
+	selbuild.label = reviewerpool.Label
+	selbuild.flds, selbuild.scan = &rpq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rpq *ReviewerPoolQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rpq.fields {
+		if !reviewerpool.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	if reviewerpool.Policy == nil {
+		return errors.New("ent: uninitialized reviewerpool.Policy (forgotten import ent/runtime?)")
+	}
+	if err := reviewerpool.Policy.EvalQuery(ctx, rpq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rpq *ReviewerPoolQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReviewerPool, error) {
+	var (
+		nodes       = []*ReviewerPool{}
+		_spec       = rpq.querySpec()
+		loadedTypes = [1]bool{
+			rpq.withMembers != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ReviewerPool).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ReviewerPool{config: rpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rpq.withMembers; query != nil {
+		if err := rpq.loadMembers(ctx, query, nodes,
+			func(n *ReviewerPool) { n.Edges.Members = []*ReviewerPoolMember{} },
+			func(n *ReviewerPool, e *ReviewerPoolMember) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rpq *ReviewerPoolQuery) loadMembers(ctx context.Context, query *ReviewerPoolMemberQuery, nodes []*ReviewerPool, init func(*ReviewerPool), assign func(*ReviewerPool, *ReviewerPoolMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ReviewerPool)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(reviewerpool.MembersColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PoolID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pool_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rpq *ReviewerPoolQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	_spec.Node.Columns = rpq.fields
+	if len(rpq.fields) > 0 {
+		_spec.Unique = rpq.unique != nil && *rpq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *ReviewerPoolQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rpq *ReviewerPoolQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpool.Table,
+			Columns: reviewerpool.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		},
+		From:   rpq.sql,
+		Unique: true,
+	}
+	if unique := rpq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewerpool.FieldID)
+		for i := range fields {
+			if fields[i] != reviewerpool.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *ReviewerPoolQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(reviewerpool.Table)
+	columns := rpq.fields
+	if len(columns) == 0 {
+		columns = reviewerpool.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.unique != nil && *rpq.unique {
+		selector.Distinct()
+	}
+	for _, m := range rpq.modifiers {
+		m(selector)
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpq *ReviewerPoolQuery) ForUpdate(opts ...sql.LockOption) *ReviewerPoolQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpq *ReviewerPoolQuery) ForShare(opts ...sql.LockOption) *ReviewerPoolQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rpq *ReviewerPoolQuery) Modify(modifiers ...func(s *sql.Selector)) *ReviewerPoolSelect {
+	rpq.modifiers = append(rpq.modifiers, modifiers...)
+	return rpq.Select()
+}
+
+// ReviewerPoolGroupBy is the group-by builder for ReviewerPool entities.
+type ReviewerPoolGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *ReviewerPoolGroupBy) Aggregate(fns ...AggregateFunc) *ReviewerPoolGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rpgb *ReviewerPoolGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rpgb.sql = query
+	return rpgb.sqlScan(ctx, v)
+}
+
+func (rpgb *ReviewerPoolGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rpgb.fields {
+		if !reviewerpool.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rpgb *ReviewerPoolGroupBy) sqlQuery() *sql.Selector {
+	selector := rpgb.sql.Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rpgb.fields)+len(rpgb.fns))
+		for _, f := range rpgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rpgb.fields...)...)
+}
+
+// ReviewerPoolSelect is the builder for selecting fields of ReviewerPool entities.
+type ReviewerPoolSelect struct {
+	*ReviewerPoolQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *ReviewerPoolSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rps.sql = rps.ReviewerPoolQuery.sqlQuery(ctx)
+	return rps.sqlScan(ctx, v)
+}
+
+func (rps *ReviewerPoolSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rps.sql.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rps *ReviewerPoolSelect) Modify(modifiers ...func(s *sql.Selector)) *ReviewerPoolSelect {
+	rps.modifiers = append(rps.modifiers, modifiers...)
+	return rps
+}
@@ -0,0 +1,905 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolUpdate is the builder for updating ReviewerPool entities.
+type ReviewerPoolUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewerPoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewerPoolUpdate builder.
+func (rpu *ReviewerPoolUpdate) Where(ps ...predicate.ReviewerPool) *ReviewerPoolUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpu *ReviewerPoolUpdate) SetCreatedAt(u uint32) *ReviewerPoolUpdate {
+	rpu.mutation.ResetCreatedAt()
+	rpu.mutation.SetCreatedAt(u)
+	return rpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpu *ReviewerPoolUpdate) SetNillableCreatedAt(u *uint32) *ReviewerPoolUpdate {
+	if u != nil {
+		rpu.SetCreatedAt(*u)
+	}
+	return rpu
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rpu *ReviewerPoolUpdate) AddCreatedAt(u int32) *ReviewerPoolUpdate {
+	rpu.mutation.AddCreatedAt(u)
+	return rpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpu *ReviewerPoolUpdate) SetUpdatedAt(u uint32) *ReviewerPoolUpdate {
+	rpu.mutation.ResetUpdatedAt()
+	rpu.mutation.SetUpdatedAt(u)
+	return rpu
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rpu *ReviewerPoolUpdate) AddUpdatedAt(u int32) *ReviewerPoolUpdate {
+	rpu.mutation.AddUpdatedAt(u)
+	return rpu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpu *ReviewerPoolUpdate) SetDeletedAt(u uint32) *ReviewerPoolUpdate {
+	rpu.mutation.ResetDeletedAt()
+	rpu.mutation.SetDeletedAt(u)
+	return rpu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpu *ReviewerPoolUpdate) SetNillableDeletedAt(u *uint32) *ReviewerPoolUpdate {
+	if u != nil {
+		rpu.SetDeletedAt(*u)
+	}
+	return rpu
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rpu *ReviewerPoolUpdate) AddDeletedAt(u int32) *ReviewerPoolUpdate {
+	rpu.mutation.AddDeletedAt(u)
+	return rpu
+}
+
+// SetAppID sets the "app_id" field.
+func (rpu *ReviewerPoolUpdate) SetAppID(u uuid.UUID) *ReviewerPoolUpdate {
+	rpu.mutation.SetAppID(u)
+	return rpu
+}
+
+// SetDomain sets the "domain" field.
+func (rpu *ReviewerPoolUpdate) SetDomain(s string) *ReviewerPoolUpdate {
+	rpu.mutation.SetDomain(s)
+	return rpu
+}
+
+// SetObjectType sets the "object_type" field.
+func (rpu *ReviewerPoolUpdate) SetObjectType(s string) *ReviewerPoolUpdate {
+	rpu.mutation.SetObjectType(s)
+	return rpu
+}
+
+// SetStrategy sets the "strategy" field.
+func (rpu *ReviewerPoolUpdate) SetStrategy(s string) *ReviewerPoolUpdate {
+	rpu.mutation.SetStrategy(s)
+	return rpu
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (rpu *ReviewerPoolUpdate) SetNillableStrategy(s *string) *ReviewerPoolUpdate {
+	if s != nil {
+		rpu.SetStrategy(*s)
+	}
+	return rpu
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (rpu *ReviewerPoolUpdate) ClearStrategy() *ReviewerPoolUpdate {
+	rpu.mutation.ClearStrategy()
+	return rpu
+}
+
+// SetCursor sets the "cursor" field.
+func (rpu *ReviewerPoolUpdate) SetCursor(u uint32) *ReviewerPoolUpdate {
+	rpu.mutation.ResetCursor()
+	rpu.mutation.SetCursor(u)
+	return rpu
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rpu *ReviewerPoolUpdate) SetNillableCursor(u *uint32) *ReviewerPoolUpdate {
+	if u != nil {
+		rpu.SetCursor(*u)
+	}
+	return rpu
+}
+
+// AddCursor adds u to the "cursor" field.
+func (rpu *ReviewerPoolUpdate) AddCursor(u int32) *ReviewerPoolUpdate {
+	rpu.mutation.AddCursor(u)
+	return rpu
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (rpu *ReviewerPoolUpdate) ClearCursor() *ReviewerPoolUpdate {
+	rpu.mutation.ClearCursor()
+	return rpu
+}
+
+// AddMemberIDs adds the "members" edge to the ReviewerPoolMember entity by IDs.
+func (rpu *ReviewerPoolUpdate) AddMemberIDs(ids ...uuid.UUID) *ReviewerPoolUpdate {
+	rpu.mutation.AddMemberIDs(ids...)
+	return rpu
+}
+
+// AddMembers adds the "members" edges to the ReviewerPoolMember entity.
+func (rpu *ReviewerPoolUpdate) AddMembers(r ...*ReviewerPoolMember) *ReviewerPoolUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpu.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ReviewerPoolMutation object of the builder.
+func (rpu *ReviewerPoolUpdate) Mutation() *ReviewerPoolMutation {
+	return rpu.mutation
+}
+
+// ClearMembers clears all "members" edges to the ReviewerPoolMember entity.
+func (rpu *ReviewerPoolUpdate) ClearMembers() *ReviewerPoolUpdate {
+	rpu.mutation.ClearMembers()
+	return rpu
+}
+
+// RemoveMemberIDs removes the "members" edge to ReviewerPoolMember entities by IDs.
+func (rpu *ReviewerPoolUpdate) RemoveMemberIDs(ids ...uuid.UUID) *ReviewerPoolUpdate {
+	rpu.mutation.RemoveMemberIDs(ids...)
+	return rpu
+}
+
+// RemoveMembers removes "members" edges to ReviewerPoolMember entities.
+func (rpu *ReviewerPoolUpdate) RemoveMembers(r ...*ReviewerPoolMember) *ReviewerPoolUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpu.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *ReviewerPoolUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := rpu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(rpu.hooks) == 0 {
+		affected, err = rpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpu.mutation = mutation
+			affected, err = rpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpu.hooks) - 1; i >= 0; i-- {
+			if rpu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *ReviewerPoolUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *ReviewerPoolUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *ReviewerPoolUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpu *ReviewerPoolUpdate) defaults() error {
+	if _, ok := rpu.mutation.UpdatedAt(); !ok {
+		if reviewerpool.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.UpdateDefaultUpdatedAt()
+		rpu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpu *ReviewerPoolUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerPoolUpdate {
+	rpu.modifiers = append(rpu.modifiers, modifiers...)
+	return rpu
+}
+
+func (rpu *ReviewerPoolUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpool.Table,
+			Columns: reviewerpool.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		},
+	}
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpu.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpu.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpu.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpool.FieldAppID,
+		})
+	}
+	if value, ok := rpu.mutation.Domain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldDomain,
+		})
+	}
+	if value, ok := rpu.mutation.ObjectType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldObjectType,
+		})
+	}
+	if value, ok := rpu.mutation.Strategy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldStrategy,
+		})
+	}
+	if rpu.mutation.StrategyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewerpool.FieldStrategy,
+		})
+	}
+	if value, ok := rpu.mutation.Cursor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if value, ok := rpu.mutation.AddedCursor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if rpu.mutation.CursorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if rpu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !rpu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rpu.modifiers
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewerpool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReviewerPoolUpdateOne is the builder for updating a single ReviewerPool entity.
+type ReviewerPoolUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewerPoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpuo *ReviewerPoolUpdateOne) SetCreatedAt(u uint32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.ResetCreatedAt()
+	rpuo.mutation.SetCreatedAt(u)
+	return rpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpuo *ReviewerPoolUpdateOne) SetNillableCreatedAt(u *uint32) *ReviewerPoolUpdateOne {
+	if u != nil {
+		rpuo.SetCreatedAt(*u)
+	}
+	return rpuo
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rpuo *ReviewerPoolUpdateOne) AddCreatedAt(u int32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.AddCreatedAt(u)
+	return rpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpuo *ReviewerPoolUpdateOne) SetUpdatedAt(u uint32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.ResetUpdatedAt()
+	rpuo.mutation.SetUpdatedAt(u)
+	return rpuo
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rpuo *ReviewerPoolUpdateOne) AddUpdatedAt(u int32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.AddUpdatedAt(u)
+	return rpuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpuo *ReviewerPoolUpdateOne) SetDeletedAt(u uint32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.ResetDeletedAt()
+	rpuo.mutation.SetDeletedAt(u)
+	return rpuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpuo *ReviewerPoolUpdateOne) SetNillableDeletedAt(u *uint32) *ReviewerPoolUpdateOne {
+	if u != nil {
+		rpuo.SetDeletedAt(*u)
+	}
+	return rpuo
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rpuo *ReviewerPoolUpdateOne) AddDeletedAt(u int32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.AddDeletedAt(u)
+	return rpuo
+}
+
+// SetAppID sets the "app_id" field.
+func (rpuo *ReviewerPoolUpdateOne) SetAppID(u uuid.UUID) *ReviewerPoolUpdateOne {
+	rpuo.mutation.SetAppID(u)
+	return rpuo
+}
+
+// SetDomain sets the "domain" field.
+func (rpuo *ReviewerPoolUpdateOne) SetDomain(s string) *ReviewerPoolUpdateOne {
+	rpuo.mutation.SetDomain(s)
+	return rpuo
+}
+
+// SetObjectType sets the "object_type" field.
+func (rpuo *ReviewerPoolUpdateOne) SetObjectType(s string) *ReviewerPoolUpdateOne {
+	rpuo.mutation.SetObjectType(s)
+	return rpuo
+}
+
+// SetStrategy sets the "strategy" field.
+func (rpuo *ReviewerPoolUpdateOne) SetStrategy(s string) *ReviewerPoolUpdateOne {
+	rpuo.mutation.SetStrategy(s)
+	return rpuo
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (rpuo *ReviewerPoolUpdateOne) SetNillableStrategy(s *string) *ReviewerPoolUpdateOne {
+	if s != nil {
+		rpuo.SetStrategy(*s)
+	}
+	return rpuo
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (rpuo *ReviewerPoolUpdateOne) ClearStrategy() *ReviewerPoolUpdateOne {
+	rpuo.mutation.ClearStrategy()
+	return rpuo
+}
+
+// SetCursor sets the "cursor" field.
+func (rpuo *ReviewerPoolUpdateOne) SetCursor(u uint32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.ResetCursor()
+	rpuo.mutation.SetCursor(u)
+	return rpuo
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (rpuo *ReviewerPoolUpdateOne) SetNillableCursor(u *uint32) *ReviewerPoolUpdateOne {
+	if u != nil {
+		rpuo.SetCursor(*u)
+	}
+	return rpuo
+}
+
+// AddCursor adds u to the "cursor" field.
+func (rpuo *ReviewerPoolUpdateOne) AddCursor(u int32) *ReviewerPoolUpdateOne {
+	rpuo.mutation.AddCursor(u)
+	return rpuo
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (rpuo *ReviewerPoolUpdateOne) ClearCursor() *ReviewerPoolUpdateOne {
+	rpuo.mutation.ClearCursor()
+	return rpuo
+}
+
+// AddMemberIDs adds the "members" edge to the ReviewerPoolMember entity by IDs.
+func (rpuo *ReviewerPoolUpdateOne) AddMemberIDs(ids ...uuid.UUID) *ReviewerPoolUpdateOne {
+	rpuo.mutation.AddMemberIDs(ids...)
+	return rpuo
+}
+
+// AddMembers adds the "members" edges to the ReviewerPoolMember entity.
+func (rpuo *ReviewerPoolUpdateOne) AddMembers(r ...*ReviewerPoolMember) *ReviewerPoolUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpuo.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ReviewerPoolMutation object of the builder.
+func (rpuo *ReviewerPoolUpdateOne) Mutation() *ReviewerPoolMutation {
+	return rpuo.mutation
+}
+
+// ClearMembers clears all "members" edges to the ReviewerPoolMember entity.
+func (rpuo *ReviewerPoolUpdateOne) ClearMembers() *ReviewerPoolUpdateOne {
+	rpuo.mutation.ClearMembers()
+	return rpuo
+}
+
+// RemoveMemberIDs removes the "members" edge to ReviewerPoolMember entities by IDs.
+func (rpuo *ReviewerPoolUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *ReviewerPoolUpdateOne {
+	rpuo.mutation.RemoveMemberIDs(ids...)
+	return rpuo
+}
+
+// RemoveMembers removes "members" edges to ReviewerPoolMember entities.
+func (rpuo *ReviewerPoolUpdateOne) RemoveMembers(r ...*ReviewerPoolMember) *ReviewerPoolUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpuo.RemoveMemberIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *ReviewerPoolUpdateOne) Select(field string, fields ...string) *ReviewerPoolUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated ReviewerPool entity.
+func (rpuo *ReviewerPoolUpdateOne) Save(ctx context.Context) (*ReviewerPool, error) {
+	var (
+		err  error
+		node *ReviewerPool
+	)
+	if err := rpuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rpuo.hooks) == 0 {
+		node, err = rpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpuo.mutation = mutation
+			node, err = rpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpuo.hooks) - 1; i >= 0; i-- {
+			if rpuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewerPool)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewerPoolMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *ReviewerPoolUpdateOne) SaveX(ctx context.Context) *ReviewerPool {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *ReviewerPoolUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *ReviewerPoolUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpuo *ReviewerPoolUpdateOne) defaults() error {
+	if _, ok := rpuo.mutation.UpdatedAt(); !ok {
+		if reviewerpool.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpool.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpool.UpdateDefaultUpdatedAt()
+		rpuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpuo *ReviewerPoolUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerPoolUpdateOne {
+	rpuo.modifiers = append(rpuo.modifiers, modifiers...)
+	return rpuo
+}
+
+func (rpuo *ReviewerPoolUpdateOne) sqlSave(ctx context.Context) (_node *ReviewerPool, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpool.Table,
+			Columns: reviewerpool.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpool.FieldID,
+			},
+		},
+	}
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewerPool.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewerpool.FieldID)
+		for _, f := range fields {
+			if !reviewerpool.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewerpool.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpool.FieldAppID,
+		})
+	}
+	if value, ok := rpuo.mutation.Domain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldDomain,
+		})
+	}
+	if value, ok := rpuo.mutation.ObjectType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldObjectType,
+		})
+	}
+	if value, ok := rpuo.mutation.Strategy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewerpool.FieldStrategy,
+		})
+	}
+	if rpuo.mutation.StrategyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewerpool.FieldStrategy,
+		})
+	}
+	if value, ok := rpuo.mutation.Cursor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if value, ok := rpuo.mutation.AddedCursor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if rpuo.mutation.CursorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: reviewerpool.FieldCursor,
+		})
+	}
+	if rpuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !rpuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reviewerpool.MembersTable,
+			Columns: []string{reviewerpool.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpoolmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rpuo.modifiers
+	_node = &ReviewerPool{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewerpool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
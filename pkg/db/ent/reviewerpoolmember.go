@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolMember is the model entity for the ReviewerPoolMember schema.
+type ReviewerPoolMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt uint32 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt uint32 `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt uint32 `json:"deleted_at,omitempty"`
+	// PoolID holds the value of the "pool_id" field.
+	PoolID uuid.UUID `json:"pool_id,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID uuid.UUID `json:"reviewer_id,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight uint32 `json:"weight,omitempty"`
+	// OnShift holds the value of the "on_shift" field.
+	OnShift bool `json:"on_shift,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewerPoolMemberQuery when eager-loading is set.
+	Edges ReviewerPoolMemberEdges `json:"edges"`
+}
+
+// ReviewerPoolMemberEdges holds the relations/edges for other nodes in the graph.
+type ReviewerPoolMemberEdges struct {
+	// Pool holds the value of the pool edge.
+	Pool *ReviewerPool `json:"pool,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PoolOrErr returns the Pool value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewerPoolMemberEdges) PoolOrErr() (*ReviewerPool, error) {
+	if e.loadedTypes[0] {
+		if e.Pool == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: reviewerpool.Label}
+		}
+		return e.Pool, nil
+	}
+	return nil, &NotLoadedError{edge: "pool"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReviewerPoolMember) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewerpoolmember.FieldOnShift:
+			values[i] = new(sql.NullBool)
+		case reviewerpoolmember.FieldCreatedAt, reviewerpoolmember.FieldUpdatedAt, reviewerpoolmember.FieldDeletedAt, reviewerpoolmember.FieldWeight:
+			values[i] = new(sql.NullInt64)
+		case reviewerpoolmember.FieldID, reviewerpoolmember.FieldPoolID, reviewerpoolmember.FieldReviewerID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ReviewerPoolMember", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReviewerPoolMember fields.
+func (rpm *ReviewerPoolMember) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewerpoolmember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rpm.ID = *value
+			}
+		case reviewerpoolmember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rpm.CreatedAt = uint32(value.Int64)
+			}
+		case reviewerpoolmember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rpm.UpdatedAt = uint32(value.Int64)
+			}
+		case reviewerpoolmember.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rpm.DeletedAt = uint32(value.Int64)
+			}
+		case reviewerpoolmember.FieldPoolID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field pool_id", values[i])
+			} else if value != nil {
+				rpm.PoolID = *value
+			}
+		case reviewerpoolmember.FieldReviewerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value != nil {
+				rpm.ReviewerID = *value
+			}
+		case reviewerpoolmember.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				rpm.Weight = uint32(value.Int64)
+			}
+		case reviewerpoolmember.FieldOnShift:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field on_shift", values[i])
+			} else if value.Valid {
+				rpm.OnShift = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPool queries the "pool" edge of the ReviewerPoolMember entity.
+func (rpm *ReviewerPoolMember) QueryPool() *ReviewerPoolQuery {
+	return (&ReviewerPoolMemberClient{config: rpm.config}).QueryPool(rpm)
+}
+
+// Update returns a builder for updating this ReviewerPoolMember.
+// Note that you need to call ReviewerPoolMember.Unwrap() before calling this method if this ReviewerPoolMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rpm *ReviewerPoolMember) Update() *ReviewerPoolMemberUpdateOne {
+	return (&ReviewerPoolMemberClient{config: rpm.config}).UpdateOne(rpm)
+}
+
+// Unwrap unwraps the ReviewerPoolMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rpm *ReviewerPoolMember) Unwrap() *ReviewerPoolMember {
+	_tx, ok := rpm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReviewerPoolMember is not a transactional entity")
+	}
+	rpm.config.driver = _tx.drv
+	return rpm
+}
+
+// String implements the fmt.Stringer.
+func (rpm *ReviewerPoolMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReviewerPoolMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rpm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("pool_id=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.PoolID))
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_id=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.ReviewerID))
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("on_shift=")
+	builder.WriteString(fmt.Sprintf("%v", rpm.OnShift))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReviewerPoolMembers is a parsable slice of ReviewerPoolMember.
+type ReviewerPoolMembers []*ReviewerPoolMember
+
+func (rpm ReviewerPoolMembers) config(cfg config) {
+	for _i := range rpm {
+		rpm[_i].config = cfg
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewerpoolmember
+
+import (
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reviewerpoolmember type in the database.
+	Label = "reviewer_pool_member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPoolID holds the string denoting the pool_id field in the database.
+	FieldPoolID = "pool_id"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldOnShift holds the string denoting the on_shift field in the database.
+	FieldOnShift = "on_shift"
+	// EdgePool holds the string denoting the pool edge name in mutations.
+	EdgePool = "pool"
+	// Table holds the table name of the reviewerpoolmember in the database.
+	Table = "reviewer_pool_members"
+	// PoolTable is the table that holds the pool relation/edge.
+	PoolTable = "reviewer_pool_members"
+	// PoolInverseTable is the table name for the ReviewerPool entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewerpool" package.
+	PoolInverseTable = "reviewer_pools"
+	// PoolColumn is the table column denoting the pool relation/edge.
+	PoolColumn = "pool_id"
+)
+
+// Columns holds all SQL columns for reviewerpoolmember fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldPoolID,
+	FieldReviewerID,
+	FieldWeight,
+	FieldOnShift,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/NpoolPlatform/review-manager/pkg/db/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() uint32
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() uint32
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() uint32
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt func() uint32
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight uint32
+	// DefaultOnShift holds the default value on creation for the "on_shift" field.
+	DefaultOnShift bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewerpoolmember
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// PoolID applies equality check predicate on the "pool_id" field. It's identical to PoolIDEQ.
+func PoolID(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPoolID), v))
+	})
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewerID), v))
+	})
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeight), v))
+	})
+}
+
+// OnShift applies equality check predicate on the "on_shift" field. It's identical to OnShiftEQ.
+func OnShift(v bool) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOnShift), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// PoolIDEQ applies the EQ predicate on the "pool_id" field.
+func PoolIDEQ(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPoolID), v))
+	})
+}
+
+// PoolIDNEQ applies the NEQ predicate on the "pool_id" field.
+func PoolIDNEQ(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPoolID), v))
+	})
+}
+
+// PoolIDIn applies the In predicate on the "pool_id" field.
+func PoolIDIn(vs ...uuid.UUID) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPoolID), v...))
+	})
+}
+
+// PoolIDNotIn applies the NotIn predicate on the "pool_id" field.
+func PoolIDNotIn(vs ...uuid.UUID) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPoolID), v...))
+	})
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewerID), v))
+	})
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewerID), v))
+	})
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...uuid.UUID) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldReviewerID), v...))
+	})
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...uuid.UUID) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldReviewerID), v...))
+	})
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReviewerID), v))
+	})
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReviewerID), v))
+	})
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReviewerID), v))
+	})
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v uuid.UUID) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReviewerID), v))
+	})
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeight), v))
+	})
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWeight), v))
+	})
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWeight), v...))
+	})
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...uint32) predicate.ReviewerPoolMember {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWeight), v...))
+	})
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWeight), v))
+	})
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWeight), v))
+	})
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWeight), v))
+	})
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v uint32) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWeight), v))
+	})
+}
+
+// WeightIsNil applies the IsNil predicate on the "weight" field.
+func WeightIsNil() predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWeight)))
+	})
+}
+
+// WeightNotNil applies the NotNil predicate on the "weight" field.
+func WeightNotNil() predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWeight)))
+	})
+}
+
+// OnShiftEQ applies the EQ predicate on the "on_shift" field.
+func OnShiftEQ(v bool) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOnShift), v))
+	})
+}
+
+// OnShiftNEQ applies the NEQ predicate on the "on_shift" field.
+func OnShiftNEQ(v bool) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOnShift), v))
+	})
+}
+
+// OnShiftIsNil applies the IsNil predicate on the "on_shift" field.
+func OnShiftIsNil() predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOnShift)))
+	})
+}
+
+// OnShiftNotNil applies the NotNil predicate on the "on_shift" field.
+func OnShiftNotNil() predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOnShift)))
+	})
+}
+
+// HasPool applies the HasEdge predicate on the "pool" edge.
+func HasPool() predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PoolTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PoolTable, PoolColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPoolWith applies the HasEdge predicate on the "pool" edge with a given conditions (other predicates).
+func HasPoolWith(preds ...predicate.ReviewerPool) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PoolInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PoolTable, PoolColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReviewerPoolMember) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReviewerPoolMember) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReviewerPoolMember) predicate.ReviewerPoolMember {
+	return predicate.ReviewerPoolMember(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
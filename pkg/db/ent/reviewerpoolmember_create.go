@@ -0,0 +1,1101 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolMemberCreate is the builder for creating a ReviewerPoolMember entity.
+type ReviewerPoolMemberCreate struct {
+	config
+	mutation *ReviewerPoolMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpmc *ReviewerPoolMemberCreate) SetCreatedAt(u uint32) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetCreatedAt(u)
+	return rpmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableCreatedAt(u *uint32) *ReviewerPoolMemberCreate {
+	if u != nil {
+		rpmc.SetCreatedAt(*u)
+	}
+	return rpmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpmc *ReviewerPoolMemberCreate) SetUpdatedAt(u uint32) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetUpdatedAt(u)
+	return rpmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableUpdatedAt(u *uint32) *ReviewerPoolMemberCreate {
+	if u != nil {
+		rpmc.SetUpdatedAt(*u)
+	}
+	return rpmc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpmc *ReviewerPoolMemberCreate) SetDeletedAt(u uint32) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetDeletedAt(u)
+	return rpmc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableDeletedAt(u *uint32) *ReviewerPoolMemberCreate {
+	if u != nil {
+		rpmc.SetDeletedAt(*u)
+	}
+	return rpmc
+}
+
+// SetPoolID sets the "pool_id" field.
+func (rpmc *ReviewerPoolMemberCreate) SetPoolID(u uuid.UUID) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetPoolID(u)
+	return rpmc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rpmc *ReviewerPoolMemberCreate) SetReviewerID(u uuid.UUID) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetReviewerID(u)
+	return rpmc
+}
+
+// SetWeight sets the "weight" field.
+func (rpmc *ReviewerPoolMemberCreate) SetWeight(u uint32) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetWeight(u)
+	return rpmc
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableWeight(u *uint32) *ReviewerPoolMemberCreate {
+	if u != nil {
+		rpmc.SetWeight(*u)
+	}
+	return rpmc
+}
+
+// SetOnShift sets the "on_shift" field.
+func (rpmc *ReviewerPoolMemberCreate) SetOnShift(b bool) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetOnShift(b)
+	return rpmc
+}
+
+// SetNillableOnShift sets the "on_shift" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableOnShift(b *bool) *ReviewerPoolMemberCreate {
+	if b != nil {
+		rpmc.SetOnShift(*b)
+	}
+	return rpmc
+}
+
+// SetID sets the "id" field.
+func (rpmc *ReviewerPoolMemberCreate) SetID(u uuid.UUID) *ReviewerPoolMemberCreate {
+	rpmc.mutation.SetID(u)
+	return rpmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpmc *ReviewerPoolMemberCreate) SetNillableID(u *uuid.UUID) *ReviewerPoolMemberCreate {
+	if u != nil {
+		rpmc.SetID(*u)
+	}
+	return rpmc
+}
+
+// SetPool sets the "pool" edge to the ReviewerPool entity.
+func (rpmc *ReviewerPoolMemberCreate) SetPool(r *ReviewerPool) *ReviewerPoolMemberCreate {
+	return rpmc.SetPoolID(r.ID)
+}
+
+// Mutation returns the ReviewerPoolMemberMutation object of the builder.
+func (rpmc *ReviewerPoolMemberCreate) Mutation() *ReviewerPoolMemberMutation {
+	return rpmc.mutation
+}
+
+// Save creates the ReviewerPoolMember in the database.
+func (rpmc *ReviewerPoolMemberCreate) Save(ctx context.Context) (*ReviewerPoolMember, error) {
+	var (
+		err  error
+		node *ReviewerPoolMember
+	)
+	if err := rpmc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rpmc.hooks) == 0 {
+		if err = rpmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpmc.check(); err != nil {
+				return nil, err
+			}
+			rpmc.mutation = mutation
+			if node, err = rpmc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpmc.hooks) - 1; i >= 0; i-- {
+			if rpmc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpmc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpmc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewerPoolMember)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewerPoolMemberMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpmc *ReviewerPoolMemberCreate) SaveX(ctx context.Context) *ReviewerPoolMember {
+	v, err := rpmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpmc *ReviewerPoolMemberCreate) Exec(ctx context.Context) error {
+	_, err := rpmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmc *ReviewerPoolMemberCreate) ExecX(ctx context.Context) {
+	if err := rpmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpmc *ReviewerPoolMemberCreate) defaults() error {
+	if _, ok := rpmc.mutation.CreatedAt(); !ok {
+		if reviewerpoolmember.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.DefaultCreatedAt()
+		rpmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpmc.mutation.UpdatedAt(); !ok {
+		if reviewerpoolmember.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.DefaultUpdatedAt()
+		rpmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rpmc.mutation.DeletedAt(); !ok {
+		if reviewerpoolmember.DefaultDeletedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.DefaultDeletedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.DefaultDeletedAt()
+		rpmc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rpmc.mutation.Weight(); !ok {
+		v := reviewerpoolmember.DefaultWeight
+		rpmc.mutation.SetWeight(v)
+	}
+	if _, ok := rpmc.mutation.OnShift(); !ok {
+		v := reviewerpoolmember.DefaultOnShift
+		rpmc.mutation.SetOnShift(v)
+	}
+	if _, ok := rpmc.mutation.ID(); !ok {
+		if reviewerpoolmember.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.DefaultID()
+		rpmc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpmc *ReviewerPoolMemberCreate) check() error {
+	if _, ok := rpmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReviewerPoolMember.created_at"`)}
+	}
+	if _, ok := rpmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReviewerPoolMember.updated_at"`)}
+	}
+	if _, ok := rpmc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "ReviewerPoolMember.deleted_at"`)}
+	}
+	if _, ok := rpmc.mutation.PoolID(); !ok {
+		return &ValidationError{Name: "pool_id", err: errors.New(`ent: missing required field "ReviewerPoolMember.pool_id"`)}
+	}
+	if _, ok := rpmc.mutation.ReviewerID(); !ok {
+		return &ValidationError{Name: "reviewer_id", err: errors.New(`ent: missing required field "ReviewerPoolMember.reviewer_id"`)}
+	}
+	if _, ok := rpmc.mutation.PoolID(); !ok {
+		return &ValidationError{Name: "pool", err: errors.New(`ent: missing required edge "ReviewerPoolMember.pool"`)}
+	}
+	return nil
+}
+
+func (rpmc *ReviewerPoolMemberCreate) sqlSave(ctx context.Context) (*ReviewerPoolMember, error) {
+	_node, _spec := rpmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rpmc *ReviewerPoolMemberCreate) createSpec() (*ReviewerPoolMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewerPoolMember{config: rpmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reviewerpoolmember.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = rpmc.conflict
+	if id, ok := rpmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rpmc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rpmc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := rpmc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := rpmc.mutation.ReviewerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpoolmember.FieldReviewerID,
+		})
+		_node.ReviewerID = value
+	}
+	if value, ok := rpmc.mutation.Weight(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+		_node.Weight = value
+	}
+	if value, ok := rpmc.mutation.OnShift(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: reviewerpoolmember.FieldOnShift,
+		})
+		_node.OnShift = value
+	}
+	if nodes := rpmc.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpool.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PoolID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewerPoolMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewerPoolMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpmc *ReviewerPoolMemberCreate) OnConflict(opts ...sql.ConflictOption) *ReviewerPoolMemberUpsertOne {
+	rpmc.conflict = opts
+	return &ReviewerPoolMemberUpsertOne{
+		create: rpmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpmc *ReviewerPoolMemberCreate) OnConflictColumns(columns ...string) *ReviewerPoolMemberUpsertOne {
+	rpmc.conflict = append(rpmc.conflict, sql.ConflictColumns(columns...))
+	return &ReviewerPoolMemberUpsertOne{
+		create: rpmc,
+	}
+}
+
+type (
+	// ReviewerPoolMemberUpsertOne is the builder for "upsert"-ing
+	//  one ReviewerPoolMember node.
+	ReviewerPoolMemberUpsertOne struct {
+		create *ReviewerPoolMemberCreate
+	}
+
+	// ReviewerPoolMemberUpsert is the "OnConflict" setter.
+	ReviewerPoolMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolMemberUpsert) SetCreatedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateCreatedAt() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolMemberUpsert) AddCreatedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Add(reviewerpoolmember.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolMemberUpsert) SetUpdatedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateUpdatedAt() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolMemberUpsert) AddUpdatedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Add(reviewerpoolmember.FieldUpdatedAt, v)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsert) SetDeletedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateDeletedAt() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsert) AddDeletedAt(v uint32) *ReviewerPoolMemberUpsert {
+	u.Add(reviewerpoolmember.FieldDeletedAt, v)
+	return u
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ReviewerPoolMemberUpsert) SetPoolID(v uuid.UUID) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldPoolID, v)
+	return u
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdatePoolID() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldPoolID)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewerPoolMemberUpsert) SetReviewerID(v uuid.UUID) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateReviewerID() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldReviewerID)
+	return u
+}
+
+// SetWeight sets the "weight" field.
+func (u *ReviewerPoolMemberUpsert) SetWeight(v uint32) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldWeight, v)
+	return u
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateWeight() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldWeight)
+	return u
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ReviewerPoolMemberUpsert) AddWeight(v uint32) *ReviewerPoolMemberUpsert {
+	u.Add(reviewerpoolmember.FieldWeight, v)
+	return u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *ReviewerPoolMemberUpsert) ClearWeight() *ReviewerPoolMemberUpsert {
+	u.SetNull(reviewerpoolmember.FieldWeight)
+	return u
+}
+
+// SetOnShift sets the "on_shift" field.
+func (u *ReviewerPoolMemberUpsert) SetOnShift(v bool) *ReviewerPoolMemberUpsert {
+	u.Set(reviewerpoolmember.FieldOnShift, v)
+	return u
+}
+
+// UpdateOnShift sets the "on_shift" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsert) UpdateOnShift() *ReviewerPoolMemberUpsert {
+	u.SetExcluded(reviewerpoolmember.FieldOnShift)
+	return u
+}
+
+// ClearOnShift clears the value of the "on_shift" field.
+func (u *ReviewerPoolMemberUpsert) ClearOnShift() *ReviewerPoolMemberUpsert {
+	u.SetNull(reviewerpoolmember.FieldOnShift)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewerpoolmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewerPoolMemberUpsertOne) UpdateNewValues() *ReviewerPoolMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reviewerpoolmember.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewerPoolMemberUpsertOne) Ignore() *ReviewerPoolMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewerPoolMemberUpsertOne) DoNothing() *ReviewerPoolMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewerPoolMemberCreate.OnConflict
+// documentation for more info.
+func (u *ReviewerPoolMemberUpsertOne) Update(set func(*ReviewerPoolMemberUpsert)) *ReviewerPoolMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewerPoolMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolMemberUpsertOne) SetCreatedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolMemberUpsertOne) AddCreatedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateCreatedAt() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolMemberUpsertOne) SetUpdatedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolMemberUpsertOne) AddUpdatedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateUpdatedAt() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsertOne) SetDeletedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsertOne) AddDeletedAt(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateDeletedAt() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ReviewerPoolMemberUpsertOne) SetPoolID(v uuid.UUID) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdatePoolID() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewerPoolMemberUpsertOne) SetReviewerID(v uuid.UUID) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateReviewerID() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *ReviewerPoolMemberUpsertOne) SetWeight(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ReviewerPoolMemberUpsertOne) AddWeight(v uint32) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateWeight() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *ReviewerPoolMemberUpsertOne) ClearWeight() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.ClearWeight()
+	})
+}
+
+// SetOnShift sets the "on_shift" field.
+func (u *ReviewerPoolMemberUpsertOne) SetOnShift(v bool) *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetOnShift(v)
+	})
+}
+
+// UpdateOnShift sets the "on_shift" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertOne) UpdateOnShift() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateOnShift()
+	})
+}
+
+// ClearOnShift clears the value of the "on_shift" field.
+func (u *ReviewerPoolMemberUpsertOne) ClearOnShift() *ReviewerPoolMemberUpsertOne {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.ClearOnShift()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewerPoolMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewerPoolMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewerPoolMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewerPoolMemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewerPoolMemberUpsertOne.ID is not supported by MySQL driver. Use ReviewerPoolMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewerPoolMemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewerPoolMemberCreateBulk is the builder for creating many ReviewerPoolMember entities in bulk.
+type ReviewerPoolMemberCreateBulk struct {
+	config
+	builders []*ReviewerPoolMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReviewerPoolMember entities in the database.
+func (rpmcb *ReviewerPoolMemberCreateBulk) Save(ctx context.Context) ([]*ReviewerPoolMember, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rpmcb.builders))
+	nodes := make([]*ReviewerPoolMember, len(rpmcb.builders))
+	mutators := make([]Mutator, len(rpmcb.builders))
+	for i := range rpmcb.builders {
+		func(i int, root context.Context) {
+			builder := rpmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewerPoolMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rpmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpmcb *ReviewerPoolMemberCreateBulk) SaveX(ctx context.Context) []*ReviewerPoolMember {
+	v, err := rpmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpmcb *ReviewerPoolMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmcb *ReviewerPoolMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := rpmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewerPoolMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewerPoolMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpmcb *ReviewerPoolMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewerPoolMemberUpsertBulk {
+	rpmcb.conflict = opts
+	return &ReviewerPoolMemberUpsertBulk{
+		create: rpmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpmcb *ReviewerPoolMemberCreateBulk) OnConflictColumns(columns ...string) *ReviewerPoolMemberUpsertBulk {
+	rpmcb.conflict = append(rpmcb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewerPoolMemberUpsertBulk{
+		create: rpmcb,
+	}
+}
+
+// ReviewerPoolMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReviewerPoolMember nodes.
+type ReviewerPoolMemberUpsertBulk struct {
+	create *ReviewerPoolMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewerpoolmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewerPoolMemberUpsertBulk) UpdateNewValues() *ReviewerPoolMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reviewerpoolmember.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewerPoolMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewerPoolMemberUpsertBulk) Ignore() *ReviewerPoolMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewerPoolMemberUpsertBulk) DoNothing() *ReviewerPoolMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewerPoolMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewerPoolMemberUpsertBulk) Update(set func(*ReviewerPoolMemberUpsert)) *ReviewerPoolMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewerPoolMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetCreatedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) AddCreatedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateCreatedAt() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetUpdatedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) AddUpdatedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateUpdatedAt() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetDeletedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewerPoolMemberUpsertBulk) AddDeletedAt(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateDeletedAt() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetPoolID(v uuid.UUID) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdatePoolID() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetReviewerID(v uuid.UUID) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateReviewerID() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetWeight(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ReviewerPoolMemberUpsertBulk) AddWeight(v uint32) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateWeight() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *ReviewerPoolMemberUpsertBulk) ClearWeight() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.ClearWeight()
+	})
+}
+
+// SetOnShift sets the "on_shift" field.
+func (u *ReviewerPoolMemberUpsertBulk) SetOnShift(v bool) *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.SetOnShift(v)
+	})
+}
+
+// UpdateOnShift sets the "on_shift" field to the value that was provided on create.
+func (u *ReviewerPoolMemberUpsertBulk) UpdateOnShift() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.UpdateOnShift()
+	})
+}
+
+// ClearOnShift clears the value of the "on_shift" field.
+func (u *ReviewerPoolMemberUpsertBulk) ClearOnShift() *ReviewerPoolMemberUpsertBulk {
+	return u.Update(func(s *ReviewerPoolMemberUpsert) {
+		s.ClearOnShift()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewerPoolMemberUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewerPoolMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewerPoolMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewerPoolMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+)
+
+// ReviewerPoolMemberDelete is the builder for deleting a ReviewerPoolMember entity.
+type ReviewerPoolMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReviewerPoolMemberMutation
+}
+
+// Where appends a list predicates to the ReviewerPoolMemberDelete builder.
+func (rpmd *ReviewerPoolMemberDelete) Where(ps ...predicate.ReviewerPoolMember) *ReviewerPoolMemberDelete {
+	rpmd.mutation.Where(ps...)
+	return rpmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpmd *ReviewerPoolMemberDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpmd.hooks) == 0 {
+		affected, err = rpmd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpmd.mutation = mutation
+			affected, err = rpmd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpmd.hooks) - 1; i >= 0; i-- {
+			if rpmd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpmd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpmd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmd *ReviewerPoolMemberDelete) ExecX(ctx context.Context) int {
+	n, err := rpmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpmd *ReviewerPoolMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: reviewerpoolmember.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		},
+	}
+	if ps := rpmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ReviewerPoolMemberDeleteOne is the builder for deleting a single ReviewerPoolMember entity.
+type ReviewerPoolMemberDeleteOne struct {
+	rpmd *ReviewerPoolMemberDelete
+}
+
+// Exec executes the deletion query.
+func (rpmdo *ReviewerPoolMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpmdo.rpmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reviewerpoolmember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmdo *ReviewerPoolMemberDeleteOne) ExecX(ctx context.Context) {
+	rpmdo.rpmd.ExecX(ctx)
+}
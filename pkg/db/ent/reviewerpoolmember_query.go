@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolMemberQuery is the builder for querying ReviewerPoolMember entities.
+type ReviewerPoolMemberQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ReviewerPoolMember
+	withPool   *ReviewerPoolQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReviewerPoolMemberQuery builder.
+func (rpmq *ReviewerPoolMemberQuery) Where(ps ...predicate.ReviewerPoolMember) *ReviewerPoolMemberQuery {
+	rpmq.predicates = append(rpmq.predicates, ps...)
+	return rpmq
+}
+
+// Limit adds a limit step to the query.
+func (rpmq *ReviewerPoolMemberQuery) Limit(limit int) *ReviewerPoolMemberQuery {
+	rpmq.limit = &limit
+	return rpmq
+}
+
+// Offset adds an offset step to the query.
+func (rpmq *ReviewerPoolMemberQuery) Offset(offset int) *ReviewerPoolMemberQuery {
+	rpmq.offset = &offset
+	return rpmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpmq *ReviewerPoolMemberQuery) Unique(unique bool) *ReviewerPoolMemberQuery {
+	rpmq.unique = &unique
+	return rpmq
+}
+
+// Order adds an order step to the query.
+func (rpmq *ReviewerPoolMemberQuery) Order(o ...OrderFunc) *ReviewerPoolMemberQuery {
+	rpmq.order = append(rpmq.order, o...)
+	return rpmq
+}
+
+// QueryPool chains the current query on the "pool" edge.
+func (rpmq *ReviewerPoolMemberQuery) QueryPool() *ReviewerPoolQuery {
+	query := &ReviewerPoolQuery{config: rpmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewerpoolmember.Table, reviewerpoolmember.FieldID, selector),
+			sqlgraph.To(reviewerpool.Table, reviewerpool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewerpoolmember.PoolTable, reviewerpoolmember.PoolColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReviewerPoolMember entity from the query.
+// Returns a *NotFoundError when no ReviewerPoolMember was found.
+func (rpmq *ReviewerPoolMemberQuery) First(ctx context.Context) (*ReviewerPoolMember, error) {
+	nodes, err := rpmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reviewerpoolmember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) FirstX(ctx context.Context) *ReviewerPoolMember {
+	node, err := rpmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReviewerPoolMember ID from the query.
+// Returns a *NotFoundError when no ReviewerPoolMember ID was found.
+func (rpmq *ReviewerPoolMemberQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reviewerpoolmember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rpmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReviewerPoolMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReviewerPoolMember entity is found.
+// Returns a *NotFoundError when no ReviewerPoolMember entities are found.
+func (rpmq *ReviewerPoolMemberQuery) Only(ctx context.Context) (*ReviewerPoolMember, error) {
+	nodes, err := rpmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reviewerpoolmember.Label}
+	default:
+		return nil, &NotSingularError{reviewerpoolmember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) OnlyX(ctx context.Context) *ReviewerPoolMember {
+	node, err := rpmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReviewerPoolMember ID in the query.
+// Returns a *NotSingularError when more than one ReviewerPoolMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpmq *ReviewerPoolMemberQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reviewerpoolmember.Label}
+	default:
+		err = &NotSingularError{reviewerpoolmember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rpmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReviewerPoolMembers.
+func (rpmq *ReviewerPoolMemberQuery) All(ctx context.Context) ([]*ReviewerPoolMember, error) {
+	if err := rpmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rpmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) AllX(ctx context.Context) []*ReviewerPoolMember {
+	nodes, err := rpmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReviewerPoolMember IDs.
+func (rpmq *ReviewerPoolMemberQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := rpmq.Select(reviewerpoolmember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rpmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpmq *ReviewerPoolMemberQuery) Count(ctx context.Context) (int, error) {
+	if err := rpmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rpmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) CountX(ctx context.Context) int {
+	count, err := rpmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpmq *ReviewerPoolMemberQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rpmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rpmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpmq *ReviewerPoolMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReviewerPoolMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpmq *ReviewerPoolMemberQuery) Clone() *ReviewerPoolMemberQuery {
+	if rpmq == nil {
+		return nil
+	}
+	return &ReviewerPoolMemberQuery{
+		config:     rpmq.config,
+		limit:      rpmq.limit,
+		offset:     rpmq.offset,
+		order:      append([]OrderFunc{}, rpmq.order...),
+		predicates: append([]predicate.ReviewerPoolMember{}, rpmq.predicates...),
+		withPool:   rpmq.withPool.Clone(),
+		// clone intermediate query.
+		sql:    rpmq.sql.Clone(),
+		path:   rpmq.path,
+		unique: rpmq.unique,
+	}
+}
+
+// WithPool tells the query-builder to eager-load the nodes that are connected to
+// the "pool" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpmq *ReviewerPoolMemberQuery) WithPool(opts ...func(*ReviewerPoolQuery)) *ReviewerPoolMemberQuery {
+	query := &ReviewerPoolQuery{config: rpmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpmq.withPool = query
+	return rpmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReviewerPoolMember.Query().
+//		GroupBy(reviewerpoolmember.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpmq *ReviewerPoolMemberQuery) GroupBy(field string, fields ...string) *ReviewerPoolMemberGroupBy {
+	grbuild := &ReviewerPoolMemberGroupBy{config: rpmq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rpmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rpmq.sqlQuery(ctx), nil
+	}
+	grbuild.label = reviewerpoolmember.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReviewerPoolMember.Query().
+//		Select(reviewerpoolmember.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rpmq *ReviewerPoolMemberQuery) Select(fields ...string) *ReviewerPoolMemberSelect {
+	rpmq.fields = append(rpmq.fields, fields...)
+	selbuild := &ReviewerPoolMemberSelect{ReviewerPoolMemberQuery: rpmq}
+	selbuild.label = reviewerpoolmember.Label
+	selbuild.flds, selbuild.scan = &rpmq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rpmq *ReviewerPoolMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rpmq.fields {
+		if !reviewerpoolmember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpmq.path != nil {
+		prev, err := rpmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpmq.sql = prev
+	}
+	if reviewerpoolmember.Policy == nil {
+		return errors.New("ent: uninitialized reviewerpoolmember.Policy (forgotten import ent/runtime?)")
+	}
+	if err := reviewerpoolmember.Policy.EvalQuery(ctx, rpmq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rpmq *ReviewerPoolMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReviewerPoolMember, error) {
+	var (
+		nodes       = []*ReviewerPoolMember{}
+		_spec       = rpmq.querySpec()
+		loadedTypes = [1]bool{
+			rpmq.withPool != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ReviewerPoolMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ReviewerPoolMember{config: rpmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rpmq.modifiers) > 0 {
+		_spec.Modifiers = rpmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rpmq.withPool; query != nil {
+		if err := rpmq.loadPool(ctx, query, nodes, nil,
+			func(n *ReviewerPoolMember, e *ReviewerPool) { n.Edges.Pool = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rpmq *ReviewerPoolMemberQuery) loadPool(ctx context.Context, query *ReviewerPoolQuery, nodes []*ReviewerPoolMember, init func(*ReviewerPoolMember), assign func(*ReviewerPoolMember, *ReviewerPool)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReviewerPoolMember)
+	for i := range nodes {
+		fk := nodes[i].PoolID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(reviewerpool.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pool_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rpmq *ReviewerPoolMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpmq.querySpec()
+	if len(rpmq.modifiers) > 0 {
+		_spec.Modifiers = rpmq.modifiers
+	}
+	_spec.Node.Columns = rpmq.fields
+	if len(rpmq.fields) > 0 {
+		_spec.Unique = rpmq.unique != nil && *rpmq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rpmq.driver, _spec)
+}
+
+func (rpmq *ReviewerPoolMemberQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rpmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rpmq *ReviewerPoolMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpoolmember.Table,
+			Columns: reviewerpoolmember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		},
+		From:   rpmq.sql,
+		Unique: true,
+	}
+	if unique := rpmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rpmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewerpoolmember.FieldID)
+		for i := range fields {
+			if fields[i] != reviewerpoolmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rpmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpmq *ReviewerPoolMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpmq.driver.Dialect())
+	t1 := builder.Table(reviewerpoolmember.Table)
+	columns := rpmq.fields
+	if len(columns) == 0 {
+		columns = reviewerpoolmember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpmq.sql != nil {
+		selector = rpmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpmq.unique != nil && *rpmq.unique {
+		selector.Distinct()
+	}
+	for _, m := range rpmq.modifiers {
+		m(selector)
+	}
+	for _, p := range rpmq.predicates {
+		p(selector)
+	}
+	for _, p := range rpmq.order {
+		p(selector)
+	}
+	if offset := rpmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpmq *ReviewerPoolMemberQuery) ForUpdate(opts ...sql.LockOption) *ReviewerPoolMemberQuery {
+	if rpmq.driver.Dialect() == dialect.Postgres {
+		rpmq.Unique(false)
+	}
+	rpmq.modifiers = append(rpmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpmq *ReviewerPoolMemberQuery) ForShare(opts ...sql.LockOption) *ReviewerPoolMemberQuery {
+	if rpmq.driver.Dialect() == dialect.Postgres {
+		rpmq.Unique(false)
+	}
+	rpmq.modifiers = append(rpmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpmq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rpmq *ReviewerPoolMemberQuery) Modify(modifiers ...func(s *sql.Selector)) *ReviewerPoolMemberSelect {
+	rpmq.modifiers = append(rpmq.modifiers, modifiers...)
+	return rpmq.Select()
+}
+
+// ReviewerPoolMemberGroupBy is the group-by builder for ReviewerPoolMember entities.
+type ReviewerPoolMemberGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpmgb *ReviewerPoolMemberGroupBy) Aggregate(fns ...AggregateFunc) *ReviewerPoolMemberGroupBy {
+	rpmgb.fns = append(rpmgb.fns, fns...)
+	return rpmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rpmgb *ReviewerPoolMemberGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rpmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rpmgb.sql = query
+	return rpmgb.sqlScan(ctx, v)
+}
+
+func (rpmgb *ReviewerPoolMemberGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rpmgb.fields {
+		if !reviewerpoolmember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rpmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rpmgb *ReviewerPoolMemberGroupBy) sqlQuery() *sql.Selector {
+	selector := rpmgb.sql.Select()
+	aggregation := make([]string, 0, len(rpmgb.fns))
+	for _, fn := range rpmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rpmgb.fields)+len(rpmgb.fns))
+		for _, f := range rpmgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rpmgb.fields...)...)
+}
+
+// ReviewerPoolMemberSelect is the builder for selecting fields of ReviewerPoolMember entities.
+type ReviewerPoolMemberSelect struct {
+	*ReviewerPoolMemberQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rpms *ReviewerPoolMemberSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rpms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rpms.sql = rpms.ReviewerPoolMemberQuery.sqlQuery(ctx)
+	return rpms.sqlScan(ctx, v)
+}
+
+func (rpms *ReviewerPoolMemberSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rpms.sql.Query()
+	if err := rpms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rpms *ReviewerPoolMemberSelect) Modify(modifiers ...func(s *sql.Selector)) *ReviewerPoolMemberSelect {
+	rpms.modifiers = append(rpms.modifiers, modifiers...)
+	return rpms
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolMemberUpdate is the builder for updating ReviewerPoolMember entities.
+type ReviewerPoolMemberUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewerPoolMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewerPoolMemberUpdate builder.
+func (rpmu *ReviewerPoolMemberUpdate) Where(ps ...predicate.ReviewerPoolMember) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.Where(ps...)
+	return rpmu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetCreatedAt(u uint32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ResetCreatedAt()
+	rpmu.mutation.SetCreatedAt(u)
+	return rpmu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpmu *ReviewerPoolMemberUpdate) SetNillableCreatedAt(u *uint32) *ReviewerPoolMemberUpdate {
+	if u != nil {
+		rpmu.SetCreatedAt(*u)
+	}
+	return rpmu
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) AddCreatedAt(u int32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.AddCreatedAt(u)
+	return rpmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetUpdatedAt(u uint32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ResetUpdatedAt()
+	rpmu.mutation.SetUpdatedAt(u)
+	return rpmu
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) AddUpdatedAt(u int32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.AddUpdatedAt(u)
+	return rpmu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetDeletedAt(u uint32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ResetDeletedAt()
+	rpmu.mutation.SetDeletedAt(u)
+	return rpmu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpmu *ReviewerPoolMemberUpdate) SetNillableDeletedAt(u *uint32) *ReviewerPoolMemberUpdate {
+	if u != nil {
+		rpmu.SetDeletedAt(*u)
+	}
+	return rpmu
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rpmu *ReviewerPoolMemberUpdate) AddDeletedAt(u int32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.AddDeletedAt(u)
+	return rpmu
+}
+
+// SetPoolID sets the "pool_id" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetPoolID(u uuid.UUID) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.SetPoolID(u)
+	return rpmu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetReviewerID(u uuid.UUID) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.SetReviewerID(u)
+	return rpmu
+}
+
+// SetWeight sets the "weight" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetWeight(u uint32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ResetWeight()
+	rpmu.mutation.SetWeight(u)
+	return rpmu
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (rpmu *ReviewerPoolMemberUpdate) SetNillableWeight(u *uint32) *ReviewerPoolMemberUpdate {
+	if u != nil {
+		rpmu.SetWeight(*u)
+	}
+	return rpmu
+}
+
+// AddWeight adds u to the "weight" field.
+func (rpmu *ReviewerPoolMemberUpdate) AddWeight(u int32) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.AddWeight(u)
+	return rpmu
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (rpmu *ReviewerPoolMemberUpdate) ClearWeight() *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ClearWeight()
+	return rpmu
+}
+
+// SetOnShift sets the "on_shift" field.
+func (rpmu *ReviewerPoolMemberUpdate) SetOnShift(b bool) *ReviewerPoolMemberUpdate {
+	rpmu.mutation.SetOnShift(b)
+	return rpmu
+}
+
+// SetNillableOnShift sets the "on_shift" field if the given value is not nil.
+func (rpmu *ReviewerPoolMemberUpdate) SetNillableOnShift(b *bool) *ReviewerPoolMemberUpdate {
+	if b != nil {
+		rpmu.SetOnShift(*b)
+	}
+	return rpmu
+}
+
+// ClearOnShift clears the value of the "on_shift" field.
+func (rpmu *ReviewerPoolMemberUpdate) ClearOnShift() *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ClearOnShift()
+	return rpmu
+}
+
+// SetPool sets the "pool" edge to the ReviewerPool entity.
+func (rpmu *ReviewerPoolMemberUpdate) SetPool(r *ReviewerPool) *ReviewerPoolMemberUpdate {
+	return rpmu.SetPoolID(r.ID)
+}
+
+// Mutation returns the ReviewerPoolMemberMutation object of the builder.
+func (rpmu *ReviewerPoolMemberUpdate) Mutation() *ReviewerPoolMemberMutation {
+	return rpmu.mutation
+}
+
+// ClearPool clears the "pool" edge to the ReviewerPool entity.
+func (rpmu *ReviewerPoolMemberUpdate) ClearPool() *ReviewerPoolMemberUpdate {
+	rpmu.mutation.ClearPool()
+	return rpmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpmu *ReviewerPoolMemberUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := rpmu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(rpmu.hooks) == 0 {
+		if err = rpmu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rpmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpmu.check(); err != nil {
+				return 0, err
+			}
+			rpmu.mutation = mutation
+			affected, err = rpmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpmu.hooks) - 1; i >= 0; i-- {
+			if rpmu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpmu *ReviewerPoolMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpmu *ReviewerPoolMemberUpdate) Exec(ctx context.Context) error {
+	_, err := rpmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmu *ReviewerPoolMemberUpdate) ExecX(ctx context.Context) {
+	if err := rpmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpmu *ReviewerPoolMemberUpdate) defaults() error {
+	if _, ok := rpmu.mutation.UpdatedAt(); !ok {
+		if reviewerpoolmember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.UpdateDefaultUpdatedAt()
+		rpmu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpmu *ReviewerPoolMemberUpdate) check() error {
+	if _, ok := rpmu.mutation.PoolID(); rpmu.mutation.PoolCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewerPoolMember.pool"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpmu *ReviewerPoolMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerPoolMemberUpdate {
+	rpmu.modifiers = append(rpmu.modifiers, modifiers...)
+	return rpmu
+}
+
+func (rpmu *ReviewerPoolMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpoolmember.Table,
+			Columns: reviewerpoolmember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		},
+	}
+	if ps := rpmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpmu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpmu.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpoolmember.FieldReviewerID,
+		})
+	}
+	if value, ok := rpmu.mutation.Weight(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if value, ok := rpmu.mutation.AddedWeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if rpmu.mutation.WeightCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if value, ok := rpmu.mutation.OnShift(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: reviewerpoolmember.FieldOnShift,
+		})
+	}
+	if rpmu.mutation.OnShiftCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: reviewerpoolmember.FieldOnShift,
+		})
+	}
+	if rpmu.mutation.PoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpool.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpmu.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpool.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rpmu.modifiers
+	if n, err = sqlgraph.UpdateNodes(ctx, rpmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewerpoolmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReviewerPoolMemberUpdateOne is the builder for updating a single ReviewerPoolMember entity.
+type ReviewerPoolMemberUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewerPoolMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetCreatedAt(u uint32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ResetCreatedAt()
+	rpmuo.mutation.SetCreatedAt(u)
+	return rpmuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetNillableCreatedAt(u *uint32) *ReviewerPoolMemberUpdateOne {
+	if u != nil {
+		rpmuo.SetCreatedAt(*u)
+	}
+	return rpmuo
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) AddCreatedAt(u int32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.AddCreatedAt(u)
+	return rpmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetUpdatedAt(u uint32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ResetUpdatedAt()
+	rpmuo.mutation.SetUpdatedAt(u)
+	return rpmuo
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) AddUpdatedAt(u int32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.AddUpdatedAt(u)
+	return rpmuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetDeletedAt(u uint32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ResetDeletedAt()
+	rpmuo.mutation.SetDeletedAt(u)
+	return rpmuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetNillableDeletedAt(u *uint32) *ReviewerPoolMemberUpdateOne {
+	if u != nil {
+		rpmuo.SetDeletedAt(*u)
+	}
+	return rpmuo
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) AddDeletedAt(u int32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.AddDeletedAt(u)
+	return rpmuo
+}
+
+// SetPoolID sets the "pool_id" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetPoolID(u uuid.UUID) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.SetPoolID(u)
+	return rpmuo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetReviewerID(u uuid.UUID) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.SetReviewerID(u)
+	return rpmuo
+}
+
+// SetWeight sets the "weight" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetWeight(u uint32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ResetWeight()
+	rpmuo.mutation.SetWeight(u)
+	return rpmuo
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetNillableWeight(u *uint32) *ReviewerPoolMemberUpdateOne {
+	if u != nil {
+		rpmuo.SetWeight(*u)
+	}
+	return rpmuo
+}
+
+// AddWeight adds u to the "weight" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) AddWeight(u int32) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.AddWeight(u)
+	return rpmuo
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) ClearWeight() *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ClearWeight()
+	return rpmuo
+}
+
+// SetOnShift sets the "on_shift" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetOnShift(b bool) *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.SetOnShift(b)
+	return rpmuo
+}
+
+// SetNillableOnShift sets the "on_shift" field if the given value is not nil.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetNillableOnShift(b *bool) *ReviewerPoolMemberUpdateOne {
+	if b != nil {
+		rpmuo.SetOnShift(*b)
+	}
+	return rpmuo
+}
+
+// ClearOnShift clears the value of the "on_shift" field.
+func (rpmuo *ReviewerPoolMemberUpdateOne) ClearOnShift() *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ClearOnShift()
+	return rpmuo
+}
+
+// SetPool sets the "pool" edge to the ReviewerPool entity.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SetPool(r *ReviewerPool) *ReviewerPoolMemberUpdateOne {
+	return rpmuo.SetPoolID(r.ID)
+}
+
+// Mutation returns the ReviewerPoolMemberMutation object of the builder.
+func (rpmuo *ReviewerPoolMemberUpdateOne) Mutation() *ReviewerPoolMemberMutation {
+	return rpmuo.mutation
+}
+
+// ClearPool clears the "pool" edge to the ReviewerPool entity.
+func (rpmuo *ReviewerPoolMemberUpdateOne) ClearPool() *ReviewerPoolMemberUpdateOne {
+	rpmuo.mutation.ClearPool()
+	return rpmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpmuo *ReviewerPoolMemberUpdateOne) Select(field string, fields ...string) *ReviewerPoolMemberUpdateOne {
+	rpmuo.fields = append([]string{field}, fields...)
+	return rpmuo
+}
+
+// Save executes the query and returns the updated ReviewerPoolMember entity.
+func (rpmuo *ReviewerPoolMemberUpdateOne) Save(ctx context.Context) (*ReviewerPoolMember, error) {
+	var (
+		err  error
+		node *ReviewerPoolMember
+	)
+	if err := rpmuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rpmuo.hooks) == 0 {
+		if err = rpmuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewerPoolMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpmuo.check(); err != nil {
+				return nil, err
+			}
+			rpmuo.mutation = mutation
+			node, err = rpmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpmuo.hooks) - 1; i >= 0; i-- {
+			if rpmuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpmuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpmuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewerPoolMember)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewerPoolMemberMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpmuo *ReviewerPoolMemberUpdateOne) SaveX(ctx context.Context) *ReviewerPoolMember {
+	node, err := rpmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpmuo *ReviewerPoolMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpmuo *ReviewerPoolMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := rpmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpmuo *ReviewerPoolMemberUpdateOne) defaults() error {
+	if _, ok := rpmuo.mutation.UpdatedAt(); !ok {
+		if reviewerpoolmember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewerpoolmember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewerpoolmember.UpdateDefaultUpdatedAt()
+		rpmuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpmuo *ReviewerPoolMemberUpdateOne) check() error {
+	if _, ok := rpmuo.mutation.PoolID(); rpmuo.mutation.PoolCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewerPoolMember.pool"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpmuo *ReviewerPoolMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerPoolMemberUpdateOne {
+	rpmuo.modifiers = append(rpmuo.modifiers, modifiers...)
+	return rpmuo
+}
+
+func (rpmuo *ReviewerPoolMemberUpdateOne) sqlSave(ctx context.Context) (_node *ReviewerPoolMember, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewerpoolmember.Table,
+			Columns: reviewerpoolmember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewerpoolmember.FieldID,
+			},
+		},
+	}
+	id, ok := rpmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewerPoolMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewerpoolmember.FieldID)
+		for _, f := range fields {
+			if !reviewerpoolmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewerpoolmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpmuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldCreatedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldUpdatedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldDeletedAt,
+		})
+	}
+	if value, ok := rpmuo.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewerpoolmember.FieldReviewerID,
+		})
+	}
+	if value, ok := rpmuo.mutation.Weight(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if value, ok := rpmuo.mutation.AddedWeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if rpmuo.mutation.WeightCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Column: reviewerpoolmember.FieldWeight,
+		})
+	}
+	if value, ok := rpmuo.mutation.OnShift(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: reviewerpoolmember.FieldOnShift,
+		})
+	}
+	if rpmuo.mutation.OnShiftCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: reviewerpoolmember.FieldOnShift,
+		})
+	}
+	if rpmuo.mutation.PoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpool.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpmuo.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewerpoolmember.PoolTable,
+			Columns: []string{reviewerpoolmember.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: reviewerpool.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = rpmuo.modifiers
+	_node = &ReviewerPoolMember{config: rpmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewerpoolmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
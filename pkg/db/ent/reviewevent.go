@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/google/uuid"
+)
+
+// ReviewEvent is the model entity for the ReviewEvent schema.
+type ReviewEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt uint32 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt uint32 `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt uint32 `json:"deleted_at,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID uuid.UUID `json:"app_id,omitempty"`
+	// ReviewID holds the value of the "review_id" field.
+	ReviewID uuid.UUID `json:"review_id,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID uuid.UUID `json:"actor_id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// OldValue holds the value of the "old_value" field.
+	OldValue string `json:"old_value,omitempty"`
+	// NewValue holds the value of the "new_value" field.
+	NewValue string `json:"new_value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewEventQuery when eager-loading is set.
+	Edges ReviewEventEdges `json:"edges"`
+}
+
+// ReviewEventEdges holds the relations/edges for other nodes in the graph.
+type ReviewEventEdges struct {
+	// Review holds the value of the review edge.
+	Review *Review `json:"review,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReviewOrErr returns the Review value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewEventEdges) ReviewOrErr() (*Review, error) {
+	if e.loadedTypes[0] {
+		if e.Review == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: review.Label}
+		}
+		return e.Review, nil
+	}
+	return nil, &NotLoadedError{edge: "review"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReviewEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewevent.FieldCreatedAt, reviewevent.FieldUpdatedAt, reviewevent.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case reviewevent.FieldEvent, reviewevent.FieldOldValue, reviewevent.FieldNewValue:
+			values[i] = new(sql.NullString)
+		case reviewevent.FieldID, reviewevent.FieldAppID, reviewevent.FieldReviewID, reviewevent.FieldActorID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ReviewEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReviewEvent fields.
+func (re *ReviewEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				re.ID = *value
+			}
+		case reviewevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				re.CreatedAt = uint32(value.Int64)
+			}
+		case reviewevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				re.UpdatedAt = uint32(value.Int64)
+			}
+		case reviewevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				re.DeletedAt = uint32(value.Int64)
+			}
+		case reviewevent.FieldAppID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value != nil {
+				re.AppID = *value
+			}
+		case reviewevent.FieldReviewID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field review_id", values[i])
+			} else if value != nil {
+				re.ReviewID = *value
+			}
+		case reviewevent.FieldActorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value != nil {
+				re.ActorID = *value
+			}
+		case reviewevent.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				re.Event = value.String
+			}
+		case reviewevent.FieldOldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value.Valid {
+				re.OldValue = value.String
+			}
+		case reviewevent.FieldNewValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value.Valid {
+				re.NewValue = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryReview queries the "review" edge of the ReviewEvent entity.
+func (re *ReviewEvent) QueryReview() *ReviewQuery {
+	return (&ReviewEventClient{config: re.config}).QueryReview(re)
+}
+
+// Update returns a builder for updating this ReviewEvent.
+// Note that you need to call ReviewEvent.Unwrap() before calling this method if this ReviewEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (re *ReviewEvent) Update() *ReviewEventUpdateOne {
+	return (&ReviewEventClient{config: re.config}).UpdateOne(re)
+}
+
+// Unwrap unwraps the ReviewEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (re *ReviewEvent) Unwrap() *ReviewEvent {
+	_tx, ok := re.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReviewEvent is not a transactional entity")
+	}
+	re.config.driver = _tx.drv
+	return re
+}
+
+// String implements the fmt.Stringer.
+func (re *ReviewEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReviewEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", re.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", re.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", re.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", re.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(fmt.Sprintf("%v", re.AppID))
+	builder.WriteString(", ")
+	builder.WriteString("review_id=")
+	builder.WriteString(fmt.Sprintf("%v", re.ReviewID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", re.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(re.Event)
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(re.OldValue)
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(re.NewValue)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReviewEvents is a parsable slice of ReviewEvent.
+type ReviewEvents []*ReviewEvent
+
+func (re ReviewEvents) config(cfg config) {
+	for _i := range re {
+		re[_i].config = cfg
+	}
+}
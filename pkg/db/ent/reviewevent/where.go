@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// ReviewID applies equality check predicate on the "review_id" field. It's identical to ReviewIDEQ.
+func ReviewID(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewID), v))
+	})
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActorID), v))
+	})
+}
+
+// Event applies equality check predicate on the "event" field. It's identical to EventEQ.
+func Event(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEvent), v))
+	})
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldValue), v))
+	})
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewValue), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...uint32) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v uint32) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDIsNil applies the IsNil predicate on the "app_id" field.
+func AppIDIsNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppID)))
+	})
+}
+
+// AppIDNotNil applies the NotNil predicate on the "app_id" field.
+func AppIDNotNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppID)))
+	})
+}
+
+// ReviewIDEQ applies the EQ predicate on the "review_id" field.
+func ReviewIDEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewID), v))
+	})
+}
+
+// ReviewIDNEQ applies the NEQ predicate on the "review_id" field.
+func ReviewIDNEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewID), v))
+	})
+}
+
+// ReviewIDIn applies the In predicate on the "review_id" field.
+func ReviewIDIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldReviewID), v...))
+	})
+}
+
+// ReviewIDNotIn applies the NotIn predicate on the "review_id" field.
+func ReviewIDNotIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldReviewID), v...))
+	})
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldActorID), v...))
+	})
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldActorID), v...))
+	})
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActorID), v))
+	})
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActorID)))
+	})
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActorID)))
+	})
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEvent), v))
+	})
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEvent), v))
+	})
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldEvent), v...))
+	})
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldEvent), v...))
+	})
+}
+
+// EventGT applies the GT predicate on the "event" field.
+func EventGT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEvent), v))
+	})
+}
+
+// EventGTE applies the GTE predicate on the "event" field.
+func EventGTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEvent), v))
+	})
+}
+
+// EventLT applies the LT predicate on the "event" field.
+func EventLT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEvent), v))
+	})
+}
+
+// EventLTE applies the LTE predicate on the "event" field.
+func EventLTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEvent), v))
+	})
+}
+
+// EventContains applies the Contains predicate on the "event" field.
+func EventContains(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEvent), v))
+	})
+}
+
+// EventHasPrefix applies the HasPrefix predicate on the "event" field.
+func EventHasPrefix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEvent), v))
+	})
+}
+
+// EventHasSuffix applies the HasSuffix predicate on the "event" field.
+func EventHasSuffix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEvent), v))
+	})
+}
+
+// EventIsNil applies the IsNil predicate on the "event" field.
+func EventIsNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEvent)))
+	})
+}
+
+// EventNotNil applies the NotNil predicate on the "event" field.
+func EventNotNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEvent)))
+	})
+}
+
+// EventEqualFold applies the EqualFold predicate on the "event" field.
+func EventEqualFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEvent), v))
+	})
+}
+
+// EventContainsFold applies the ContainsFold predicate on the "event" field.
+func EventContainsFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEvent), v))
+	})
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldOldValue), v...))
+	})
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldOldValue), v...))
+	})
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOldValue)))
+	})
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOldValue)))
+	})
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOldValue), v))
+	})
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOldValue), v))
+	})
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNewValue), v...))
+	})
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.ReviewEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNewValue), v...))
+	})
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNewValue)))
+	})
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNewValue)))
+	})
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNewValue), v))
+	})
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNewValue), v))
+	})
+}
+
+// HasReview applies the HasEdge predicate on the "review" edge.
+func HasReview() predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReviewTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReviewTable, ReviewColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewWith applies the HasEdge predicate on the "review" edge with a given conditions (other predicates).
+func HasReviewWith(preds ...predicate.Review) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReviewInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReviewTable, ReviewColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReviewEvent) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReviewEvent) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReviewEvent) predicate.ReviewEvent {
+	return predicate.ReviewEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
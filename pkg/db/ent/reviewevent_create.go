@@ -0,0 +1,1288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/google/uuid"
+)
+
+// ReviewEventCreate is the builder for creating a ReviewEvent entity.
+type ReviewEventCreate struct {
+	config
+	mutation *ReviewEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rec *ReviewEventCreate) SetCreatedAt(u uint32) *ReviewEventCreate {
+	rec.mutation.SetCreatedAt(u)
+	return rec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableCreatedAt(u *uint32) *ReviewEventCreate {
+	if u != nil {
+		rec.SetCreatedAt(*u)
+	}
+	return rec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rec *ReviewEventCreate) SetUpdatedAt(u uint32) *ReviewEventCreate {
+	rec.mutation.SetUpdatedAt(u)
+	return rec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableUpdatedAt(u *uint32) *ReviewEventCreate {
+	if u != nil {
+		rec.SetUpdatedAt(*u)
+	}
+	return rec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rec *ReviewEventCreate) SetDeletedAt(u uint32) *ReviewEventCreate {
+	rec.mutation.SetDeletedAt(u)
+	return rec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableDeletedAt(u *uint32) *ReviewEventCreate {
+	if u != nil {
+		rec.SetDeletedAt(*u)
+	}
+	return rec
+}
+
+// SetAppID sets the "app_id" field.
+func (rec *ReviewEventCreate) SetAppID(u uuid.UUID) *ReviewEventCreate {
+	rec.mutation.SetAppID(u)
+	return rec
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableAppID(u *uuid.UUID) *ReviewEventCreate {
+	if u != nil {
+		rec.SetAppID(*u)
+	}
+	return rec
+}
+
+// SetReviewID sets the "review_id" field.
+func (rec *ReviewEventCreate) SetReviewID(u uuid.UUID) *ReviewEventCreate {
+	rec.mutation.SetReviewID(u)
+	return rec
+}
+
+// SetActorID sets the "actor_id" field.
+func (rec *ReviewEventCreate) SetActorID(u uuid.UUID) *ReviewEventCreate {
+	rec.mutation.SetActorID(u)
+	return rec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableActorID(u *uuid.UUID) *ReviewEventCreate {
+	if u != nil {
+		rec.SetActorID(*u)
+	}
+	return rec
+}
+
+// SetEvent sets the "event" field.
+func (rec *ReviewEventCreate) SetEvent(s string) *ReviewEventCreate {
+	rec.mutation.SetEvent(s)
+	return rec
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableEvent(s *string) *ReviewEventCreate {
+	if s != nil {
+		rec.SetEvent(*s)
+	}
+	return rec
+}
+
+// SetOldValue sets the "old_value" field.
+func (rec *ReviewEventCreate) SetOldValue(s string) *ReviewEventCreate {
+	rec.mutation.SetOldValue(s)
+	return rec
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableOldValue(s *string) *ReviewEventCreate {
+	if s != nil {
+		rec.SetOldValue(*s)
+	}
+	return rec
+}
+
+// SetNewValue sets the "new_value" field.
+func (rec *ReviewEventCreate) SetNewValue(s string) *ReviewEventCreate {
+	rec.mutation.SetNewValue(s)
+	return rec
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableNewValue(s *string) *ReviewEventCreate {
+	if s != nil {
+		rec.SetNewValue(*s)
+	}
+	return rec
+}
+
+// SetID sets the "id" field.
+func (rec *ReviewEventCreate) SetID(u uuid.UUID) *ReviewEventCreate {
+	rec.mutation.SetID(u)
+	return rec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rec *ReviewEventCreate) SetNillableID(u *uuid.UUID) *ReviewEventCreate {
+	if u != nil {
+		rec.SetID(*u)
+	}
+	return rec
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (rec *ReviewEventCreate) SetReview(r *Review) *ReviewEventCreate {
+	return rec.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewEventMutation object of the builder.
+func (rec *ReviewEventCreate) Mutation() *ReviewEventMutation {
+	return rec.mutation
+}
+
+// Save creates the ReviewEvent in the database.
+func (rec *ReviewEventCreate) Save(ctx context.Context) (*ReviewEvent, error) {
+	var (
+		err  error
+		node *ReviewEvent
+	)
+	if err := rec.defaults(); err != nil {
+		return nil, err
+	}
+	if len(rec.hooks) == 0 {
+		if err = rec.check(); err != nil {
+			return nil, err
+		}
+		node, err = rec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rec.check(); err != nil {
+				return nil, err
+			}
+			rec.mutation = mutation
+			if node, err = rec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rec.hooks) - 1; i >= 0; i-- {
+			if rec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rec *ReviewEventCreate) SaveX(ctx context.Context) *ReviewEvent {
+	v, err := rec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rec *ReviewEventCreate) Exec(ctx context.Context) error {
+	_, err := rec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rec *ReviewEventCreate) ExecX(ctx context.Context) {
+	if err := rec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rec *ReviewEventCreate) defaults() error {
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		if reviewevent.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultCreatedAt()
+		rec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rec.mutation.UpdatedAt(); !ok {
+		if reviewevent.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultUpdatedAt()
+		rec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rec.mutation.DeletedAt(); !ok {
+		if reviewevent.DefaultDeletedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultDeletedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultDeletedAt()
+		rec.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rec.mutation.AppID(); !ok {
+		if reviewevent.DefaultAppID == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultAppID (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultAppID()
+		rec.mutation.SetAppID(v)
+	}
+	if _, ok := rec.mutation.ActorID(); !ok {
+		if reviewevent.DefaultActorID == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultActorID (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultActorID()
+		rec.mutation.SetActorID(v)
+	}
+	if _, ok := rec.mutation.Event(); !ok {
+		v := reviewevent.DefaultEvent
+		rec.mutation.SetEvent(v)
+	}
+	if _, ok := rec.mutation.OldValue(); !ok {
+		v := reviewevent.DefaultOldValue
+		rec.mutation.SetOldValue(v)
+	}
+	if _, ok := rec.mutation.NewValue(); !ok {
+		v := reviewevent.DefaultNewValue
+		rec.mutation.SetNewValue(v)
+	}
+	if _, ok := rec.mutation.ID(); !ok {
+		if reviewevent.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.DefaultID()
+		rec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rec *ReviewEventCreate) check() error {
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReviewEvent.created_at"`)}
+	}
+	if _, ok := rec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReviewEvent.updated_at"`)}
+	}
+	if _, ok := rec.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "ReviewEvent.deleted_at"`)}
+	}
+	if _, ok := rec.mutation.ReviewID(); !ok {
+		return &ValidationError{Name: "review_id", err: errors.New(`ent: missing required field "ReviewEvent.review_id"`)}
+	}
+	if _, ok := rec.mutation.ReviewID(); !ok {
+		return &ValidationError{Name: "review", err: errors.New(`ent: missing required edge "ReviewEvent.review"`)}
+	}
+	return nil
+}
+
+func (rec *ReviewEventCreate) sqlSave(ctx context.Context) (*ReviewEvent, error) {
+	_node, _spec := rec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rec *ReviewEventCreate) createSpec() (*ReviewEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewEvent{config: rec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reviewevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = rec.conflict
+	if id, ok := rec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rec.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := rec.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := rec.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := rec.mutation.ActorID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldActorID,
+		})
+		_node.ActorID = value
+	}
+	if value, ok := rec.mutation.Event(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldEvent,
+		})
+		_node.Event = value
+	}
+	if value, ok := rec.mutation.OldValue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldOldValue,
+		})
+		_node.OldValue = value
+	}
+	if value, ok := rec.mutation.NewValue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldNewValue,
+		})
+		_node.NewValue = value
+	}
+	if nodes := rec.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewEvent.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rec *ReviewEventCreate) OnConflict(opts ...sql.ConflictOption) *ReviewEventUpsertOne {
+	rec.conflict = opts
+	return &ReviewEventUpsertOne{
+		create: rec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rec *ReviewEventCreate) OnConflictColumns(columns ...string) *ReviewEventUpsertOne {
+	rec.conflict = append(rec.conflict, sql.ConflictColumns(columns...))
+	return &ReviewEventUpsertOne{
+		create: rec,
+	}
+}
+
+type (
+	// ReviewEventUpsertOne is the builder for "upsert"-ing
+	//  one ReviewEvent node.
+	ReviewEventUpsertOne struct {
+		create *ReviewEventCreate
+	}
+
+	// ReviewEventUpsert is the "OnConflict" setter.
+	ReviewEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewEventUpsert) SetCreatedAt(v uint32) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateCreatedAt() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewEventUpsert) AddCreatedAt(v uint32) *ReviewEventUpsert {
+	u.Add(reviewevent.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewEventUpsert) SetUpdatedAt(v uint32) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateUpdatedAt() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewEventUpsert) AddUpdatedAt(v uint32) *ReviewEventUpsert {
+	u.Add(reviewevent.FieldUpdatedAt, v)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewEventUpsert) SetDeletedAt(v uint32) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateDeletedAt() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewEventUpsert) AddDeletedAt(v uint32) *ReviewEventUpsert {
+	u.Add(reviewevent.FieldDeletedAt, v)
+	return u
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewEventUpsert) SetAppID(v uuid.UUID) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldAppID, v)
+	return u
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateAppID() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldAppID)
+	return u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewEventUpsert) ClearAppID() *ReviewEventUpsert {
+	u.SetNull(reviewevent.FieldAppID)
+	return u
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewEventUpsert) SetReviewID(v uuid.UUID) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldReviewID, v)
+	return u
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateReviewID() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldReviewID)
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *ReviewEventUpsert) SetActorID(v uuid.UUID) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldActorID, v)
+	return u
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateActorID() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldActorID)
+	return u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *ReviewEventUpsert) ClearActorID() *ReviewEventUpsert {
+	u.SetNull(reviewevent.FieldActorID)
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *ReviewEventUpsert) SetEvent(v string) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldEvent, v)
+	return u
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateEvent() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldEvent)
+	return u
+}
+
+// ClearEvent clears the value of the "event" field.
+func (u *ReviewEventUpsert) ClearEvent() *ReviewEventUpsert {
+	u.SetNull(reviewevent.FieldEvent)
+	return u
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *ReviewEventUpsert) SetOldValue(v string) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldOldValue, v)
+	return u
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateOldValue() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldOldValue)
+	return u
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *ReviewEventUpsert) ClearOldValue() *ReviewEventUpsert {
+	u.SetNull(reviewevent.FieldOldValue)
+	return u
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *ReviewEventUpsert) SetNewValue(v string) *ReviewEventUpsert {
+	u.Set(reviewevent.FieldNewValue, v)
+	return u
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *ReviewEventUpsert) UpdateNewValue() *ReviewEventUpsert {
+	u.SetExcluded(reviewevent.FieldNewValue)
+	return u
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *ReviewEventUpsert) ClearNewValue() *ReviewEventUpsert {
+	u.SetNull(reviewevent.FieldNewValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewEventUpsertOne) UpdateNewValues() *ReviewEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reviewevent.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewEventUpsertOne) Ignore() *ReviewEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewEventUpsertOne) DoNothing() *ReviewEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewEventCreate.OnConflict
+// documentation for more info.
+func (u *ReviewEventUpsertOne) Update(set func(*ReviewEventUpsert)) *ReviewEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewEventUpsertOne) SetCreatedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewEventUpsertOne) AddCreatedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateCreatedAt() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewEventUpsertOne) SetUpdatedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewEventUpsertOne) AddUpdatedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateUpdatedAt() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewEventUpsertOne) SetDeletedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewEventUpsertOne) AddDeletedAt(v uint32) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateDeletedAt() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewEventUpsertOne) SetAppID(v uuid.UUID) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateAppID() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewEventUpsertOne) ClearAppID() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewEventUpsertOne) SetReviewID(v uuid.UUID) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetReviewID(v)
+	})
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateReviewID() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateReviewID()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *ReviewEventUpsertOne) SetActorID(v uuid.UUID) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateActorID() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *ReviewEventUpsertOne) ClearActorID() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *ReviewEventUpsertOne) SetEvent(v string) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateEvent() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// ClearEvent clears the value of the "event" field.
+func (u *ReviewEventUpsertOne) ClearEvent() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearEvent()
+	})
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *ReviewEventUpsertOne) SetOldValue(v string) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetOldValue(v)
+	})
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateOldValue() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateOldValue()
+	})
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *ReviewEventUpsertOne) ClearOldValue() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearOldValue()
+	})
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *ReviewEventUpsertOne) SetNewValue(v string) *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetNewValue(v)
+	})
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *ReviewEventUpsertOne) UpdateNewValue() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateNewValue()
+	})
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *ReviewEventUpsertOne) ClearNewValue() *ReviewEventUpsertOne {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearNewValue()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewEventUpsertOne.ID is not supported by MySQL driver. Use ReviewEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewEventCreateBulk is the builder for creating many ReviewEvent entities in bulk.
+type ReviewEventCreateBulk struct {
+	config
+	builders []*ReviewEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReviewEvent entities in the database.
+func (recb *ReviewEventCreateBulk) Save(ctx context.Context) ([]*ReviewEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(recb.builders))
+	nodes := make([]*ReviewEvent, len(recb.builders))
+	mutators := make([]Mutator, len(recb.builders))
+	for i := range recb.builders {
+		func(i int, root context.Context) {
+			builder := recb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, recb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = recb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, recb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, recb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recb *ReviewEventCreateBulk) SaveX(ctx context.Context) []*ReviewEvent {
+	v, err := recb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (recb *ReviewEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := recb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recb *ReviewEventCreateBulk) ExecX(ctx context.Context) {
+	if err := recb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (recb *ReviewEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewEventUpsertBulk {
+	recb.conflict = opts
+	return &ReviewEventUpsertBulk{
+		create: recb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (recb *ReviewEventCreateBulk) OnConflictColumns(columns ...string) *ReviewEventUpsertBulk {
+	recb.conflict = append(recb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewEventUpsertBulk{
+		create: recb,
+	}
+}
+
+// ReviewEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReviewEvent nodes.
+type ReviewEventUpsertBulk struct {
+	create *ReviewEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewEventUpsertBulk) UpdateNewValues() *ReviewEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reviewevent.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewEventUpsertBulk) Ignore() *ReviewEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewEventUpsertBulk) DoNothing() *ReviewEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewEventUpsertBulk) Update(set func(*ReviewEventUpsert)) *ReviewEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReviewEventUpsertBulk) SetCreatedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *ReviewEventUpsertBulk) AddCreatedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateCreatedAt() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewEventUpsertBulk) SetUpdatedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ReviewEventUpsertBulk) AddUpdatedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateUpdatedAt() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewEventUpsertBulk) SetDeletedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *ReviewEventUpsertBulk) AddDeletedAt(v uint32) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateDeletedAt() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetAppID sets the "app_id" field.
+func (u *ReviewEventUpsertBulk) SetAppID(v uuid.UUID) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetAppID(v)
+	})
+}
+
+// UpdateAppID sets the "app_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateAppID() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateAppID()
+	})
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (u *ReviewEventUpsertBulk) ClearAppID() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearAppID()
+	})
+}
+
+// SetReviewID sets the "review_id" field.
+func (u *ReviewEventUpsertBulk) SetReviewID(v uuid.UUID) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetReviewID(v)
+	})
+}
+
+// UpdateReviewID sets the "review_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateReviewID() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateReviewID()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *ReviewEventUpsertBulk) SetActorID(v uuid.UUID) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateActorID() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *ReviewEventUpsertBulk) ClearActorID() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *ReviewEventUpsertBulk) SetEvent(v string) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateEvent() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// ClearEvent clears the value of the "event" field.
+func (u *ReviewEventUpsertBulk) ClearEvent() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearEvent()
+	})
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *ReviewEventUpsertBulk) SetOldValue(v string) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetOldValue(v)
+	})
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateOldValue() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateOldValue()
+	})
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *ReviewEventUpsertBulk) ClearOldValue() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearOldValue()
+	})
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *ReviewEventUpsertBulk) SetNewValue(v string) *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.SetNewValue(v)
+	})
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *ReviewEventUpsertBulk) UpdateNewValue() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.UpdateNewValue()
+	})
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *ReviewEventUpsertBulk) ClearNewValue() *ReviewEventUpsertBulk {
+	return u.Update(func(s *ReviewEventUpsert) {
+		s.ClearNewValue()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewEventUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+)
+
+// ReviewEventDelete is the builder for deleting a ReviewEvent entity.
+type ReviewEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReviewEventMutation
+}
+
+// Where appends a list predicates to the ReviewEventDelete builder.
+func (red *ReviewEventDelete) Where(ps ...predicate.ReviewEvent) *ReviewEventDelete {
+	red.mutation.Where(ps...)
+	return red
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (red *ReviewEventDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(red.hooks) == 0 {
+		affected, err = red.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			red.mutation = mutation
+			affected, err = red.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(red.hooks) - 1; i >= 0; i-- {
+			if red.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = red.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, red.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (red *ReviewEventDelete) ExecX(ctx context.Context) int {
+	n, err := red.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (red *ReviewEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: reviewevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		},
+	}
+	if ps := red.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, red.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ReviewEventDeleteOne is the builder for deleting a single ReviewEvent entity.
+type ReviewEventDeleteOne struct {
+	red *ReviewEventDelete
+}
+
+// Exec executes the deletion query.
+func (redo *ReviewEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := redo.red.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reviewevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (redo *ReviewEventDeleteOne) ExecX(ctx context.Context) {
+	redo.red.ExecX(ctx)
+}
@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/google/uuid"
+)
+
+// ReviewEventQuery is the builder for querying ReviewEvent entities.
+type ReviewEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ReviewEvent
+	withReview *ReviewQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReviewEventQuery builder.
+func (req *ReviewEventQuery) Where(ps ...predicate.ReviewEvent) *ReviewEventQuery {
+	req.predicates = append(req.predicates, ps...)
+	return req
+}
+
+// Limit adds a limit step to the query.
+func (req *ReviewEventQuery) Limit(limit int) *ReviewEventQuery {
+	req.limit = &limit
+	return req
+}
+
+// Offset adds an offset step to the query.
+func (req *ReviewEventQuery) Offset(offset int) *ReviewEventQuery {
+	req.offset = &offset
+	return req
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (req *ReviewEventQuery) Unique(unique bool) *ReviewEventQuery {
+	req.unique = &unique
+	return req
+}
+
+// Order adds an order step to the query.
+func (req *ReviewEventQuery) Order(o ...OrderFunc) *ReviewEventQuery {
+	req.order = append(req.order, o...)
+	return req
+}
+
+// QueryReview chains the current query on the "review" edge.
+func (req *ReviewEventQuery) QueryReview() *ReviewQuery {
+	query := &ReviewQuery{config: req.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := req.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := req.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewevent.Table, reviewevent.FieldID, selector),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewevent.ReviewTable, reviewevent.ReviewColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(req.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReviewEvent entity from the query.
+// Returns a *NotFoundError when no ReviewEvent was found.
+func (req *ReviewEventQuery) First(ctx context.Context) (*ReviewEvent, error) {
+	nodes, err := req.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reviewevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (req *ReviewEventQuery) FirstX(ctx context.Context) *ReviewEvent {
+	node, err := req.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReviewEvent ID from the query.
+// Returns a *NotFoundError when no ReviewEvent ID was found.
+func (req *ReviewEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = req.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reviewevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (req *ReviewEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := req.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReviewEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReviewEvent entity is found.
+// Returns a *NotFoundError when no ReviewEvent entities are found.
+func (req *ReviewEventQuery) Only(ctx context.Context) (*ReviewEvent, error) {
+	nodes, err := req.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reviewevent.Label}
+	default:
+		return nil, &NotSingularError{reviewevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (req *ReviewEventQuery) OnlyX(ctx context.Context) *ReviewEvent {
+	node, err := req.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReviewEvent ID in the query.
+// Returns a *NotSingularError when more than one ReviewEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (req *ReviewEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = req.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reviewevent.Label}
+	default:
+		err = &NotSingularError{reviewevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (req *ReviewEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := req.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReviewEvents.
+func (req *ReviewEventQuery) All(ctx context.Context) ([]*ReviewEvent, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return req.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (req *ReviewEventQuery) AllX(ctx context.Context) []*ReviewEvent {
+	nodes, err := req.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReviewEvent IDs.
+func (req *ReviewEventQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := req.Select(reviewevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (req *ReviewEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := req.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (req *ReviewEventQuery) Count(ctx context.Context) (int, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return req.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (req *ReviewEventQuery) CountX(ctx context.Context) int {
+	count, err := req.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (req *ReviewEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return req.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (req *ReviewEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := req.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReviewEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (req *ReviewEventQuery) Clone() *ReviewEventQuery {
+	if req == nil {
+		return nil
+	}
+	return &ReviewEventQuery{
+		config:     req.config,
+		limit:      req.limit,
+		offset:     req.offset,
+		order:      append([]OrderFunc{}, req.order...),
+		predicates: append([]predicate.ReviewEvent{}, req.predicates...),
+		withReview: req.withReview.Clone(),
+		// clone intermediate query.
+		sql:    req.sql.Clone(),
+		path:   req.path,
+		unique: req.unique,
+	}
+}
+
+// WithReview tells the query-builder to eager-load the nodes that are connected to
+// the "review" edge. The optional arguments are used to configure the query builder of the edge.
+func (req *ReviewEventQuery) WithReview(opts ...func(*ReviewQuery)) *ReviewEventQuery {
+	query := &ReviewQuery{config: req.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	req.withReview = query
+	return req
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReviewEvent.Query().
+//		GroupBy(reviewevent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (req *ReviewEventQuery) GroupBy(field string, fields ...string) *ReviewEventGroupBy {
+	grbuild := &ReviewEventGroupBy{config: req.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := req.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return req.sqlQuery(ctx), nil
+	}
+	grbuild.label = reviewevent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt uint32 `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReviewEvent.Query().
+//		Select(reviewevent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (req *ReviewEventQuery) Select(fields ...string) *ReviewEventSelect {
+	req.fields = append(req.fields, fields...)
+	selbuild := &ReviewEventSelect{ReviewEventQuery: req}
+	selbuild.label = reviewevent.Label
+	selbuild.flds, selbuild.scan = &req.fields, selbuild.Scan
+	return selbuild
+}
+
+func (req *ReviewEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range req.fields {
+		if !reviewevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if req.path != nil {
+		prev, err := req.path(ctx)
+		if err != nil {
+			return err
+		}
+		req.sql = prev
+	}
+	if reviewevent.Policy == nil {
+		return errors.New("ent: uninitialized reviewevent.Policy (forgotten import ent/runtime?)")
+	}
+	if err := reviewevent.Policy.EvalQuery(ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *ReviewEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReviewEvent, error) {
+	var (
+		nodes       = []*ReviewEvent{}
+		_spec       = req.querySpec()
+		loadedTypes = [1]bool{
+			req.withReview != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ReviewEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ReviewEvent{config: req.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(req.modifiers) > 0 {
+		_spec.Modifiers = req.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, req.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := req.withReview; query != nil {
+		if err := req.loadReview(ctx, query, nodes, nil,
+			func(n *ReviewEvent, e *Review) { n.Edges.Review = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (req *ReviewEventQuery) loadReview(ctx context.Context, query *ReviewQuery, nodes []*ReviewEvent, init func(*ReviewEvent), assign func(*ReviewEvent, *Review)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReviewEvent)
+	for i := range nodes {
+		fk := nodes[i].ReviewID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(review.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "review_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (req *ReviewEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := req.querySpec()
+	if len(req.modifiers) > 0 {
+		_spec.Modifiers = req.modifiers
+	}
+	_spec.Node.Columns = req.fields
+	if len(req.fields) > 0 {
+		_spec.Unique = req.unique != nil && *req.unique
+	}
+	return sqlgraph.CountNodes(ctx, req.driver, _spec)
+}
+
+func (req *ReviewEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := req.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (req *ReviewEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewevent.Table,
+			Columns: reviewevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		},
+		From:   req.sql,
+		Unique: true,
+	}
+	if unique := req.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := req.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewevent.FieldID)
+		for i := range fields {
+			if fields[i] != reviewevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := req.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := req.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := req.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := req.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (req *ReviewEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(req.driver.Dialect())
+	t1 := builder.Table(reviewevent.Table)
+	columns := req.fields
+	if len(columns) == 0 {
+		columns = reviewevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if req.sql != nil {
+		selector = req.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if req.unique != nil && *req.unique {
+		selector.Distinct()
+	}
+	for _, m := range req.modifiers {
+		m(selector)
+	}
+	for _, p := range req.predicates {
+		p(selector)
+	}
+	for _, p := range req.order {
+		p(selector)
+	}
+	if offset := req.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := req.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (req *ReviewEventQuery) ForUpdate(opts ...sql.LockOption) *ReviewEventQuery {
+	if req.driver.Dialect() == dialect.Postgres {
+		req.Unique(false)
+	}
+	req.modifiers = append(req.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return req
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (req *ReviewEventQuery) ForShare(opts ...sql.LockOption) *ReviewEventQuery {
+	if req.driver.Dialect() == dialect.Postgres {
+		req.Unique(false)
+	}
+	req.modifiers = append(req.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return req
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (req *ReviewEventQuery) Modify(modifiers ...func(s *sql.Selector)) *ReviewEventSelect {
+	req.modifiers = append(req.modifiers, modifiers...)
+	return req.Select()
+}
+
+// ReviewEventGroupBy is the group-by builder for ReviewEvent entities.
+type ReviewEventGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (regb *ReviewEventGroupBy) Aggregate(fns ...AggregateFunc) *ReviewEventGroupBy {
+	regb.fns = append(regb.fns, fns...)
+	return regb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (regb *ReviewEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := regb.path(ctx)
+	if err != nil {
+		return err
+	}
+	regb.sql = query
+	return regb.sqlScan(ctx, v)
+}
+
+func (regb *ReviewEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range regb.fields {
+		if !reviewevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := regb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := regb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (regb *ReviewEventGroupBy) sqlQuery() *sql.Selector {
+	selector := regb.sql.Select()
+	aggregation := make([]string, 0, len(regb.fns))
+	for _, fn := range regb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(regb.fields)+len(regb.fns))
+		for _, f := range regb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(regb.fields...)...)
+}
+
+// ReviewEventSelect is the builder for selecting fields of ReviewEvent entities.
+type ReviewEventSelect struct {
+	*ReviewEventQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (res *ReviewEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := res.prepareQuery(ctx); err != nil {
+		return err
+	}
+	res.sql = res.ReviewEventQuery.sqlQuery(ctx)
+	return res.sqlScan(ctx, v)
+}
+
+func (res *ReviewEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := res.sql.Query()
+	if err := res.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (res *ReviewEventSelect) Modify(modifiers ...func(s *sql.Selector)) *ReviewEventSelect {
+	res.modifiers = append(res.modifiers, modifiers...)
+	return res
+}
@@ -0,0 +1,949 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/predicate"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/google/uuid"
+)
+
+// ReviewEventUpdate is the builder for updating ReviewEvent entities.
+type ReviewEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewEventUpdate builder.
+func (reu *ReviewEventUpdate) Where(ps ...predicate.ReviewEvent) *ReviewEventUpdate {
+	reu.mutation.Where(ps...)
+	return reu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (reu *ReviewEventUpdate) SetCreatedAt(u uint32) *ReviewEventUpdate {
+	reu.mutation.ResetCreatedAt()
+	reu.mutation.SetCreatedAt(u)
+	return reu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableCreatedAt(u *uint32) *ReviewEventUpdate {
+	if u != nil {
+		reu.SetCreatedAt(*u)
+	}
+	return reu
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (reu *ReviewEventUpdate) AddCreatedAt(u int32) *ReviewEventUpdate {
+	reu.mutation.AddCreatedAt(u)
+	return reu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (reu *ReviewEventUpdate) SetUpdatedAt(u uint32) *ReviewEventUpdate {
+	reu.mutation.ResetUpdatedAt()
+	reu.mutation.SetUpdatedAt(u)
+	return reu
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (reu *ReviewEventUpdate) AddUpdatedAt(u int32) *ReviewEventUpdate {
+	reu.mutation.AddUpdatedAt(u)
+	return reu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (reu *ReviewEventUpdate) SetDeletedAt(u uint32) *ReviewEventUpdate {
+	reu.mutation.ResetDeletedAt()
+	reu.mutation.SetDeletedAt(u)
+	return reu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableDeletedAt(u *uint32) *ReviewEventUpdate {
+	if u != nil {
+		reu.SetDeletedAt(*u)
+	}
+	return reu
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (reu *ReviewEventUpdate) AddDeletedAt(u int32) *ReviewEventUpdate {
+	reu.mutation.AddDeletedAt(u)
+	return reu
+}
+
+// SetAppID sets the "app_id" field.
+func (reu *ReviewEventUpdate) SetAppID(u uuid.UUID) *ReviewEventUpdate {
+	reu.mutation.SetAppID(u)
+	return reu
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableAppID(u *uuid.UUID) *ReviewEventUpdate {
+	if u != nil {
+		reu.SetAppID(*u)
+	}
+	return reu
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (reu *ReviewEventUpdate) ClearAppID() *ReviewEventUpdate {
+	reu.mutation.ClearAppID()
+	return reu
+}
+
+// SetReviewID sets the "review_id" field.
+func (reu *ReviewEventUpdate) SetReviewID(u uuid.UUID) *ReviewEventUpdate {
+	reu.mutation.SetReviewID(u)
+	return reu
+}
+
+// SetActorID sets the "actor_id" field.
+func (reu *ReviewEventUpdate) SetActorID(u uuid.UUID) *ReviewEventUpdate {
+	reu.mutation.SetActorID(u)
+	return reu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableActorID(u *uuid.UUID) *ReviewEventUpdate {
+	if u != nil {
+		reu.SetActorID(*u)
+	}
+	return reu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (reu *ReviewEventUpdate) ClearActorID() *ReviewEventUpdate {
+	reu.mutation.ClearActorID()
+	return reu
+}
+
+// SetEvent sets the "event" field.
+func (reu *ReviewEventUpdate) SetEvent(s string) *ReviewEventUpdate {
+	reu.mutation.SetEvent(s)
+	return reu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableEvent(s *string) *ReviewEventUpdate {
+	if s != nil {
+		reu.SetEvent(*s)
+	}
+	return reu
+}
+
+// ClearEvent clears the value of the "event" field.
+func (reu *ReviewEventUpdate) ClearEvent() *ReviewEventUpdate {
+	reu.mutation.ClearEvent()
+	return reu
+}
+
+// SetOldValue sets the "old_value" field.
+func (reu *ReviewEventUpdate) SetOldValue(s string) *ReviewEventUpdate {
+	reu.mutation.SetOldValue(s)
+	return reu
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableOldValue(s *string) *ReviewEventUpdate {
+	if s != nil {
+		reu.SetOldValue(*s)
+	}
+	return reu
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (reu *ReviewEventUpdate) ClearOldValue() *ReviewEventUpdate {
+	reu.mutation.ClearOldValue()
+	return reu
+}
+
+// SetNewValue sets the "new_value" field.
+func (reu *ReviewEventUpdate) SetNewValue(s string) *ReviewEventUpdate {
+	reu.mutation.SetNewValue(s)
+	return reu
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (reu *ReviewEventUpdate) SetNillableNewValue(s *string) *ReviewEventUpdate {
+	if s != nil {
+		reu.SetNewValue(*s)
+	}
+	return reu
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (reu *ReviewEventUpdate) ClearNewValue() *ReviewEventUpdate {
+	reu.mutation.ClearNewValue()
+	return reu
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (reu *ReviewEventUpdate) SetReview(r *Review) *ReviewEventUpdate {
+	return reu.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewEventMutation object of the builder.
+func (reu *ReviewEventUpdate) Mutation() *ReviewEventMutation {
+	return reu.mutation
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (reu *ReviewEventUpdate) ClearReview() *ReviewEventUpdate {
+	reu.mutation.ClearReview()
+	return reu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (reu *ReviewEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := reu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(reu.hooks) == 0 {
+		if err = reu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = reu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = reu.check(); err != nil {
+				return 0, err
+			}
+			reu.mutation = mutation
+			affected, err = reu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(reu.hooks) - 1; i >= 0; i-- {
+			if reu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = reu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, reu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reu *ReviewEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := reu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (reu *ReviewEventUpdate) Exec(ctx context.Context) error {
+	_, err := reu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reu *ReviewEventUpdate) ExecX(ctx context.Context) {
+	if err := reu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (reu *ReviewEventUpdate) defaults() error {
+	if _, ok := reu.mutation.UpdatedAt(); !ok {
+		if reviewevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.UpdateDefaultUpdatedAt()
+		reu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reu *ReviewEventUpdate) check() error {
+	if _, ok := reu.mutation.ReviewID(); reu.mutation.ReviewCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewEvent.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (reu *ReviewEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewEventUpdate {
+	reu.modifiers = append(reu.modifiers, modifiers...)
+	return reu
+}
+
+func (reu *ReviewEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewevent.Table,
+			Columns: reviewevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		},
+	}
+	if ps := reu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldCreatedAt,
+		})
+	}
+	if value, ok := reu.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldCreatedAt,
+		})
+	}
+	if value, ok := reu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldUpdatedAt,
+		})
+	}
+	if value, ok := reu.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldUpdatedAt,
+		})
+	}
+	if value, ok := reu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldDeletedAt,
+		})
+	}
+	if value, ok := reu.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldDeletedAt,
+		})
+	}
+	if value, ok := reu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldAppID,
+		})
+	}
+	if reu.mutation.AppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewevent.FieldAppID,
+		})
+	}
+	if value, ok := reu.mutation.ActorID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldActorID,
+		})
+	}
+	if reu.mutation.ActorIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewevent.FieldActorID,
+		})
+	}
+	if value, ok := reu.mutation.Event(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldEvent,
+		})
+	}
+	if reu.mutation.EventCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldEvent,
+		})
+	}
+	if value, ok := reu.mutation.OldValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldOldValue,
+		})
+	}
+	if reu.mutation.OldValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldOldValue,
+		})
+	}
+	if value, ok := reu.mutation.NewValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldNewValue,
+		})
+	}
+	if reu.mutation.NewValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldNewValue,
+		})
+	}
+	if reu.mutation.ReviewCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := reu.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = reu.modifiers
+	if n, err = sqlgraph.UpdateNodes(ctx, reu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReviewEventUpdateOne is the builder for updating a single ReviewEvent entity.
+type ReviewEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (reuo *ReviewEventUpdateOne) SetCreatedAt(u uint32) *ReviewEventUpdateOne {
+	reuo.mutation.ResetCreatedAt()
+	reuo.mutation.SetCreatedAt(u)
+	return reuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableCreatedAt(u *uint32) *ReviewEventUpdateOne {
+	if u != nil {
+		reuo.SetCreatedAt(*u)
+	}
+	return reuo
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (reuo *ReviewEventUpdateOne) AddCreatedAt(u int32) *ReviewEventUpdateOne {
+	reuo.mutation.AddCreatedAt(u)
+	return reuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (reuo *ReviewEventUpdateOne) SetUpdatedAt(u uint32) *ReviewEventUpdateOne {
+	reuo.mutation.ResetUpdatedAt()
+	reuo.mutation.SetUpdatedAt(u)
+	return reuo
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (reuo *ReviewEventUpdateOne) AddUpdatedAt(u int32) *ReviewEventUpdateOne {
+	reuo.mutation.AddUpdatedAt(u)
+	return reuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (reuo *ReviewEventUpdateOne) SetDeletedAt(u uint32) *ReviewEventUpdateOne {
+	reuo.mutation.ResetDeletedAt()
+	reuo.mutation.SetDeletedAt(u)
+	return reuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableDeletedAt(u *uint32) *ReviewEventUpdateOne {
+	if u != nil {
+		reuo.SetDeletedAt(*u)
+	}
+	return reuo
+}
+
+// AddDeletedAt adds u to the "deleted_at" field.
+func (reuo *ReviewEventUpdateOne) AddDeletedAt(u int32) *ReviewEventUpdateOne {
+	reuo.mutation.AddDeletedAt(u)
+	return reuo
+}
+
+// SetAppID sets the "app_id" field.
+func (reuo *ReviewEventUpdateOne) SetAppID(u uuid.UUID) *ReviewEventUpdateOne {
+	reuo.mutation.SetAppID(u)
+	return reuo
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableAppID(u *uuid.UUID) *ReviewEventUpdateOne {
+	if u != nil {
+		reuo.SetAppID(*u)
+	}
+	return reuo
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (reuo *ReviewEventUpdateOne) ClearAppID() *ReviewEventUpdateOne {
+	reuo.mutation.ClearAppID()
+	return reuo
+}
+
+// SetReviewID sets the "review_id" field.
+func (reuo *ReviewEventUpdateOne) SetReviewID(u uuid.UUID) *ReviewEventUpdateOne {
+	reuo.mutation.SetReviewID(u)
+	return reuo
+}
+
+// SetActorID sets the "actor_id" field.
+func (reuo *ReviewEventUpdateOne) SetActorID(u uuid.UUID) *ReviewEventUpdateOne {
+	reuo.mutation.SetActorID(u)
+	return reuo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableActorID(u *uuid.UUID) *ReviewEventUpdateOne {
+	if u != nil {
+		reuo.SetActorID(*u)
+	}
+	return reuo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (reuo *ReviewEventUpdateOne) ClearActorID() *ReviewEventUpdateOne {
+	reuo.mutation.ClearActorID()
+	return reuo
+}
+
+// SetEvent sets the "event" field.
+func (reuo *ReviewEventUpdateOne) SetEvent(s string) *ReviewEventUpdateOne {
+	reuo.mutation.SetEvent(s)
+	return reuo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableEvent(s *string) *ReviewEventUpdateOne {
+	if s != nil {
+		reuo.SetEvent(*s)
+	}
+	return reuo
+}
+
+// ClearEvent clears the value of the "event" field.
+func (reuo *ReviewEventUpdateOne) ClearEvent() *ReviewEventUpdateOne {
+	reuo.mutation.ClearEvent()
+	return reuo
+}
+
+// SetOldValue sets the "old_value" field.
+func (reuo *ReviewEventUpdateOne) SetOldValue(s string) *ReviewEventUpdateOne {
+	reuo.mutation.SetOldValue(s)
+	return reuo
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableOldValue(s *string) *ReviewEventUpdateOne {
+	if s != nil {
+		reuo.SetOldValue(*s)
+	}
+	return reuo
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (reuo *ReviewEventUpdateOne) ClearOldValue() *ReviewEventUpdateOne {
+	reuo.mutation.ClearOldValue()
+	return reuo
+}
+
+// SetNewValue sets the "new_value" field.
+func (reuo *ReviewEventUpdateOne) SetNewValue(s string) *ReviewEventUpdateOne {
+	reuo.mutation.SetNewValue(s)
+	return reuo
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (reuo *ReviewEventUpdateOne) SetNillableNewValue(s *string) *ReviewEventUpdateOne {
+	if s != nil {
+		reuo.SetNewValue(*s)
+	}
+	return reuo
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (reuo *ReviewEventUpdateOne) ClearNewValue() *ReviewEventUpdateOne {
+	reuo.mutation.ClearNewValue()
+	return reuo
+}
+
+// SetReview sets the "review" edge to the Review entity.
+func (reuo *ReviewEventUpdateOne) SetReview(r *Review) *ReviewEventUpdateOne {
+	return reuo.SetReviewID(r.ID)
+}
+
+// Mutation returns the ReviewEventMutation object of the builder.
+func (reuo *ReviewEventUpdateOne) Mutation() *ReviewEventMutation {
+	return reuo.mutation
+}
+
+// ClearReview clears the "review" edge to the Review entity.
+func (reuo *ReviewEventUpdateOne) ClearReview() *ReviewEventUpdateOne {
+	reuo.mutation.ClearReview()
+	return reuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (reuo *ReviewEventUpdateOne) Select(field string, fields ...string) *ReviewEventUpdateOne {
+	reuo.fields = append([]string{field}, fields...)
+	return reuo
+}
+
+// Save executes the query and returns the updated ReviewEvent entity.
+func (reuo *ReviewEventUpdateOne) Save(ctx context.Context) (*ReviewEvent, error) {
+	var (
+		err  error
+		node *ReviewEvent
+	)
+	if err := reuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(reuo.hooks) == 0 {
+		if err = reuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = reuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReviewEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = reuo.check(); err != nil {
+				return nil, err
+			}
+			reuo.mutation = mutation
+			node, err = reuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(reuo.hooks) - 1; i >= 0; i-- {
+			if reuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = reuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, reuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReviewEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReviewEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reuo *ReviewEventUpdateOne) SaveX(ctx context.Context) *ReviewEvent {
+	node, err := reuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (reuo *ReviewEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := reuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reuo *ReviewEventUpdateOne) ExecX(ctx context.Context) {
+	if err := reuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (reuo *ReviewEventUpdateOne) defaults() error {
+	if _, ok := reuo.mutation.UpdatedAt(); !ok {
+		if reviewevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized reviewevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := reviewevent.UpdateDefaultUpdatedAt()
+		reuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reuo *ReviewEventUpdateOne) check() error {
+	if _, ok := reuo.mutation.ReviewID(); reuo.mutation.ReviewCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReviewEvent.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (reuo *ReviewEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewEventUpdateOne {
+	reuo.modifiers = append(reuo.modifiers, modifiers...)
+	return reuo
+}
+
+func (reuo *ReviewEventUpdateOne) sqlSave(ctx context.Context) (_node *ReviewEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reviewevent.Table,
+			Columns: reviewevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: reviewevent.FieldID,
+			},
+		},
+	}
+	id, ok := reuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := reuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewevent.FieldID)
+		for _, f := range fields {
+			if !reviewevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := reuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldCreatedAt,
+		})
+	}
+	if value, ok := reuo.mutation.AddedCreatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldCreatedAt,
+		})
+	}
+	if value, ok := reuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldUpdatedAt,
+		})
+	}
+	if value, ok := reuo.mutation.AddedUpdatedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldUpdatedAt,
+		})
+	}
+	if value, ok := reuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldDeletedAt,
+		})
+	}
+	if value, ok := reuo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: reviewevent.FieldDeletedAt,
+		})
+	}
+	if value, ok := reuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldAppID,
+		})
+	}
+	if reuo.mutation.AppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewevent.FieldAppID,
+		})
+	}
+	if value, ok := reuo.mutation.ActorID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: reviewevent.FieldActorID,
+		})
+	}
+	if reuo.mutation.ActorIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: reviewevent.FieldActorID,
+		})
+	}
+	if value, ok := reuo.mutation.Event(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldEvent,
+		})
+	}
+	if reuo.mutation.EventCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldEvent,
+		})
+	}
+	if value, ok := reuo.mutation.OldValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldOldValue,
+		})
+	}
+	if reuo.mutation.OldValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldOldValue,
+		})
+	}
+	if value, ok := reuo.mutation.NewValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reviewevent.FieldNewValue,
+		})
+	}
+	if reuo.mutation.NewValueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: reviewevent.FieldNewValue,
+		})
+	}
+	if reuo.mutation.ReviewCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := reuo.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewevent.ReviewTable,
+			Columns: []string{reviewevent.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: review.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Modifiers = reuo.modifiers
+	_node = &ReviewEvent{config: reuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, reuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -5,7 +5,12 @@ package runtime
 import (
 	"context"
 
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpool"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewerpoolmember"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/schema"
 	"github.com/google/uuid"
 
@@ -17,8 +22,68 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	outboxMixin := schema.Outbox{}.Mixin()
+	outbox.Policy = privacy.NewPolicies(outboxMixin[0], schema.Outbox{})
+	outbox.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outbox.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outboxMixinFields0 := outboxMixin[0].Fields()
+	_ = outboxMixinFields0
+	outboxFields := schema.Outbox{}.Fields()
+	_ = outboxFields
+	// outboxDescCreatedAt is the schema descriptor for created_at field.
+	outboxDescCreatedAt := outboxMixinFields0[0].Descriptor()
+	// outbox.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outbox.DefaultCreatedAt = outboxDescCreatedAt.Default.(func() uint32)
+	// outboxDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxDescUpdatedAt := outboxMixinFields0[1].Descriptor()
+	// outbox.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outbox.DefaultUpdatedAt = outboxDescUpdatedAt.Default.(func() uint32)
+	// outbox.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outbox.UpdateDefaultUpdatedAt = outboxDescUpdatedAt.UpdateDefault.(func() uint32)
+	// outboxDescDeletedAt is the schema descriptor for deleted_at field.
+	outboxDescDeletedAt := outboxMixinFields0[2].Descriptor()
+	// outbox.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	outbox.DefaultDeletedAt = outboxDescDeletedAt.Default.(func() uint32)
+	// outboxDescRoutingKey is the schema descriptor for routing_key field.
+	outboxDescRoutingKey := outboxFields[1].Descriptor()
+	// outbox.DefaultRoutingKey holds the default value on creation for the routing_key field.
+	outbox.DefaultRoutingKey = outboxDescRoutingKey.Default.(string)
+	// outboxDescPayload is the schema descriptor for payload field.
+	outboxDescPayload := outboxFields[2].Descriptor()
+	// outbox.DefaultPayload holds the default value on creation for the payload field.
+	outbox.DefaultPayload = outboxDescPayload.Default.(string)
+	// outboxDescState is the schema descriptor for state field.
+	outboxDescState := outboxFields[3].Descriptor()
+	// outbox.DefaultState holds the default value on creation for the state field.
+	outbox.DefaultState = outboxDescState.Default.(string)
+	// outboxDescAttempts is the schema descriptor for attempts field.
+	outboxDescAttempts := outboxFields[4].Descriptor()
+	// outbox.DefaultAttempts holds the default value on creation for the attempts field.
+	outbox.DefaultAttempts = outboxDescAttempts.Default.(uint32)
+	// outboxDescNextRetryAt is the schema descriptor for next_retry_at field.
+	outboxDescNextRetryAt := outboxFields[5].Descriptor()
+	// outbox.DefaultNextRetryAt holds the default value on creation for the next_retry_at field.
+	outbox.DefaultNextRetryAt = outboxDescNextRetryAt.Default.(uint32)
+	// outboxDescDeliveredAt is the schema descriptor for delivered_at field.
+	outboxDescDeliveredAt := outboxFields[6].Descriptor()
+	// outbox.DefaultDeliveredAt holds the default value on creation for the delivered_at field.
+	outbox.DefaultDeliveredAt = outboxDescDeliveredAt.Default.(uint32)
+	// outboxDescLastError is the schema descriptor for last_error field.
+	outboxDescLastError := outboxFields[7].Descriptor()
+	// outbox.DefaultLastError holds the default value on creation for the last_error field.
+	outbox.DefaultLastError = outboxDescLastError.Default.(string)
+	// outboxDescID is the schema descriptor for id field.
+	outboxDescID := outboxFields[0].Descriptor()
+	// outbox.DefaultID holds the default value on creation for the id field.
+	outbox.DefaultID = outboxDescID.Default.(func() uuid.UUID)
 	reviewMixin := schema.Review{}.Mixin()
-	review.Policy = privacy.NewPolicies(reviewMixin[0], schema.Review{})
+	review.Policy = privacy.NewPolicies(reviewMixin[0], reviewMixin[1], schema.Review{})
 	review.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := review.Policy.EvalMutation(ctx, m); err != nil {
@@ -27,6 +92,9 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	reviewHooks := schema.Review{}.Hooks()
+
+	review.Hooks[1] = reviewHooks[0]
 	reviewMixinFields0 := reviewMixin[0].Fields()
 	_ = reviewMixinFields0
 	reviewFields := schema.Review{}.Fields()
@@ -77,12 +145,201 @@ func init() {
 	reviewDescMessage := reviewFields[8].Descriptor()
 	// review.DefaultMessage holds the default value on creation for the message field.
 	review.DefaultMessage = reviewDescMessage.Default.(string)
+	// reviewDescLeaseExpiresAt is the schema descriptor for lease_expires_at field.
+	reviewDescLeaseExpiresAt := reviewFields[10].Descriptor()
+	// review.DefaultLeaseExpiresAt holds the default value on creation for the lease_expires_at field.
+	review.DefaultLeaseExpiresAt = reviewDescLeaseExpiresAt.Default.(uint32)
+	// reviewDescDueAt is the schema descriptor for due_at field.
+	reviewDescDueAt := reviewFields[11].Descriptor()
+	// review.DefaultDueAt holds the default value on creation for the due_at field.
+	review.DefaultDueAt = reviewDescDueAt.Default.(uint32)
+	// reviewDescEscalatedAt is the schema descriptor for escalated_at field.
+	reviewDescEscalatedAt := reviewFields[12].Descriptor()
+	// review.DefaultEscalatedAt holds the default value on creation for the escalated_at field.
+	review.DefaultEscalatedAt = reviewDescEscalatedAt.Default.(uint32)
+	// reviewDescVersion is the schema descriptor for version field.
+	reviewDescVersion := reviewFields[13].Descriptor()
+	// review.DefaultVersion holds the default value on creation for the version field.
+	review.DefaultVersion = reviewDescVersion.Default.(uint32)
 	// reviewDescID is the schema descriptor for id field.
 	reviewDescID := reviewFields[0].Descriptor()
 	// review.DefaultID holds the default value on creation for the id field.
 	review.DefaultID = reviewDescID.Default.(func() uuid.UUID)
+	reviewdecisionMixin := schema.ReviewDecision{}.Mixin()
+	reviewdecision.Policy = privacy.NewPolicies(reviewdecisionMixin[0], reviewdecisionMixin[1], schema.ReviewDecision{})
+	reviewdecision.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reviewdecision.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reviewdecisionMixinFields0 := reviewdecisionMixin[0].Fields()
+	_ = reviewdecisionMixinFields0
+	reviewdecisionFields := schema.ReviewDecision{}.Fields()
+	_ = reviewdecisionFields
+	// reviewdecisionDescCreatedAt is the schema descriptor for created_at field.
+	reviewdecisionDescCreatedAt := reviewdecisionMixinFields0[0].Descriptor()
+	// reviewdecision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewdecision.DefaultCreatedAt = reviewdecisionDescCreatedAt.Default.(func() uint32)
+	// reviewdecisionDescUpdatedAt is the schema descriptor for updated_at field.
+	reviewdecisionDescUpdatedAt := reviewdecisionMixinFields0[1].Descriptor()
+	// reviewdecision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reviewdecision.DefaultUpdatedAt = reviewdecisionDescUpdatedAt.Default.(func() uint32)
+	// reviewdecision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reviewdecision.UpdateDefaultUpdatedAt = reviewdecisionDescUpdatedAt.UpdateDefault.(func() uint32)
+	// reviewdecisionDescDeletedAt is the schema descriptor for deleted_at field.
+	reviewdecisionDescDeletedAt := reviewdecisionMixinFields0[2].Descriptor()
+	// reviewdecision.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	reviewdecision.DefaultDeletedAt = reviewdecisionDescDeletedAt.Default.(func() uint32)
+	// reviewdecisionDescAppID is the schema descriptor for app_id field.
+	reviewdecisionDescAppID := reviewdecisionFields[1].Descriptor()
+	// reviewdecision.DefaultAppID holds the default value on creation for the app_id field.
+	reviewdecision.DefaultAppID = reviewdecisionDescAppID.Default.(func() uuid.UUID)
+	// reviewdecisionDescMessage is the schema descriptor for message field.
+	reviewdecisionDescMessage := reviewdecisionFields[5].Descriptor()
+	// reviewdecision.DefaultMessage holds the default value on creation for the message field.
+	reviewdecision.DefaultMessage = reviewdecisionDescMessage.Default.(string)
+	// reviewdecisionDescID is the schema descriptor for id field.
+	reviewdecisionDescID := reviewdecisionFields[0].Descriptor()
+	// reviewdecision.DefaultID holds the default value on creation for the id field.
+	reviewdecision.DefaultID = reviewdecisionDescID.Default.(func() uuid.UUID)
+	revieweventMixin := schema.ReviewEvent{}.Mixin()
+	reviewevent.Policy = privacy.NewPolicies(revieweventMixin[0], revieweventMixin[1], schema.ReviewEvent{})
+	reviewevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reviewevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	revieweventMixinFields0 := revieweventMixin[0].Fields()
+	_ = revieweventMixinFields0
+	revieweventFields := schema.ReviewEvent{}.Fields()
+	_ = revieweventFields
+	// revieweventDescCreatedAt is the schema descriptor for created_at field.
+	revieweventDescCreatedAt := revieweventMixinFields0[0].Descriptor()
+	// reviewevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewevent.DefaultCreatedAt = revieweventDescCreatedAt.Default.(func() uint32)
+	// revieweventDescUpdatedAt is the schema descriptor for updated_at field.
+	revieweventDescUpdatedAt := revieweventMixinFields0[1].Descriptor()
+	// reviewevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reviewevent.DefaultUpdatedAt = revieweventDescUpdatedAt.Default.(func() uint32)
+	// reviewevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reviewevent.UpdateDefaultUpdatedAt = revieweventDescUpdatedAt.UpdateDefault.(func() uint32)
+	// revieweventDescDeletedAt is the schema descriptor for deleted_at field.
+	revieweventDescDeletedAt := revieweventMixinFields0[2].Descriptor()
+	// reviewevent.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	reviewevent.DefaultDeletedAt = revieweventDescDeletedAt.Default.(func() uint32)
+	// revieweventDescAppID is the schema descriptor for app_id field.
+	revieweventDescAppID := revieweventFields[1].Descriptor()
+	// reviewevent.DefaultAppID holds the default value on creation for the app_id field.
+	reviewevent.DefaultAppID = revieweventDescAppID.Default.(func() uuid.UUID)
+	// revieweventDescActorID is the schema descriptor for actor_id field.
+	revieweventDescActorID := revieweventFields[3].Descriptor()
+	// reviewevent.DefaultActorID holds the default value on creation for the actor_id field.
+	reviewevent.DefaultActorID = revieweventDescActorID.Default.(func() uuid.UUID)
+	// revieweventDescEvent is the schema descriptor for event field.
+	revieweventDescEvent := revieweventFields[4].Descriptor()
+	// reviewevent.DefaultEvent holds the default value on creation for the event field.
+	reviewevent.DefaultEvent = revieweventDescEvent.Default.(string)
+	// revieweventDescOldValue is the schema descriptor for old_value field.
+	revieweventDescOldValue := revieweventFields[5].Descriptor()
+	// reviewevent.DefaultOldValue holds the default value on creation for the old_value field.
+	reviewevent.DefaultOldValue = revieweventDescOldValue.Default.(string)
+	// revieweventDescNewValue is the schema descriptor for new_value field.
+	revieweventDescNewValue := revieweventFields[6].Descriptor()
+	// reviewevent.DefaultNewValue holds the default value on creation for the new_value field.
+	reviewevent.DefaultNewValue = revieweventDescNewValue.Default.(string)
+	// revieweventDescID is the schema descriptor for id field.
+	revieweventDescID := revieweventFields[0].Descriptor()
+	// reviewevent.DefaultID holds the default value on creation for the id field.
+	reviewevent.DefaultID = revieweventDescID.Default.(func() uuid.UUID)
+	reviewerpoolMixin := schema.ReviewerPool{}.Mixin()
+	reviewerpool.Policy = privacy.NewPolicies(reviewerpoolMixin[0], reviewerpoolMixin[1], schema.ReviewerPool{})
+	reviewerpool.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reviewerpool.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reviewerpoolMixinFields0 := reviewerpoolMixin[0].Fields()
+	_ = reviewerpoolMixinFields0
+	reviewerpoolFields := schema.ReviewerPool{}.Fields()
+	_ = reviewerpoolFields
+	// reviewerpoolDescCreatedAt is the schema descriptor for created_at field.
+	reviewerpoolDescCreatedAt := reviewerpoolMixinFields0[0].Descriptor()
+	// reviewerpool.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewerpool.DefaultCreatedAt = reviewerpoolDescCreatedAt.Default.(func() uint32)
+	// reviewerpoolDescUpdatedAt is the schema descriptor for updated_at field.
+	reviewerpoolDescUpdatedAt := reviewerpoolMixinFields0[1].Descriptor()
+	// reviewerpool.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reviewerpool.DefaultUpdatedAt = reviewerpoolDescUpdatedAt.Default.(func() uint32)
+	// reviewerpool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reviewerpool.UpdateDefaultUpdatedAt = reviewerpoolDescUpdatedAt.UpdateDefault.(func() uint32)
+	// reviewerpoolDescDeletedAt is the schema descriptor for deleted_at field.
+	reviewerpoolDescDeletedAt := reviewerpoolMixinFields0[2].Descriptor()
+	// reviewerpool.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	reviewerpool.DefaultDeletedAt = reviewerpoolDescDeletedAt.Default.(func() uint32)
+	// reviewerpoolDescStrategy is the schema descriptor for strategy field.
+	reviewerpoolDescStrategy := reviewerpoolFields[4].Descriptor()
+	// reviewerpool.DefaultStrategy holds the default value on creation for the strategy field.
+	reviewerpool.DefaultStrategy = reviewerpoolDescStrategy.Default.(string)
+	// reviewerpoolDescCursor is the schema descriptor for cursor field.
+	reviewerpoolDescCursor := reviewerpoolFields[5].Descriptor()
+	// reviewerpool.DefaultCursor holds the default value on creation for the cursor field.
+	reviewerpool.DefaultCursor = reviewerpoolDescCursor.Default.(uint32)
+	// reviewerpoolDescID is the schema descriptor for id field.
+	reviewerpoolDescID := reviewerpoolFields[0].Descriptor()
+	// reviewerpool.DefaultID holds the default value on creation for the id field.
+	reviewerpool.DefaultID = reviewerpoolDescID.Default.(func() uuid.UUID)
+	reviewerpoolmemberMixin := schema.ReviewerPoolMember{}.Mixin()
+	reviewerpoolmember.Policy = privacy.NewPolicies(reviewerpoolmemberMixin[0], schema.ReviewerPoolMember{})
+	reviewerpoolmember.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reviewerpoolmember.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reviewerpoolmemberMixinFields0 := reviewerpoolmemberMixin[0].Fields()
+	_ = reviewerpoolmemberMixinFields0
+	reviewerpoolmemberFields := schema.ReviewerPoolMember{}.Fields()
+	_ = reviewerpoolmemberFields
+	// reviewerpoolmemberDescCreatedAt is the schema descriptor for created_at field.
+	reviewerpoolmemberDescCreatedAt := reviewerpoolmemberMixinFields0[0].Descriptor()
+	// reviewerpoolmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewerpoolmember.DefaultCreatedAt = reviewerpoolmemberDescCreatedAt.Default.(func() uint32)
+	// reviewerpoolmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	reviewerpoolmemberDescUpdatedAt := reviewerpoolmemberMixinFields0[1].Descriptor()
+	// reviewerpoolmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reviewerpoolmember.DefaultUpdatedAt = reviewerpoolmemberDescUpdatedAt.Default.(func() uint32)
+	// reviewerpoolmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reviewerpoolmember.UpdateDefaultUpdatedAt = reviewerpoolmemberDescUpdatedAt.UpdateDefault.(func() uint32)
+	// reviewerpoolmemberDescDeletedAt is the schema descriptor for deleted_at field.
+	reviewerpoolmemberDescDeletedAt := reviewerpoolmemberMixinFields0[2].Descriptor()
+	// reviewerpoolmember.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	reviewerpoolmember.DefaultDeletedAt = reviewerpoolmemberDescDeletedAt.Default.(func() uint32)
+	// reviewerpoolmemberDescWeight is the schema descriptor for weight field.
+	reviewerpoolmemberDescWeight := reviewerpoolmemberFields[3].Descriptor()
+	// reviewerpoolmember.DefaultWeight holds the default value on creation for the weight field.
+	reviewerpoolmember.DefaultWeight = reviewerpoolmemberDescWeight.Default.(uint32)
+	// reviewerpoolmemberDescOnShift is the schema descriptor for on_shift field.
+	reviewerpoolmemberDescOnShift := reviewerpoolmemberFields[4].Descriptor()
+	// reviewerpoolmember.DefaultOnShift holds the default value on creation for the on_shift field.
+	reviewerpoolmember.DefaultOnShift = reviewerpoolmemberDescOnShift.Default.(bool)
+	// reviewerpoolmemberDescID is the schema descriptor for id field.
+	reviewerpoolmemberDescID := reviewerpoolmemberFields[0].Descriptor()
+	// reviewerpoolmember.DefaultID holds the default value on creation for the id field.
+	reviewerpoolmember.DefaultID = reviewerpoolmemberDescID.Default.(func() uuid.UUID)
 }
 
 const (
-	Version = "v0.11.2" // Version of ent codegen.
+	Version = "v0.11.2"                                         // Version of ent codegen.
+	Sum     = "h1:UM2/BUhF2FfsxPHRxLjQbhqJNaDdVlOwNIAMLs2jyto=" // Sum of ent codegen.
 )
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
+	"github.com/google/uuid"
+)
+
+// Outbox holds the schema definition for the Outbox entity.
+type Outbox struct {
+	ent.Schema
+}
+
+func (Outbox) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
+
+// Fields of the Outbox.
+func (Outbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.
+			UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.
+			String("routing_key").
+			Optional().
+			Default(""),
+		field.
+			Text("payload").
+			Optional().
+			Default(""),
+		field.
+			String("state").
+			Optional().
+			Default("Pending"),
+		field.
+			Uint32("attempts").
+			Optional().
+			Default(0),
+		field.
+			Uint32("next_retry_at").
+			Optional().
+			Default(0),
+		field.
+			Uint32("delivered_at").
+			Optional().
+			Default(0),
+		field.
+			Text("last_error").
+			Optional().
+			Default(""),
+	}
+}
+
+// Edges of the Outbox.
+func (Outbox) Edges() []ent.Edge {
+	return nil
+}
+
+func (Outbox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state", "next_retry_at"),
+		index.Fields("state", "delivered_at"),
+	}
+}
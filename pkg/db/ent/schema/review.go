@@ -1,8 +1,14 @@
 package schema
 
 import (
+	"context"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	gen "github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/hook"
 	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
 	"github.com/google/uuid"
 
@@ -17,6 +23,7 @@ type Review struct {
 func (Review) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.TimeMixin{},
+		mixin.TenantMixin{},
 	}
 }
 
@@ -65,10 +72,60 @@ func (Review) Fields() []ent.Field {
 			String("message").
 			Optional().
 			Default(""),
+		// reasons are codes of the reason catalogue, message keeps the free text
+		field.
+			Strings("reasons").
+			Optional(),
+		field.
+			Uint32("lease_expires_at").
+			Optional().
+			Default(0),
+		field.
+			Uint32("due_at").
+			Optional().
+			Default(0),
+		field.
+			Uint32("escalated_at").
+			Optional().
+			Default(0),
+		field.
+			Uint32("version").
+			Optional().
+			Default(0),
+		// open_key is only set while the review waits, so one object has one open review at most
+		field.
+			String("open_key").
+			Optional().
+			Nillable().
+			Unique(),
+	}
+}
+
+// Hooks of the Review, every update bumps the version checked by optimistic updates
+func (Review) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.ReviewFunc(func(ctx context.Context, m *gen.ReviewMutation) (ent.Value, error) {
+					m.AddVersion(1)
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
 	}
 }
 
 // Edges of the Review.
 func (Review) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("events", ReviewEvent.Type),
+		edge.To("decisions", ReviewDecision.Type),
+	}
+}
+
+func (Review) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state", "due_at"),
+	}
 }
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
+	"github.com/google/uuid"
+)
+
+// ReviewDecision holds the schema definition for the ReviewDecision entity.
+type ReviewDecision struct {
+	ent.Schema
+}
+
+func (ReviewDecision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+		mixin.TenantMixin{},
+	}
+}
+
+// Fields of the ReviewDecision.
+func (ReviewDecision) Fields() []ent.Field {
+	return []ent.Field{
+		field.
+			UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		// app_id copies the app of the review, so the rows are scoped like their review
+		field.
+			UUID("app_id", uuid.UUID{}).
+			Optional().
+			Default(func() uuid.UUID {
+				return uuid.UUID{}
+			}),
+		field.
+			UUID("review_id", uuid.UUID{}),
+		field.
+			UUID("reviewer_id", uuid.UUID{}),
+		field.
+			String("decision"),
+		field.
+			String("message").
+			Optional().
+			Default(""),
+		// reasons are codes of the reason catalogue, message keeps the free text
+		field.
+			Strings("reasons").
+			Optional(),
+	}
+}
+
+// Edges of the ReviewDecision.
+func (ReviewDecision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("review", Review.Type).
+			Ref("decisions").
+			Field("review_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ReviewDecision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("review_id", "reviewer_id"),
+	}
+}
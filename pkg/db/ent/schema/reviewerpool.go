@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
+	"github.com/google/uuid"
+)
+
+// ReviewerPool holds the schema definition for the ReviewerPool entity.
+type ReviewerPool struct {
+	ent.Schema
+}
+
+func (ReviewerPool) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+		mixin.TenantMixin{},
+	}
+}
+
+// Fields of the ReviewerPool.
+func (ReviewerPool) Fields() []ent.Field {
+	return []ent.Field{
+		field.
+			UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.
+			UUID("app_id", uuid.UUID{}),
+		field.
+			String("domain"),
+		field.
+			String("object_type"),
+		field.
+			String("strategy").
+			Optional().
+			Default("RoundRobin"),
+		field.
+			Uint32("cursor").
+			Optional().
+			Default(0),
+	}
+}
+
+// Edges of the ReviewerPool.
+func (ReviewerPool) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", ReviewerPoolMember.Type),
+	}
+}
+
+func (ReviewerPool) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "domain", "object_type").Unique(),
+	}
+}
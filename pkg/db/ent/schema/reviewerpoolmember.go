@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
+	"github.com/google/uuid"
+)
+
+// ReviewerPoolMember holds the schema definition for the ReviewerPoolMember entity.
+type ReviewerPoolMember struct {
+	ent.Schema
+}
+
+func (ReviewerPoolMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
+
+// Fields of the ReviewerPoolMember.
+func (ReviewerPoolMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.
+			UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.
+			UUID("pool_id", uuid.UUID{}),
+		field.
+			UUID("reviewer_id", uuid.UUID{}),
+		field.
+			Uint32("weight").
+			Optional().
+			Default(1),
+		field.
+			Bool("on_shift").
+			Optional().
+			Default(true),
+	}
+}
+
+// Edges of the ReviewerPoolMember.
+func (ReviewerPoolMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("pool", ReviewerPool.Type).
+			Ref("members").
+			Field("pool_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ReviewerPoolMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("pool_id", "reviewer_id").Unique(),
+	}
+}
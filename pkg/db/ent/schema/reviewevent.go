@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/NpoolPlatform/review-manager/pkg/db/mixin"
+	"github.com/google/uuid"
+)
+
+// ReviewEvent holds the schema definition for the ReviewEvent entity.
+type ReviewEvent struct {
+	ent.Schema
+}
+
+func (ReviewEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+		mixin.TenantMixin{},
+	}
+}
+
+// Fields of the ReviewEvent.
+func (ReviewEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.
+			UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		// app_id copies the app of the review, so the rows are scoped like their review
+		field.
+			UUID("app_id", uuid.UUID{}).
+			Optional().
+			Default(func() uuid.UUID {
+				return uuid.UUID{}
+			}),
+		field.
+			UUID("review_id", uuid.UUID{}),
+		field.
+			UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Default(func() uuid.UUID {
+				return uuid.UUID{}
+			}),
+		field.
+			String("event").
+			Optional().
+			Default(""),
+		field.
+			String("old_value").
+			Optional().
+			Default(""),
+		field.
+			String("new_value").
+			Optional().
+			Default(""),
+	}
+}
+
+// Edges of the ReviewEvent.
+func (ReviewEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("review", Review.Type).
+			Ref("events").
+			Field("review_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ReviewEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("review_id", "created_at"),
+	}
+}
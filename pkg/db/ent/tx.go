@@ -14,8 +14,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Outbox is the client for interacting with the Outbox builders.
+	Outbox *OutboxClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
+	// ReviewDecision is the client for interacting with the ReviewDecision builders.
+	ReviewDecision *ReviewDecisionClient
+	// ReviewEvent is the client for interacting with the ReviewEvent builders.
+	ReviewEvent *ReviewEventClient
+	// ReviewerPool is the client for interacting with the ReviewerPool builders.
+	ReviewerPool *ReviewerPoolClient
+	// ReviewerPoolMember is the client for interacting with the ReviewerPoolMember builders.
+	ReviewerPoolMember *ReviewerPoolMemberClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,7 +161,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Outbox = NewOutboxClient(tx.config)
 	tx.Review = NewReviewClient(tx.config)
+	tx.ReviewDecision = NewReviewDecisionClient(tx.config)
+	tx.ReviewEvent = NewReviewEventClient(tx.config)
+	tx.ReviewerPool = NewReviewerPoolClient(tx.config)
+	tx.ReviewerPoolMember = NewReviewerPoolMemberClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Review.QueryXXX(), the query will be executed
+// applies a query, for example: Outbox.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
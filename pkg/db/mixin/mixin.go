@@ -19,3 +19,15 @@ func (TimeMixin) Policy() ent.Policy {
 		},
 	}
 }
+
+func (TenantMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.CreateTenantRule(),
+			rule.FilterTenantRule(),
+		},
+	}
+}
@@ -1,9 +1,16 @@
 package mixin
 
 import (
+	"entgo.io/ent/schema/mixin"
+
 	cruder "github.com/NpoolPlatform/libent-cruder/pkg/mixin"
 )
 
 type TimeMixin struct {
 	cruder.Schema
 }
+
+// TenantMixin scopes the schemas with an app_id field to the app of the grpc caller
+type TenantMixin struct {
+	mixin.Schema
+}
@@ -4,12 +4,64 @@ import (
 	"context"
 
 	"entgo.io/ent/entql"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
 	"github.com/NpoolPlatform/review-manager/pkg/db/ent/privacy"
+	"github.com/NpoolPlatform/review-manager/pkg/tenant"
 )
 
+type withDeletedKey struct{}
+
+// WithDeleted lets the queries of ctx see the soft deleted rows FilterTimeRule hides
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withDeletedKey{}, true)
+}
+
 func FilterTimeRule() privacy.QueryMutationRule {
 	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		if deleted, ok := ctx.Value(withDeletedKey{}).(bool); ok && deleted {
+			return privacy.Skip
+		}
 		f.Where(entql.FieldEQ("deleted_at", 0))
 		return privacy.Skip
 	})
 }
+
+// FilterTenantRule keeps the grpc callers in the rows of their app, see tenant.Scoped.
+// Callers without app are handled by tenant.MissingApp.
+func FilterTenantRule() privacy.QueryMutationRule {
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		if !tenant.Scoped(ctx) {
+			return privacy.Skip
+		}
+		appID, ok := tenant.AppID(ctx)
+		if !ok {
+			if err := tenant.MissingApp(ctx, "filter"); err != nil {
+				return privacy.Denyf("%v", err)
+			}
+			return privacy.Skip
+		}
+		f.Where(entql.FieldEQ("app_id", appID))
+		return privacy.Skip
+	})
+}
+
+// CreateTenantRule denies the grpc callers to create rows of another app,
+// the filter of FilterTenantRule does not apply to creations
+func CreateTenantRule() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if !m.Op().Is(ent.OpCreate) || !tenant.Scoped(ctx) {
+			return privacy.Skip
+		}
+		appID, ok := tenant.AppID(ctx)
+		if !ok {
+			if err := tenant.MissingApp(ctx, m.Type()); err != nil {
+				return privacy.Denyf("%v", err)
+			}
+			return privacy.Skip
+		}
+		if val, ok := m.Field("app_id"); ok && val != appID {
+			return privacy.Denyf("create row of app %v as app %v", val, appID)
+		}
+		return privacy.Skip
+	})
+}
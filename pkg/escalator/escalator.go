@@ -0,0 +1,40 @@
+package escalator
+
+import (
+	"context"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	crud "github.com/NpoolPlatform/review-manager/pkg/crud/review"
+)
+
+const (
+	interval  = 1 * time.Minute
+	batchSize = 100
+)
+
+// Run escalates overdue reviews until ctx is done, a full batch is followed by the next one at once
+func Run(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		infos, err := crud.Escalate(ctx, batchSize)
+		if err != nil {
+			logger.Sugar().Errorw("escalate", "error", err)
+		}
+		for _, info := range infos {
+			logger.Sugar().Infow("escalate", "ID", info.ID, "Domain", info.Domain, "ObjectType", info.ObjectType, "DueAt", info.DueAt)
+		}
+		if len(infos) == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
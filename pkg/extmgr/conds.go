@@ -0,0 +1,22 @@
+package extmgr
+
+import (
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+)
+
+// SingleConds returns the single value fields of conds as the Conds of the Manager service
+func SingleConds(conds *Conds) *npool.Conds {
+	if conds == nil {
+		return nil
+	}
+	return &npool.Conds{
+		ID:         conds.ID,
+		AppID:      conds.AppID,
+		ReviewerID: conds.ReviewerID,
+		Domain:     conds.Domain,
+		ObjectID:   conds.ObjectID,
+		Trigger:    conds.Trigger,
+		ObjectType: conds.ObjectType,
+		State:      conds.State,
+	}
+}
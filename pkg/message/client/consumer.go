@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	msgcli "github.com/NpoolPlatform/go-service-framework/pkg/rabbitmq/client"
+	constant "github.com/NpoolPlatform/review-manager/pkg/message/const"
+	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	prefetchCount     = 10
+	defaultRetries    = 3
+	defaultBackoff    = 1 * time.Second
+	reconnectInterval = 5 * time.Second
+	requeueDelay      = 30 * time.Second
+)
+
+// Handler handles the body of one delivery. An error wrapping msg.ErrInvalidMessage
+// parks the message at once, other errors are transient: they are retried, then the
+// message is requeued, it is never parked.
+type Handler func(ctx context.Context, body []byte) error
+
+// Consumer consumes Queue with manual ack, parking invalid messages to DeadQueue
+type Consumer struct {
+	Queue      string
+	DeadQueue  string
+	Handler    Handler
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+func (c *Consumer) maxRetries() int {
+	if c.MaxRetries <= 0 {
+		return defaultRetries
+	}
+	return c.MaxRetries
+}
+
+func (c *Consumer) backoff(retry int) time.Duration {
+	d := c.Backoff
+	if d <= 0 {
+		d = defaultBackoff
+	}
+	return d << uint(retry)
+}
+
+// declareQueue declares the queue with a dead letter queue, a message nacked without requeue goes to dead
+func (c *Consumer) declareQueue(cli *msgcli.Client) error {
+	if err := cli.DeclareQueue(c.DeadQueue); err != nil {
+		return err
+	}
+
+	_, err := cli.Channel.QueueDeclare(
+		c.Queue,
+		true,
+		false,
+		false,
+		false,
+		amqp.Table{
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": c.DeadQueue,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("fail declare queue %v: %v", c.Queue, err)
+	}
+
+	return nil
+}
+
+// handle retries transient errors with exponential backoff, it returns the last error
+func (c *Consumer) handle(ctx context.Context, body []byte) error {
+	var err error
+	for retry := 0; ; retry++ {
+		err = c.Handler(ctx, body)
+		if err == nil || errors.Is(err, msg.ErrInvalidMessage) || retry >= c.maxRetries() {
+			return err
+		}
+
+		logger.Sugar().Warnw("handle", "Queue", c.Queue, "Retry", retry+1, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.backoff(retry)):
+		}
+	}
+}
+
+// settle acks a handled message and parks an invalid one. A message whose retries ran out is
+// requeued after requeueDelay, so an outage of a dependency doesn't spin on redeliveries.
+func (c *Consumer) settle(ctx context.Context, d *amqp.Delivery, err error) error {
+	switch {
+	case err == nil:
+		return d.Ack(false)
+	case errors.Is(err, msg.ErrInvalidMessage):
+		logger.Sugar().Errorw("settle", "Queue", c.Queue, "State", "park", "DeadQueue", c.DeadQueue, "error", err)
+		return d.Nack(false, false)
+	case ctx.Err() != nil:
+		return d.Nack(false, true)
+	default:
+		logger.Sugar().Warnw("settle", "Queue", c.Queue, "State", "requeue", "Delay", requeueDelay, "error", err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(requeueDelay):
+		}
+		return d.Nack(false, true)
+	}
+}
+
+// session consumes until ctx is done or the channel is closed
+func (c *Consumer) session(ctx context.Context) error {
+	cli, err := msgcli.New(constant.ServiceName)
+	if err != nil {
+		return err
+	}
+	defer cli.Destroy()
+
+	if err := c.declareQueue(cli); err != nil {
+		return err
+	}
+	if err := cli.Channel.Qos(prefetchCount, 0, false); err != nil {
+		return fmt.Errorf("fail set qos: %v", err)
+	}
+
+	closed := cli.Channel.NotifyClose(make(chan *amqp.Error, 1))
+
+	deliveries, err := cli.Channel.Consume(c.Queue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("fail consume %v: %v", c.Queue, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-closed:
+			return fmt.Errorf("channel of %v closed: %v", c.Queue, err)
+		case d, ok := <-deliveries:
+			if !ok {
+				return fmt.Errorf("deliveries of %v closed", c.Queue)
+			}
+			if err := c.settle(ctx, &d, c.handle(ctx, d.Body)); err != nil {
+				return fmt.Errorf("fail settle %v: %v", c.Queue, err)
+			}
+		}
+	}
+}
+
+// Run consumes until ctx is done, reconnecting whenever the connection or channel is lost
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		err := c.session(ctx)
+		if ctx.Err() != nil {
+			logger.Sugar().Infow("Run", "Queue", c.Queue, "State", "stopped")
+			return
+		}
+
+		logger.Sugar().Warnw("Run", "Queue", c.Queue, "State", "reconnect", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
@@ -1,24 +1,13 @@
 package listener
 
 import (
-	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	"context"
+
+	"github.com/NpoolPlatform/review-manager/api"
 	msgcli "github.com/NpoolPlatform/review-manager/pkg/message/client"
-	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
 )
 
-func listenTemplateExample() {
-	for {
-		err := msgcli.ConsumeExample(func(example *msg.Example) error {
-			// Call event handler in api module
-			return nil
-		})
-		if err != nil {
-			logger.Sugar().Errorf("fail to consume example: %v", err)
-			return
-		}
-	}
-}
-
-func Listen() {
-	go listenTemplateExample()
+// Listen starts the consumers, they stop when ctx is done
+func Listen(ctx context.Context) {
+	go msgcli.ConsumeReviewRequested(ctx, api.HandleReviewRequested)
 }
@@ -1,20 +1,91 @@
 package message
 
 import (
-	msgsrv "github.com/NpoolPlatform/go-service-framework/pkg/rabbitmq/server"
+	"errors"
+	"fmt"
+	"strings"
 )
 
-const QueueExample = "example"
+// Upstream services request reviews through QueueReviewRequested,
+// payloads which can never be handled are dead-lettered to QueueReviewRequestedDead
+const (
+	QueueReviewRequested     = "review-manager.review.requested"
+	QueueReviewRequestedDead = "review-manager.review.requested.dead"
+)
+
+// ErrInvalidMessage marks a message which will never succeed, so it is dead-lettered instead of redelivered
+var ErrInvalidMessage = errors.New("invalid message")
+
+// ReviewRequested asks to create a review, Trigger and ObjectType are the enum names of the review proto.
+// ID is optional, a producer setting it gets an idempotent request.
+type ReviewRequested struct {
+	ID         *string `json:"id,omitempty"`
+	AppID      string  `json:"app_id"`
+	Domain     string  `json:"domain"`
+	ObjectID   string  `json:"object_id"`
+	ObjectType string  `json:"object_type"`
+	Trigger    string  `json:"trigger"`
+}
+
+// Review lifecycle events are published to a topic exchange with routing key
+// <domain>.<object type>.<event>, e.g. kyc-middleware-npool-top.ObjectKyc.Created
+const (
+	ExchangeReview = "review"
+
+	EventReviewCreated      = "Created"
+	EventReviewStateChanged = "StateChanged"
+	EventReviewDeleted      = "Deleted"
+	EventReviewEscalated    = "Escalated"
+	EventReviewRestored     = "Restored"
+)
+
+type Review struct {
+	ID         string   `json:"id"`
+	AppID      string   `json:"app_id"`
+	ReviewerID string   `json:"reviewer_id"`
+	Domain     string   `json:"domain"`
+	ObjectID   string   `json:"object_id"`
+	Trigger    string   `json:"trigger"`
+	ObjectType string   `json:"object_type"`
+	State      string   `json:"state"`
+	Message    string   `json:"message"`
+	Reasons    []string `json:"reasons"`
+	DueAt      uint32   `json:"due_at"`
+	Version    uint32   `json:"version"`
+	CreatedAt  uint32   `json:"created_at"`
+	UpdatedAt  uint32   `json:"updated_at"`
+}
+
+type ReviewCreated struct {
+	Review
+}
+
+type ReviewStateChanged struct {
+	Review
+	PrevState string `json:"prev_state"`
+}
+
+// ReviewEscalated is published once when a Wait review passes its due time
+type ReviewEscalated struct {
+	Review
+	EscalatedAt uint32 `json:"escalated_at"`
+}
+
+type ReviewDeleted struct {
+	Review
+	DeletedAt uint32 `json:"deleted_at"`
+}
 
-type Example struct {
-	ID      int    `json:"id"`
-	Example string `json:"example"`
+type ReviewRestored struct {
+	Review
 }
 
-func InitQueues() error {
-	err := msgsrv.DeclareQueue(QueueExample)
-	if err != nil {
-		return err
-	}
-	return nil
+// RoutingKey keeps each segment free of dots so consumers can bind with * and #
+func RoutingKey(domain, objectType, event string) string {
+	return fmt.Sprintf(
+		"%v.%v.%v",
+		strings.ReplaceAll(domain, ".", "-"),
+		strings.ReplaceAll(objectType, ".", "-"),
+		event,
+	)
 }
@@ -1,14 +1,143 @@
 package server
 
 import (
-	msgsrv "github.com/NpoolPlatform/go-service-framework/pkg/rabbitmq/server"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	rabbitmq "github.com/NpoolPlatform/go-service-framework/pkg/rabbitmq/common"
 	msg "github.com/NpoolPlatform/review-manager/pkg/message/message"
+
+	"github.com/streadway/amqp"
+)
+
+// confirmTimeout bounds the wait for the broker to confirm a publishing
+const confirmTimeout = 5 * time.Second
+
+type server struct {
+	mq       *rabbitmq.RabbitMQ
+	confirms chan amqp.Confirmation
+}
+
+var (
+	mu       sync.Mutex
+	myServer *server
 )
 
+// open connects in confirm mode, the broker acks every publishing once it took it over
+func open() (*server, error) {
+	mq, err := rabbitmq.New(rabbitmq.MyServiceNameToVHost())
+	if err != nil {
+		return nil, fmt.Errorf("fail create rabbitmq: %v", err)
+	}
+
+	err = mq.Channel.ExchangeDeclare(
+		msg.ExchangeReview,
+		amqp.ExchangeTopic,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		mq.Destroy()
+		return nil, fmt.Errorf("fail declare exchange %v: %v", msg.ExchangeReview, err)
+	}
+
+	if err := mq.Channel.Confirm(false); err != nil {
+		mq.Destroy()
+		return nil, fmt.Errorf("fail enable publisher confirms: %v", err)
+	}
+
+	return &server{
+		mq:       mq,
+		confirms: mq.Channel.NotifyPublish(make(chan amqp.Confirmation, 1)),
+	}, nil
+}
+
 func Init() error {
-	return msg.InitQueues()
+	srv, err := open()
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	myServer = srv
+	mu.Unlock()
+
+	return nil
+}
+
+func Deinit() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if myServer != nil {
+		myServer.mq.Destroy()
+		myServer = nil
+	}
 }
 
-func PublishExample(example *msg.Example) error {
-	return msgsrv.PublishToQueue(msg.QueueExample, example)
+// reset drops a server whose channel is closed or out of step with its confirms,
+// the next Publish opens a new one
+func reset() {
+	if myServer != nil {
+		myServer.mq.Destroy()
+		myServer = nil
+	}
+}
+
+// Publish sends a json body, already marshaled by the outbox, to the review exchange and waits
+// for the broker to confirm it. It reconnects when the connection was lost, a nil error means the
+// broker took the message over.
+func Publish(routingKey string, body []byte) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if myServer != nil && myServer.mq.Conn.IsClosed() {
+		logger.Sugar().Warnw("Publish", "State", "connection closed, reconnecting")
+		reset()
+	}
+	if myServer == nil {
+		srv, err := open()
+		if err != nil {
+			return err
+		}
+		myServer = srv
+	}
+
+	err := myServer.mq.Channel.Publish(
+		msg.ExchangeReview,
+		routingKey,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Type:         routingKey,
+			Body:         body,
+		},
+	)
+	if err != nil {
+		reset()
+		return err
+	}
+
+	select {
+	case confirm, ok := <-myServer.confirms:
+		if !ok {
+			reset()
+			return fmt.Errorf("channel closed before confirm")
+		}
+		if !confirm.Ack {
+			return fmt.Errorf("broker nacked %v", confirm.DeliveryTag)
+		}
+	case <-time.After(confirmTimeout):
+		reset()
+		return fmt.Errorf("no confirm in %v", confirmTimeout)
+	}
+
+	return nil
 }
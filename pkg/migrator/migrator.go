@@ -2,13 +2,242 @@ package migrator
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+	"github.com/NpoolPlatform/go-service-framework/pkg/mysql"
+
+	servicename "github.com/NpoolPlatform/review-manager/pkg/servicename"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
 )
 
+const (
+	lockTimeout   = 10 // seconds to wait for the lock in each attempt
+	versionsTable = "review_migrations"
+)
+
+type step struct {
+	Version uint32
+	Name    string
+	Migrate func(ctx context.Context, tx *ent.Tx) error
+}
+
+// Steps are applied in order, never reorder or remove a released step
+var steps = []*step{
+	{
+		Version: 1,
+		Name:    "backfill legacy review state",
+		Migrate: backfillState,
+	},
+	{
+		Version: 2,
+		Name:    "backfill due time of wait reviews",
+		Migrate: backfillDueAt,
+	},
+	{
+		Version: 3,
+		Name:    "backfill open key of wait reviews",
+		Migrate: backfillOpenKey,
+	},
+	{
+		Version: 4,
+		Name:    "backfill app of review events and decisions",
+		Migrate: backfillEventApp,
+	},
+}
+
+func lockKey() string {
+	return fmt.Sprintf("migrator:%v", servicename.ServiceName)
+}
+
+// lock takes a mysql named lock, which lives as long as the returned connection
+func lock(ctx context.Context) (*sql.Conn, error) {
+	mdb, err := mysql.GetConn()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := mdb.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		locked := 0
+		err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockKey(), lockTimeout).Scan(&locked)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if locked == 1 {
+			return conn, nil
+		}
+
+		logger.Sugar().Infow("Migrate", "State", "wait for lock", "Key", lockKey())
+
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return nil, ctx.Err()
+		default:
+		}
+	}
+}
+
+func unlock(conn *sql.Conn) {
+	if _, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockKey()); err != nil {
+		logger.Sugar().Errorw("Migrate", "State", "unlock", "Key", lockKey(), "error", err)
+	}
+	conn.Close()
+}
+
+func createVersionsTable(ctx context.Context, cli *ent.Client) error {
+	_, err := cli.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %v ("+
+				"version INT UNSIGNED NOT NULL PRIMARY KEY, "+
+				"name VARCHAR(256) NOT NULL, "+
+				"migrated_at INT UNSIGNED NOT NULL)",
+			versionsTable,
+		),
+	)
+	return err
+}
+
+func versionsTableExist(ctx context.Context, cli *ent.Client) (bool, error) {
+	rows, err := cli.QueryContext(
+		ctx,
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+		versionsTable,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return false, err
+		}
+	}
+
+	return count > 0, rows.Err()
+}
+
+func migratedVersion(ctx context.Context, cli *ent.Client) (uint32, error) {
+	rows, err := cli.QueryContext(ctx, fmt.Sprintf("SELECT COALESCE(MAX(version), 0) FROM %v", versionsTable))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var version uint32
+	for rows.Next() {
+		if err := rows.Scan(&version); err != nil {
+			return 0, err
+		}
+	}
+
+	return version, rows.Err()
+}
+
+func migrateStep(ctx context.Context, _step *step) error {
+	return db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		if err := _step.Migrate(_ctx, tx); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(
+			_ctx,
+			fmt.Sprintf("INSERT INTO %v (version, name, migrated_at) VALUES (?, ?, ?)", versionsTable),
+			_step.Version,
+			_step.Name,
+			uint32(time.Now().Unix()),
+		)
+		return err
+	})
+}
+
+// Migrate creates the ent schema and applies the pending steps while holding the migration lock
 func Migrate(ctx context.Context) error {
-	// Try lock
-	// Check migrated
-	// Migrate
-	// Set migrated
-	// Unlock
+	conn, err := lock(ctx)
+	if err != nil {
+		return fmt.Errorf("fail lock migrator: %v", err)
+	}
+	defer unlock(conn)
+
+	cli, err := db.Client()
+	if err != nil {
+		return err
+	}
+
+	if err := cli.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("fail create schema: %v", err)
+	}
+
+	if err := createVersionsTable(ctx, cli); err != nil {
+		return fmt.Errorf("fail create %v: %v", versionsTable, err)
+	}
+
+	version, err := migratedVersion(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("fail get migrated version: %v", err)
+	}
+
+	for _, _step := range steps {
+		if _step.Version <= version {
+			continue
+		}
+
+		logger.Sugar().Infow("Migrate", "Version", _step.Version, "Name", _step.Name)
+
+		if err := migrateStep(ctx, _step); err != nil {
+			return fmt.Errorf("fail migrate %v %v: %v", _step.Version, _step.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// DryRun writes the schema DDL and the pending steps to w without applying anything
+func DryRun(ctx context.Context, w io.Writer) error {
+	cli, err := db.Client()
+	if err != nil {
+		return err
+	}
+
+	if err := cli.Schema.WriteTo(ctx, w); err != nil {
+		return fmt.Errorf("fail write schema: %v", err)
+	}
+
+	exist, err := versionsTableExist(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("fail check %v: %v", versionsTable, err)
+	}
+
+	version := uint32(0)
+	if exist {
+		version, err = migratedVersion(ctx, cli)
+		if err != nil {
+			return fmt.Errorf("fail get migrated version: %v", err)
+		}
+	}
+
+	for _, _step := range steps {
+		if _step.Version <= version {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "-- pending step %v: %v\n", _step.Version, _step.Name); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
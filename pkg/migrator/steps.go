@@ -0,0 +1,113 @@
+package migrator
+
+import (
+	"context"
+	"fmt"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/review"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewdecision"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/reviewevent"
+	"github.com/NpoolPlatform/review-manager/pkg/openkey"
+	"github.com/NpoolPlatform/review-manager/pkg/sla"
+)
+
+// Reviews created before CreateSet forced Wait kept the schema default state
+func backfillState(ctx context.Context, tx *ent.Tx) error {
+	_, err := tx.Review.
+		Update().
+		Where(
+			review.Or(
+				review.State(npool.ReviewState_DefaultReviewState.String()),
+				review.State(""),
+			),
+		).
+		SetState(npool.ReviewState_Wait.String()).
+		Save(ctx)
+	return err
+}
+
+// Wait reviews created before the SLA policy get their due time from their creation time
+func backfillDueAt(ctx context.Context, tx *ent.Tx) error {
+	for _, objectType := range npool.ReviewObjectType_name {
+		_sla := sla.SLA(objectType)
+		if _sla <= 0 {
+			continue
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			fmt.Sprintf(
+				"UPDATE %v SET %v = %v + ? WHERE %v = ? AND %v = ? AND %v = 0 AND %v = 0",
+				review.Table,
+				review.FieldDueAt,
+				review.FieldCreatedAt,
+				review.FieldObjectType,
+				review.FieldState,
+				review.FieldDueAt,
+				review.FieldDeletedAt,
+			),
+			uint32(_sla.Seconds()),
+			objectType,
+			npool.ReviewState_Wait.String(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Wait reviews created before open_key get it, the oldest review keeps it when an object has several
+func backfillOpenKey(ctx context.Context, tx *ent.Tx) error {
+	rows, err := tx.Review.
+		Query().
+		Where(
+			review.State(npool.ReviewState_Wait.String()),
+			review.OpenKeyIsNil(),
+		).
+		Order(ent.Asc(review.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]struct{}{}
+	for _, row := range rows {
+		key := openkey.Key(row.AppID, row.Domain, row.ObjectID, row.ObjectType)
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		keys[key] = struct{}{}
+
+		if _, err := row.Update().SetOpenKey(key).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Events and decisions recorded before they had app_id take the app of their review,
+// soft deleted rows included so a restored review keeps its history visible
+func backfillEventApp(ctx context.Context, tx *ent.Tx) error {
+	for _, table := range []string{reviewevent.Table, reviewdecision.Table} {
+		_, err := tx.ExecContext(
+			ctx,
+			fmt.Sprintf(
+				"UPDATE %v t JOIN %v r ON t.%v = r.%v SET t.%v = r.%v",
+				table,
+				review.Table,
+				reviewevent.FieldReviewID,
+				review.FieldID,
+				reviewevent.FieldAppID,
+				review.FieldAppID,
+			),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package openkey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Key identifies the open review of an object, reviews store it in open_key while they wait
+func Key(appID uuid.UUID, domain string, objectID uuid.UUID, objectType string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%v:%v:%v:%v", appID, domain, objectID, objectType)))
+	return hex.EncodeToString(h[:])
+}
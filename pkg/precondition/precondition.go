@@ -0,0 +1,51 @@
+package precondition
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Clients round-trip the version, or the UpdatedAt, of the review they read in these metadata keys
+const (
+	VersionKey   = "x-review-version"
+	UpdatedAtKey = "x-review-updated-at"
+)
+
+type Precondition struct {
+	Version   *uint32
+	UpdatedAt *uint32
+}
+
+func value(md metadata.MD, key string) *uint32 {
+	for _, val := range md.Get(key) {
+		if v, err := strconv.ParseUint(val, 10, 32); err == nil {
+			_v := uint32(v)
+			return &_v
+		}
+	}
+	return nil
+}
+
+// Version returns the version in md, e.g. the response header of a call returning a review
+func Version(md metadata.MD) *uint32 {
+	return value(md, VersionKey)
+}
+
+// FromContext returns the precondition forwarded in grpc metadata, nil when the caller sent none
+func FromContext(ctx context.Context) *Precondition {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	p := &Precondition{
+		Version:   value(md, VersionKey),
+		UpdatedAt: value(md, UpdatedAtKey),
+	}
+	if p.Version == nil && p.UpdatedAt == nil {
+		return nil
+	}
+	return p
+}
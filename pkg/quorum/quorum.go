@@ -0,0 +1,33 @@
+package quorum
+
+import (
+	"fmt"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/config"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+)
+
+// keyApprovals overrides the approvals of an object type and trigger, e.g. review_quorum_ObjectWithdrawal_LargeAmount
+const keyApprovals = "review_quorum_%v_%v"
+
+func key(objectType, trigger string) string {
+	return fmt.Sprintf(keyApprovals, objectType, trigger)
+}
+
+var defaultApprovals = map[string]int{
+	key(npool.ReviewObjectType_ObjectWithdrawal.String(), npool.ReviewTriggerType_LargeAmount.String()): 2,
+}
+
+// Approvals is how many distinct reviewers must approve a review of objectType and trigger.
+// Any rejection rejects the review whatever the quorum is.
+func Approvals(objectType, trigger string) int {
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	if val := config.GetIntValueWithNameSpace(hostname, key(objectType, trigger)); val > 0 {
+		return val
+	}
+	if val, ok := defaultApprovals[key(objectType, trigger)]; ok {
+		return val
+	}
+	return 1
+}
@@ -0,0 +1,93 @@
+package reason
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ReviewReq has no field for the reason codes, callers send them comma separated in this metadata key
+const Key = "x-review-reasons"
+
+// catalogue lists the reason codes of each object type with the decision they explain
+var catalogue = map[npool.ReviewObjectType]map[string]npool.ReviewState{
+	npool.ReviewObjectType_ObjectKyc: {
+		"ID_PHOTO_BLURRY":    npool.ReviewState_Rejected,
+		"ID_EXPIRED":         npool.ReviewState_Rejected,
+		"NAME_MISMATCH":      npool.ReviewState_Rejected,
+		"FACE_MISMATCH":      npool.ReviewState_Rejected,
+		"DOCUMENT_FORGED":    npool.ReviewState_Rejected,
+		"DOCUMENTS_VERIFIED": npool.ReviewState_Approved,
+	},
+	npool.ReviewObjectType_ObjectWithdrawal: {
+		"SUSPECTED_FRAUD":     npool.ReviewState_Rejected,
+		"ADDRESS_BLOCKED":     npool.ReviewState_Rejected,
+		"AMOUNT_ANOMALY":      npool.ReviewState_Rejected,
+		"ACCOUNT_RESTRICTED":  npool.ReviewState_Rejected,
+		"OWNERSHIP_VERIFIED":  npool.ReviewState_Approved,
+		"AMOUNT_WITHIN_LIMIT": npool.ReviewState_Approved,
+	},
+}
+
+type Error struct {
+	ObjectType string
+	State      string
+	Code       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %v review reason %v for state %v", e.ObjectType, e.Code, e.State)
+}
+
+// Codes lists the reason codes a decision to state can give on a review of objectType
+func Codes(objectType string, state npool.ReviewState) []string {
+	codes := []string{}
+	for code, _state := range catalogue[npool.ReviewObjectType(npool.ReviewObjectType_value[objectType])] {
+		if _state == state {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Validate checks codes are reasons of the catalogue for a decision to state on a review of objectType
+func Validate(objectType string, state npool.ReviewState, codes []string) error {
+	reasons := catalogue[npool.ReviewObjectType(npool.ReviewObjectType_value[objectType])]
+	for _, code := range codes {
+		if _state, ok := reasons[code]; !ok || _state != state {
+			return &Error{ObjectType: objectType, State: state.String(), Code: code}
+		}
+	}
+	return nil
+}
+
+// FromContext returns the reason codes forwarded in grpc metadata, nil when the caller sent none
+func FromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	var codes []string
+	seen := map[string]struct{}{}
+	for _, val := range md.Get(Key) {
+		for _, code := range strings.Split(val, ",") {
+			code = strings.TrimSpace(code)
+			if code == "" {
+				continue
+			}
+			if _, ok := seen[code]; ok {
+				continue
+			}
+			seen[code] = struct{}{}
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
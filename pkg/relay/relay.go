@@ -0,0 +1,149 @@
+package relay
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	outboxcrud "github.com/NpoolPlatform/review-manager/pkg/crud/outbox"
+	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent"
+	"github.com/NpoolPlatform/review-manager/pkg/db/ent/outbox"
+	msgsrv "github.com/NpoolPlatform/review-manager/pkg/message/server"
+
+	"github.com/google/uuid"
+)
+
+const (
+	interval   = 1 * time.Second
+	batchSize  = 100
+	minBackoff = 2 * time.Second
+	maxBackoff = 5 * time.Minute
+	// claimLease must outlast the publishing of a batch
+	claimLease = 1 * time.Minute
+)
+
+func backoff(attempts uint32) time.Duration {
+	d := minBackoff
+	for i := uint32(1); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
+
+// claim takes one batch of pending messages for claimLease. Rows are locked with SKIP LOCKED
+// only while their retry time moves past the lease, so replicas running the relay at the same time
+// never ship the same row twice, and no lock is held while the batch is published.
+// The rows of a relay dying mid batch are shipped again once the lease expired.
+func claim(ctx context.Context) ([]*ent.Outbox, error) {
+	rows := []*ent.Outbox{}
+
+	err := db.WithTx(ctx, func(_ctx context.Context, tx *ent.Tx) error {
+		now := uint32(time.Now().Unix())
+
+		var err error
+		rows, err = tx.Outbox.
+			Query().
+			Where(
+				outbox.State(outboxcrud.StatePending),
+				outbox.NextRetryAtLTE(now),
+			).
+			Order(ent.Asc(outbox.FieldCreatedAt)).
+			Limit(batchSize).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(_ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		ids := []uuid.UUID{}
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+
+		_, err = tx.Outbox.
+			Update().
+			Where(outbox.IDIn(ids...)).
+			SetNextRetryAt(now + uint32(claimLease.Seconds())).
+			Save(_ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// relay ships one claimed batch of pending messages
+func relay(ctx context.Context) (int, error) {
+	rows, err := claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	err = db.WithClient(ctx, func(_ctx context.Context, cli *ent.Client) error {
+		// Publish returns once the broker confirmed the message, only then the row is delivered.
+		// The batch stops at the first failure, the broker is likely gone for the rest of it too,
+		// the rest of it is shipped again once the lease expired.
+		for _, row := range rows {
+			if err := msgsrv.Publish(row.RoutingKey, []byte(row.Payload)); err != nil {
+				logger.Sugar().Warnw("relay", "ID", row.ID, "RoutingKey", row.RoutingKey, "Attempts", row.Attempts+1, "error", err)
+				_, err = cli.Outbox.
+					UpdateOneID(row.ID).
+					SetAttempts(row.Attempts + 1).
+					SetNextRetryAt(uint32(time.Now().Unix()) + uint32(backoff(row.Attempts+1).Seconds())).
+					SetLastError(err.Error()).
+					Save(_ctx)
+				return err
+			}
+
+			_, err := cli.Outbox.
+				UpdateOneID(row.ID).
+				SetState(outboxcrud.StateDelivered).
+				SetDeliveredAt(uint32(time.Now().Unix())).
+				Save(_ctx)
+			if err != nil {
+				return err
+			}
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Run relays the outbox until ctx is done, a full batch is followed by the next one at once
+func Run(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		count, err := relay(ctx)
+		if err != nil {
+			logger.Sugar().Errorw("relay", "error", err)
+		}
+		if count == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package sla
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/config"
+
+	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+)
+
+// keySLASeconds overrides the SLA of an object type, e.g. review_sla_seconds_ObjectWithdrawal
+const keySLASeconds = "review_sla_seconds_%v"
+
+// Reviews of an object type without SLA never become overdue
+var defaultSLAs = map[string]time.Duration{
+	npool.ReviewObjectType_ObjectKyc.String():        72 * time.Hour,
+	npool.ReviewObjectType_ObjectWithdrawal.String(): 24 * time.Hour,
+}
+
+// SLA is how long a review of objectType may wait, 0 for no SLA
+func SLA(objectType string) time.Duration {
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	if val := config.GetIntValueWithNameSpace(hostname, fmt.Sprintf(keySLASeconds, objectType)); val > 0 {
+		return time.Duration(val) * time.Second
+	}
+	return defaultSLAs[objectType]
+}
+
+// DueAt is the due time of a review of objectType created at from, 0 when it has no SLA
+func DueAt(objectType string, from time.Time) uint32 {
+	sla := SLA(objectType)
+	if sla <= 0 {
+		return 0
+	}
+	return uint32(from.Add(sla).Unix())
+}
@@ -0,0 +1,120 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/NpoolPlatform/go-service-framework/pkg/config"
+	"github.com/NpoolPlatform/go-service-framework/pkg/logger"
+
+	"github.com/NpoolPlatform/review-manager/pkg/actor"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/google/uuid"
+)
+
+// The gateway forwards the app of the caller in AppIDKey
+const AppIDKey = "x-app-id"
+
+// keyPlatformAdmins of the service config lists the users, comma separated, who may call the cross app endpoints
+const keyPlatformAdmins = "platform_admin_user_ids"
+
+// keyMode of the service config selects how a grpc call without AppIDKey is handled
+const (
+	keyMode     = "tenant_mode"
+	ModeLog     = "log"
+	ModeEnforce = "enforce"
+)
+
+var (
+	ErrMissingApp       = errors.New("missing app of the caller")
+	ErrNotPlatformAdmin = errors.New("caller is not platform admin")
+)
+
+type platformAdminKey struct{}
+
+// AppID returns the app of the caller forwarded in grpc metadata
+func AppID(ctx context.Context) (uuid.UUID, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.UUID{}, false
+	}
+	for _, val := range md.Get(AppIDKey) {
+		if id, err := uuid.Parse(val); err == nil {
+			return id, true
+		}
+	}
+	return uuid.UUID{}, false
+}
+
+// Scoped tells if ctx comes from a grpc call, which only sees the rows of its app.
+// Workers and commands run with plain contexts and see every app.
+func Scoped(ctx context.Context) bool {
+	if IsPlatformAdmin(ctx) {
+		return false
+	}
+	_, ok := metadata.FromIncomingContext(ctx)
+	return ok
+}
+
+// Mode is ModeLog when the service config opts into it while the callers roll out WithApp,
+// else ModeEnforce, the default
+func Mode() string {
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	if config.GetStringValueWithNameSpace(hostname, keyMode) == ModeLog {
+		return ModeLog
+	}
+	return ModeEnforce
+}
+
+// MissingApp handles a grpc call of op without AppIDKey: ModeEnforce denies it with ErrMissingApp,
+// ModeLog logs the caller and lets it see every app like before the app scope
+func MissingApp(ctx context.Context, op string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if Mode() == ModeEnforce {
+		logger.Sugar().Warnw("MissingApp", "Op", op, "Peer", md.Get(":authority"), "error", ErrMissingApp)
+		return ErrMissingApp
+	}
+	logger.Sugar().Warnw("MissingApp", "Op", op, "Peer", md.Get(":authority"), "UserAgent", md.Get("user-agent"))
+	return nil
+}
+
+// platformAdmins is the set of users of keyPlatformAdmins
+func platformAdmins() map[uuid.UUID]struct{} {
+	hostname := config.GetStringValueWithNameSpace("", config.KeyHostname)
+	admins := map[uuid.UUID]struct{}{}
+	for _, val := range strings.Split(config.GetStringValueWithNameSpace(hostname, keyPlatformAdmins), ",") {
+		if id, err := uuid.Parse(strings.TrimSpace(val)); err == nil {
+			admins[id] = struct{}{}
+		}
+	}
+	return admins
+}
+
+// PlatformAdmin lifts the app scope of ctx for the cross app endpoint method.
+// The user of the caller, forwarded in actor.UserIDKey, must be listed in keyPlatformAdmins,
+// and every bypass is logged for audit.
+func PlatformAdmin(ctx context.Context, method string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	userID := actor.FromContext(ctx)
+	appID, _ := AppID(ctx)
+
+	if _, admin := platformAdmins()[userID]; !admin || userID == uuid.Nil {
+		logger.Sugar().Warnw("PlatformAdmin", "Method", method, "AppID", appID, "UserID", userID, "error", ErrNotPlatformAdmin)
+		return ctx, ErrNotPlatformAdmin
+	}
+
+	logger.Sugar().Infow("PlatformAdmin", "Method", method, "AppID", appID, "UserID", userID, "Peer", md.Get(":authority"))
+	return context.WithValue(ctx, platformAdminKey{}, true), nil
+}
+
+func IsPlatformAdmin(ctx context.Context) bool {
+	admin, ok := ctx.Value(platformAdminKey{}).(bool)
+	return ok && admin
+}
@@ -1,6 +1,7 @@
 package testinit
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"runtime"
@@ -8,6 +9,7 @@ import (
 	"github.com/NpoolPlatform/go-service-framework/pkg/app"
 
 	"github.com/NpoolPlatform/review-manager/pkg/db"
+	"github.com/NpoolPlatform/review-manager/pkg/migrator"
 
 	servicename "github.com/NpoolPlatform/review-manager/pkg/servicename"
 
@@ -44,6 +46,10 @@ func Init() error {
 	if err != nil {
 		return fmt.Errorf("cannot init database: %v", err)
 	}
+	err = migrator.Migrate(context.Background())
+	if err != nil {
+		return fmt.Errorf("cannot migrate database: %v", err)
+	}
 
 	return nil
 }
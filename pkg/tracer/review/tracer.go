@@ -6,7 +6,10 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	trace1 "go.opentelemetry.io/otel/trace"
 
+	"github.com/NpoolPlatform/libent-cruder/pkg/cruder"
 	npool "github.com/NpoolPlatform/message/npool/review/mgr/v2"
+
+	"github.com/NpoolPlatform/review-manager/pkg/extmgr"
 )
 
 func trace(span trace1.Span, in *npool.ReviewReq, index int) trace1.Span {
@@ -49,6 +52,66 @@ func TraceConds(span trace1.Span, in *npool.Conds) trace1.Span {
 	return span
 }
 
+func TraceExtConds(span trace1.Span, in *extmgr.Conds) trace1.Span {
+	span = TraceConds(span, extmgr.SingleConds(in))
+	span.SetAttributes(
+		attribute.String("IDs.Op", in.GetIDs().GetOp()),
+		attribute.StringSlice("IDs.Value", in.GetIDs().GetValue()),
+		attribute.String("AppIDs.Op", in.GetAppIDs().GetOp()),
+		attribute.StringSlice("AppIDs.Value", in.GetAppIDs().GetValue()),
+		attribute.String("ReviewerIDs.Op", in.GetReviewerIDs().GetOp()),
+		attribute.StringSlice("ReviewerIDs.Value", in.GetReviewerIDs().GetValue()),
+		attribute.String("Domains.Op", in.GetDomains().GetOp()),
+		attribute.StringSlice("Domains.Value", in.GetDomains().GetValue()),
+		attribute.String("ObjectIDs.Op", in.GetObjectIDs().GetOp()),
+		attribute.StringSlice("ObjectIDs.Value", in.GetObjectIDs().GetValue()),
+		attribute.String("Triggers.Op", in.GetTriggers().GetOp()),
+		attribute.String("Triggers.Value", fmt.Sprintf("%v", in.GetTriggers().GetValue())),
+		attribute.String("ObjectTypes.Op", in.GetObjectTypes().GetOp()),
+		attribute.String("ObjectTypes.Value", fmt.Sprintf("%v", in.GetObjectTypes().GetValue())),
+		attribute.String("States.Op", in.GetStates().GetOp()),
+		attribute.String("States.Value", fmt.Sprintf("%v", in.GetStates().GetValue())),
+	)
+	for index, bound := range in.GetCreatedAt() {
+		span.SetAttributes(
+			attribute.String(fmt.Sprintf("CreatedAt.%v.Op", index), bound.GetOp()),
+			attribute.Int64(fmt.Sprintf("CreatedAt.%v.Value", index), int64(bound.GetValue())),
+		)
+	}
+	for index, bound := range in.GetUpdatedAt() {
+		span.SetAttributes(
+			attribute.String(fmt.Sprintf("UpdatedAt.%v.Op", index), bound.GetOp()),
+			attribute.Int64(fmt.Sprintf("UpdatedAt.%v.Value", index), int64(bound.GetValue())),
+		)
+	}
+	for index, bound := range in.GetDueAt() {
+		span.SetAttributes(
+			attribute.String(fmt.Sprintf("DueAt.%v.Op", index), bound.GetOp()),
+			attribute.Int64(fmt.Sprintf("DueAt.%v.Value", index), int64(bound.GetValue())),
+		)
+	}
+	span.SetAttributes(
+		attribute.String("Overdue.Op", in.GetOverdue().GetOp()),
+		attribute.Bool("Overdue.Value", in.GetOverdue().GetValue()),
+		attribute.String("Escalated.Op", in.GetEscalated().GetOp()),
+		attribute.Bool("Escalated.Value", in.GetEscalated().GetValue()),
+		attribute.String("Reason.Op", in.GetReason().GetOp()),
+		attribute.String("Reason.Value", in.GetReason().GetValue()),
+	)
+	return span
+}
+
+func TraceCond(span trace1.Span, field string, cond *cruder.Cond) trace1.Span {
+	if cond == nil {
+		return span
+	}
+	span.SetAttributes(
+		attribute.String(fmt.Sprintf("%v.Op", field), cond.Op),
+		attribute.String(fmt.Sprintf("%v.Value", field), fmt.Sprintf("%v", cond.Val)),
+	)
+	return span
+}
+
 func TraceMany(span trace1.Span, infos []*npool.ReviewReq) trace1.Span {
 	for index, info := range infos {
 		span = trace(span, info, index)